@@ -0,0 +1,17 @@
+# Wake up a development deployment until a given time with
+# `terraform apply -invoke=action.astro_deployment_hibernation_override.wake_up`
+action "astro_deployment_hibernation_override" "wake_up" {
+  config {
+    deployment_id  = "clx44jyu001m201m5dzsbexqr"
+    is_hibernating = false
+    override_until = "2030-01-01T00:00:00Z"
+  }
+}
+
+# Hibernate a development deployment until the override is ended
+action "astro_deployment_hibernation_override" "hibernate" {
+  config {
+    deployment_id  = "clx44jyu001m201m5dzsbexqr"
+    is_hibernating = true
+  }
+}
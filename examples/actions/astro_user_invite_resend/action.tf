@@ -0,0 +1,8 @@
+# Invite a user again after their invite expired with
+# `terraform apply -invoke=action.astro_user_invite_resend.resend`
+action "astro_user_invite_resend" "resend" {
+  config {
+    email = "email@organization.com"
+    role  = "ORGANIZATION_MEMBER"
+  }
+}
@@ -12,6 +12,18 @@ data "astro_api_tokens" "deployment_example" {
   deployment_id = "clx44jyu001m201m5dzsbexqr"
 }
 
+# Standard API tokens with a DAG role for a DAG, or for DAGs with one of the tags, of a deployment
+data "astro_api_tokens" "dag_example" {
+  deployment_id = "clx44jyu001m201m5dzsbexqr"
+  dag_id        = "example_dag"
+  kind          = "STANDARD"
+}
+
+data "astro_api_tokens" "dag_tags_example" {
+  deployment_id = "clx44jyu001m201m5dzsbexqr"
+  dag_tags      = ["finance", "pii"]
+}
+
 # Output the API tokens using terraform apply
 output "api_tokens" {
   value = data.astro_api_tokens.example_api_tokens
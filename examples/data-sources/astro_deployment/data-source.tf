@@ -2,6 +2,12 @@ data "astro_deployment" "example_deployment" {
   id = "clozc036j01to01jrlgvueo8t"
 }
 
+# Look up a deployment by name, workspace_id is only needed when the name is used in several workspaces
+data "astro_deployment" "example_deployment_by_name" {
+  name         = "my-deployment"
+  workspace_id = "clozc036j01to01jrlgvueo8t"
+}
+
 # Output the deployment value using terraform apply
 output "deployment" {
   value = data.astro_deployment.example_deployment
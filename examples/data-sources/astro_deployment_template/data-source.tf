@@ -0,0 +1,54 @@
+data "astro_deployment_template" "production" {
+  source_deployment_id = "clozc036j01to01jrlgvueo8t"
+}
+
+# A preview deployment with the configuration of the production deployment
+resource "astro_deployment" "preview" {
+  name                           = "preview"
+  description                    = "Preview of the production deployment"
+  workspace_id                   = data.astro_deployment_template.production.workspace_id
+  type                           = data.astro_deployment_template.production.type
+  cloud_provider                 = data.astro_deployment_template.production.cloud_provider
+  region                         = data.astro_deployment_template.production.region
+  cluster_id                     = data.astro_deployment_template.production.cluster_id
+  original_astro_runtime_version = data.astro_deployment_template.production.original_astro_runtime_version
+  executor                       = data.astro_deployment_template.production.executor
+  contact_emails                 = data.astro_deployment_template.production.contact_emails
+  is_cicd_enforced               = data.astro_deployment_template.production.is_cicd_enforced
+  is_dag_deploy_enabled          = data.astro_deployment_template.production.is_dag_deploy_enabled
+  is_development_mode            = data.astro_deployment_template.production.is_development_mode
+  is_high_availability           = data.astro_deployment_template.production.is_high_availability
+  scheduler_size                 = data.astro_deployment_template.production.scheduler_size
+  resource_quota_cpu             = data.astro_deployment_template.production.resource_quota_cpu
+  resource_quota_memory          = data.astro_deployment_template.production.resource_quota_memory
+  default_task_pod_cpu           = data.astro_deployment_template.production.default_task_pod_cpu
+  default_task_pod_memory        = data.astro_deployment_template.production.default_task_pod_memory
+  environment_variables          = data.astro_deployment_template.production.environment_variables
+  worker_queues                  = data.astro_deployment_template.production.worker_queues
+  scaling_spec                   = data.astro_deployment_template.production.scaling_spec
+  remote_execution               = data.astro_deployment_template.production.remote_execution
+}
+
+# Copy the secret environment variables too, their values are never returned by Astro and must be set again
+data "astro_deployment_template" "production_with_secrets" {
+  source_deployment_id                 = "clozc036j01to01jrlgvueo8t"
+  include_secret_environment_variables = true
+}
+
+locals {
+  secret_values = {
+    API_KEY = var.api_key
+  }
+  environment_variables = [
+    for env_var in data.astro_deployment_template.production_with_secrets.environment_variables : {
+      key       = env_var.key
+      value     = env_var.is_secret ? local.secret_values[env_var.key] : env_var.value
+      is_secret = env_var.is_secret
+    }
+  ]
+}
+
+variable "api_key" {
+  type      = string
+  sensitive = true
+}
@@ -8,6 +8,17 @@ data "astro_users" "example_users_filter_by_deployment_id" {
   deployment_id = "clx44jyu001m201m5dzsbexqr"
 }
 
+# Users with a DAG role for a DAG, or for DAGs with one of the tags, of a deployment
+data "astro_users" "example_users_filter_by_dag_id" {
+  deployment_id = "clx44jyu001m201m5dzsbexqr"
+  dag_id        = "example_dag"
+}
+
+data "astro_users" "example_users_filter_by_dag_tags" {
+  deployment_id = "clx44jyu001m201m5dzsbexqr"
+  dag_tags      = ["finance", "pii"]
+}
+
 # Output the users value using terraform apply
 output "example_users" {
   value = data.astro_users.example_users
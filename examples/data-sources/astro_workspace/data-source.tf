@@ -2,6 +2,11 @@ data "astro_workspace" "example_workspace" {
   id = "clozc036j01to01jrlgvueo8t"
 }
 
+# Look up a workspace by name
+data "astro_workspace" "example_workspace_by_name" {
+  name = "my-workspace"
+}
+
 # Output the workspace value using terraform apply
 output "workspace" {
   value = data.astro_workspace.example_workspace
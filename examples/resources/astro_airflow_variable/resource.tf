@@ -0,0 +1,57 @@
+# Workspace variable, not secret
+resource "astro_airflow_variable" "workspace_plain" {
+  key             = "etl_default_region"
+  scope           = "WORKSPACE"
+  scope_entity_id = "clx42sxw501gl01o0gjenthnh"
+
+  value = "us-east-1"
+}
+
+# Workspace secret variable. Toggling `is_secret` forces replacement.
+resource "astro_airflow_variable" "workspace_secret" {
+  key                   = "external_api_key"
+  scope                 = "WORKSPACE"
+  scope_entity_id       = "clx42sxw501gl01o0gjenthnh"
+  auto_link_deployments = true
+
+  value     = "sk-abc123-replace-me"
+  is_secret = true
+}
+
+# Workspace variable with a per-Deployment override
+resource "astro_airflow_variable" "workspace_with_override" {
+  key             = "warehouse_database"
+  scope           = "WORKSPACE"
+  scope_entity_id = "clx42sxw501gl01o0gjenthnh"
+
+  value = "analytics_prod"
+
+  links = [
+    {
+      scope           = "DEPLOYMENT"
+      scope_entity_id = "clx44jyu001m201m5dzsbexqr"
+      overrides = {
+        value = "analytics_staging"
+      }
+    },
+  ]
+}
+
+# Import an existing Airflow variable
+import {
+  id = "cm4ntm56001gk01mbhudv1elv"
+  to = astro_airflow_variable.workspace_plain
+}
+
+# Or import it by the name of its workspace and its key, use <workspace name>/<deployment name>/<key> for
+# an object of a deployment. The import fails if several workspaces or deployments have the name
+# import {
+#   id = "airflow_variable:my workspace/etl_default_region"
+#   to = astro_airflow_variable.workspace_plain
+# }
+
+# Move an Airflow variable managed by astro_environment_object, requires Terraform 1.8 or later
+moved {
+  from = astro_environment_object.var_workspace_plain
+  to   = astro_airflow_variable.workspace_plain
+}
@@ -150,6 +150,12 @@ import {
   to = astro_alert.dag_failure_alert
 }
 
+# Or import it by name, the import fails if several alerts have the name
+# import {
+#   id = "alert:DAG Failure Alert"
+#   to = astro_alert.dag_failure_alert
+# }
+
 resource "astro_alert" "dag_failure_alert_imported" {
   name                     = "Imported DAG Failure Alert"
   type                     = "DAG_FAILURE"
@@ -78,3 +78,10 @@ resource "astro_alerts" "per_dag_failure_alerts" {
     }
   }
 }
+
+# Move an alert managed by astro_alert, requires Terraform 1.8 or later. The alert is keyed by its name, use the name
+# as its key in alerts.
+moved {
+  from = astro_alert.dag_duration_alert
+  to   = astro_alerts.dag_duration_alerts
+}
@@ -9,4 +9,17 @@ resource "astro_allowed_ip_address_ranges" "org_allow_list" {
     "203.0.113.0/24",
     "198.51.100.5/32",
   ]
+  # Descriptions are only kept in the Terraform state, the Astro API does not store them
+  ip_address_range_descriptions = {
+    "203.0.113.0/24"  = "Office network"
+    "198.51.100.5/32" = "CI runner egress IP"
+  }
 }
+
+# Plans fail when ip_address_ranges does not cover the public IP address of the machine running
+# Terraform. Set allow_self_lockout when Terraform reaches the Astro API through another IP address,
+# e.g. through a proxy.
+# resource "astro_allowed_ip_address_ranges" "org_allow_list" {
+#   ip_address_ranges  = ["203.0.113.0/24"]
+#   allow_self_lockout = true
+# }
@@ -10,6 +10,19 @@ resource "astro_api_token" "organization_token" {
   expiry_period_in_days = 30
 }
 
+# Rotate the token by changing rotation_trigger, the new value is stored in token
+resource "astro_api_token" "rotated_organization_token" {
+  name        = "rotated organization api token"
+  description = "organization api token rotated every month"
+  type        = "ORGANIZATION"
+  roles = [{
+    "role" : "ORGANIZATION_MEMBER",
+    "entity_id" : "clx42kkcm01fo01o06agtmshg",
+    "entity_type" : "ORGANIZATION"
+  }]
+  rotation_trigger = "2026-10"
+}
+
 resource "astro_api_token" "organization_token_with_multiple_roles" {
   name        = "organization api token with multiple roles"
   description = "organization api token description"
@@ -123,6 +136,11 @@ import {
   id = "clxm46ged05b301neuucdqwox" // ID of the existing api token
   to = astro_api_token.imported_api_token
 }
+# Or import it by name, the import fails if several API tokens have the name
+# import {
+#   id = "api_token:imported api token"
+#   to = astro_api_token.imported_api_token
+# }
 resource "astro_api_token" "imported_api_token" {
   name        = "imported api token"
   description = "imported api token description"
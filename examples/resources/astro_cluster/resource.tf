@@ -84,6 +84,12 @@ import {
   id = "clozc036j01to01jrlgvuf98d" // ID of the existing cluster
   to = astro_cluster.imported_cluster
 }
+
+// Or import it by name, the import fails if several clusters have the name
+// import {
+//   id = "cluster:an existing cluster to import"
+//   to = astro_cluster.imported_cluster
+// }
 resource "astro_cluster" "imported_cluster" {
   type                  = "DEDICATED"
   name                  = "an existing cluster to import"
@@ -0,0 +1,26 @@
+resource "astro_cluster" "dr" {
+  type             = "DEDICATED"
+  name             = "my dedicated cluster with disaster recovery"
+  region           = "us-east-1"
+  cloud_provider   = "AWS"
+  vpc_subnet_range = "172.20.0.0/20"
+  is_dr_enabled    = true
+  dr_region        = "us-west-2"
+  workspace_ids    = []
+}
+
+# Set is_failed_over to true to fail the cluster over to us-west-2, and back to false to fail it back to us-east-1
+resource "astro_cluster_failover" "dr" {
+  cluster_id     = astro_cluster.dr.id
+  is_failed_over = false
+}
+
+# Import the failover state of an existing cluster
+import {
+  id = "clv4wcf6f003u01m3zp7gsvzg" # <cluster_id>
+  to = astro_cluster_failover.imported
+}
+resource "astro_cluster_failover" "imported" {
+  cluster_id     = "clv4wcf6f003u01m3zp7gsvzg"
+  is_failed_over = false
+}
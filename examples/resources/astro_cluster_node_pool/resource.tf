@@ -0,0 +1,36 @@
+resource "astro_cluster" "dedicated" {
+  type             = "DEDICATED"
+  name             = "my first dedicated cluster"
+  region           = "us-east-1"
+  cloud_provider   = "AWS"
+  vpc_subnet_range = "172.20.0.0/20"
+  workspace_ids    = []
+}
+
+resource "astro_cluster_node_pool" "large_workers" {
+  cluster_id         = astro_cluster.dedicated.id
+  name               = "large-workers"
+  node_instance_type = "m5.4xlarge"
+  max_node_count     = 10
+}
+
+# Make a node pool the default node pool of the cluster
+resource "astro_cluster_node_pool" "default" {
+  cluster_id         = astro_cluster.dedicated.id
+  name               = "default-workers"
+  node_instance_type = "m5.xlarge"
+  max_node_count     = 20
+  is_default         = true
+}
+
+# Import an existing node pool
+import {
+  id = "clv4wcf6f003u01m3zp7gsvzg/clv4wcf6f003v01m3abcd1234" # <cluster_id>/<node_pool_id>
+  to = astro_cluster_node_pool.imported
+}
+resource "astro_cluster_node_pool" "imported" {
+  cluster_id         = "clv4wcf6f003u01m3zp7gsvzg"
+  name               = "imported-workers"
+  node_instance_type = "m5.2xlarge"
+  max_node_count     = 5
+}
@@ -17,6 +17,7 @@ resource "astro_deployment" "dedicated" {
   scheduler_size                 = "SMALL"
   workspace_id                   = "clnp86ly5000401ndaga21g81"
   desired_workload_identity      = "arn:aws:iam::123456789:role/AirflowS3Logs-clmk2qqia000008mhff3ndjr0"
+  dr_workload_identity           = "arn:aws:iam::123456789:role/AirflowS3Logs-DR-clmk2qqia000008mhff3ndjr0" # used when the cluster is failed over to its DR region
   environment_variables = [{
     key       = "key1"
     value     = "value1"
@@ -79,6 +80,9 @@ resource "astro_deployment" "standard" {
     min_worker_count   = 0
     worker_concurrency = 1
   }]
+
+  # Fail plans once the runtime version is deprecated instead of only warning
+  runtime_deprecation_severity = "ERROR"
 }
 
 resource "astro_deployment" "standard_astro" {
@@ -165,6 +169,22 @@ import {
   id = "clv17vgft000801kkydsws63x" // ID of the existing deployment
   to = astro_deployment.imported_deployment
 }
+
+// Or import it by name, with the name alone or prefixed with the name of its workspace. The import fails if several
+// deployments have the name
+// import {
+//   id = "deployment:import me" // or "my-workspace/import me"
+//   to = astro_deployment.imported_deployment
+// }
+
+// Or import it by its identity, requires Terraform 1.12 or later
+// import {
+//   identity = {
+//     organization_id = "clx42kkcm01fo01o06agtmshg" // Optional, defaults to the organization of the provider
+//     id              = "clv17vgft000801kkydsws63x"
+//   }
+//   to = astro_deployment.imported_deployment
+// }
 resource "astro_deployment" "imported_deployment" {
   name                    = "import me"
   description             = "an existing deployment"
@@ -0,0 +1,43 @@
+# Prometheus export with bearer-token auth, custom headers and labels
+resource "astro_metrics_export" "workspace_bearer" {
+  key             = "prometheus_remote_write"
+  scope           = "WORKSPACE"
+  scope_entity_id = "clx42sxw501gl01o0gjenthnh"
+
+  endpoint    = "https://prometheus.example.com/api/v1/write"
+  auth_type   = "AUTH_TOKEN"
+  basic_token = "REPLACE_ME"
+  labels      = { environment = "prod", team = "data" }
+  headers     = { "X-Scope-OrgID" = "astro-tenant-1" }
+}
+
+# Prometheus export with basic auth
+resource "astro_metrics_export" "deployment_basic_auth" {
+  key             = "prometheus_remote_write_basic"
+  scope           = "DEPLOYMENT"
+  scope_entity_id = "clx44jyu001m201m5dzsbexqr"
+
+  endpoint  = "https://prometheus.example.com/api/v1/write"
+  auth_type = "BASIC"
+  username  = "metrics"
+  password  = "REPLACE_ME"
+}
+
+# Import an existing metrics export
+import {
+  id = "cm4ntm56001gk01mbhudv1elv"
+  to = astro_metrics_export.workspace_bearer
+}
+
+# Or import it by the name of its workspace and its key, use <workspace name>/<deployment name>/<key> for
+# an object of a deployment. The import fails if several workspaces or deployments have the name
+# import {
+#   id = "metrics_export:my workspace/prometheus_remote_write"
+#   to = astro_metrics_export.workspace_bearer
+# }
+
+# Move a metrics export managed by astro_environment_object, requires Terraform 1.8 or later
+moved {
+  from = astro_environment_object.metrics_workspace_bearer
+  to   = astro_metrics_export.workspace_bearer
+}
@@ -61,6 +61,12 @@ import {
   to = astro_notification_channel.email_notification_channel
 }
 
+# Or import it by name, the import fails if several notification channels have the name
+# import {
+#   id = "notification_channel:Email Notification Channel"
+#   to = astro_notification_channel.email_notification_channel
+# }
+
 resource "astro_notification_channel" "example_notification_channel" {
   name        = "Example Notification Channel"
   type        = "EMAIL"
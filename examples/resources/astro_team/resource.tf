@@ -23,6 +23,12 @@ import {
   id = "clx486hno068301il306nuhsm" # ID of the existing team
   to = astro_team.imported_team
 }
+
+# Or import it by name, the import fails if several teams have the name
+# import {
+#   id = "team:imported team"
+#   to = astro_team.imported_team
+# }
 resource "astro_team" "imported_team" {
   name              = "imported team"
   description       = "imported team description"
@@ -18,6 +18,15 @@ import {
   to = astro_team_membership.single
 }
 
+# Import an existing membership by its identity, requires Terraform 1.12 or later
+import {
+  identity = {
+    team_id = "clhpichn8002m01mqa4ocs7g6"
+    user_id = "clv9user1000000000000000"
+  }
+  to = astro_team_membership.bulk["clv9user1000000000000000"]
+}
+
 # ─────────────────────────────────────────────────────────────────────────────
 # Decentralized ownership example
 #
@@ -64,3 +73,9 @@ resource "astro_team_membership" "analytics_onboarding" {
   team_id = one(data.astro_teams.platform_engineers.teams).id
   user_id = each.value
 }
+
+# Move a team with a single member in member_ids managed by astro_team, requires Terraform 1.8 or later
+moved {
+  from = astro_team.single_member
+  to   = astro_team_membership.single
+}
@@ -125,4 +125,10 @@ resource "astro_team_roles" "imported_team_roles" {
       role         = "WORKSPACE_OWNER"
     }
   ]
-}
\ No newline at end of file
+}
+
+# Move the roles of a team managed by astro_team, requires Terraform 1.8 or later
+moved {
+  from = astro_team.data_engineering
+  to   = astro_team_roles.data_engineering
+}
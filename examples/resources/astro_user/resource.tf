@@ -0,0 +1,34 @@
+# Invites the user, the roles are bound on the first apply after the user accepts the invite
+resource "astro_user" "example" {
+  email             = "user@example.com"
+  organization_role = "ORGANIZATION_MEMBER"
+  workspace_roles = [
+    {
+      workspace_id = "clx42sxw501gl01o0gjenthnh"
+      role         = "WORKSPACE_MEMBER"
+    }
+  ]
+  deployment_roles = [
+    {
+      deployment_id = "clyn6kxud003x01mtxmccegnh"
+      role          = "DEPLOYMENT_ACCESSOR"
+    }
+  ]
+}
+
+# Sends a new invite on the next apply after the pending invite expired
+resource "astro_user" "reinvited" {
+  email              = "other.user@example.com"
+  organization_role  = "ORGANIZATION_MEMBER"
+  reinvite_on_expiry = true
+}
+
+# Import an existing user by email
+import {
+  id = "existing.user@example.com"
+  to = astro_user.imported_user
+}
+resource "astro_user" "imported_user" {
+  email             = "existing.user@example.com"
+  organization_role = "ORGANIZATION_MEMBER"
+}
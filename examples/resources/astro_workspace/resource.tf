@@ -9,6 +9,12 @@ import {
   id = "clozc036j01to01jrlgvu798d" // ID of the existing workspace
   to = astro_workspace.imported_workspace
 }
+
+// Or import it by name, the import fails if several workspaces have the name
+// import {
+//   id = "workspace:import me"
+//   to = astro_workspace.imported_workspace
+// }
 resource "astro_workspace" "imported_workspace" {
   name                  = "import me"
   description           = "an existing workspace"
@@ -9,6 +9,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"sync"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/astronomer/terraform-provider-astro/internal/clients"
 
 	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/validators"
 	"github.com/samber/lo"
 )
 
@@ -37,7 +39,10 @@ func main() {
 	// collect all arguments from the user, indicating all the resources that need to be imported
 	resourcesPtr := flag.String("resources", "workspace,deployment,cluster,api_token,team,team_roles,user_roles,alert,notification_channel", "Comma separated list of resources to import. The only accepted values are workspace, deployment, cluster, api_token, team, team_roles, user_roles, alert, notification_channel")
 	tokenPtr := flag.String("token", "", "API token to authenticate with the platform")
-	hostPtr := flag.String("host", "https://api.astronomer.io", "API host to connect to")
+	hostPtr := flag.String("host", "https://api.astronomer.io", "API host to connect to: dev, stage, prod or a URL")
+	caCertFilePtr := flag.String("caCertFile", "", "Path to a PEM encoded CA certificate bundle to trust when connecting to the API host")
+	proxyUrlPtr := flag.String("proxyUrl", "", "URL of the HTTP or HTTPS proxy used to connect to the API host")
+	insecureSkipVerifyPtr := flag.Bool("insecureSkipVerify", false, "Skip verification of the API host TLS certificate")
 	organizationIdPtr := flag.String("organizationId", "", "Organization ID to import resources into")
 	runTerraformInitPtr := flag.Bool("runTerraformInit", false, "Run terraform init after generating the import configuration")
 	helpFlag := flag.Bool("help", false, "Display help information")
@@ -85,14 +90,11 @@ func main() {
 	}
 
 	// set the host
-	var host string
-	if *hostPtr == "dev" {
-		host = "https://api.astronomer-dev.io"
-	} else if *hostPtr == "stage" {
-		host = "https://api.astronomer-stage.io"
-	} else {
-		host = "https://api.astronomer.io"
+	host, isCustomHost, err := resolveHost(*hostPtr)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
 	}
+	log.Printf("Using API host: %s", host)
 
 	// set the organization ID
 	organizationId := *organizationIdPtr
@@ -110,12 +112,22 @@ func main() {
 
 	// connect to v1beta1 client
 	ctx := context.Background()
-	platformClient, err := platform.NewPlatformClient(host, token, "import")
+	transport, err := clients.NewBaseTransport(clients.TransportConfig{
+		CaCertFile:         *caCertFilePtr,
+		ProxyUrl:           *proxyUrlPtr,
+		InsecureSkipVerify: *insecureSkipVerifyPtr,
+	})
+	if err != nil {
+		log.Fatalf("Failed to configure HTTP client: %v", err)
+	}
+	httpClient := &http.Client{Transport: transport}
+
+	platformClient, err := platform.NewPlatformClient(host, token, "import", platform.WithHTTPClient(httpClient))
 	if err != nil {
 		log.Fatalf("Failed to create platform client: %v", err)
 	}
 
-	iamClient, err := iam.NewIamClient(host, token, "import")
+	iamClient, err := iam.NewIamClient(host, token, "import", iam.WithHTTPClient(httpClient))
 	if err != nil {
 		log.Fatalf("Failed to create iam client: %v", err)
 		return
@@ -134,8 +146,8 @@ func main() {
 provider "astro" {
 	organization_id = "%s"
 	host = "%s"
-}
-`, organizationId, host)
+%s}
+`, organizationId, host, providerConnectionSettings(isCustomHost, *caCertFilePtr, *proxyUrlPtr, *insecureSkipVerifyPtr))
 
 	//	for each resource, we get the list of entities and generate the terraform import command
 
@@ -285,6 +297,14 @@ func printHelp() {
 	log.Println("        API token to authenticate with the platform")
 	log.Println("  -organizationId string")
 	log.Println("        Organization ID to import resources into")
+	log.Println("  -host string")
+	log.Println("        API host to connect to: dev, stage, prod (default) or a URL such as https://astro-api.example.com")
+	log.Println("  -caCertFile string")
+	log.Println("        Path to a PEM encoded CA certificate bundle to trust when connecting to the API host")
+	log.Println("  -proxyUrl string")
+	log.Println("        URL of the HTTP or HTTPS proxy used to connect to the API host")
+	log.Println("  -insecureSkipVerify")
+	log.Println("        Skip verification of the API host TLS certificate")
 	log.Println("  -runTerraformInit")
 	log.Println("        Run terraform init after generating the import configuration")
 	log.Println("  -help")
@@ -294,6 +314,45 @@ func printHelp() {
 	log.Println("\nNote: If the -token flag is not provided, the script will attempt to use the ASTRO_API_TOKEN environment variable.")
 }
 
+// resolveHost maps the -host flag to an API host. dev, stage and prod select the matching Astronomer API host, any
+// other value must be an Astronomer API host URL or a custom host URL accepted by the provider allow_custom_host setting.
+func resolveHost(hostFlag string) (string, bool, error) {
+	switch hostFlag {
+	case "dev":
+		return "https://api.astronomer-dev.io", false, nil
+	case "stage":
+		return "https://api.astronomer-stage.io", false, nil
+	case "", "prod":
+		return "https://api.astronomer.io", false, nil
+	}
+	if regexp.MustCompile(validators.AstronomerApiHostString).MatchString(hostFlag) {
+		return hostFlag, false, nil
+	}
+	if err := validators.ValidateCustomApiHost(hostFlag); err != nil {
+		return "", false, fmt.Errorf("invalid -host '%s': %v", hostFlag, err)
+	}
+	return hostFlag, true, nil
+}
+
+// providerConnectionSettings returns the provider block attributes needed to reach a custom host, a proxy or a host
+// with a private CA, so that terraform uses the same connection settings as the import script
+func providerConnectionSettings(isCustomHost bool, caCertFile, proxyUrl string, insecureSkipVerify bool) string {
+	var settings string
+	if isCustomHost {
+		settings += "\tallow_custom_host = true\n"
+	}
+	if caCertFile != "" {
+		settings += fmt.Sprintf("\tca_cert_file = %q\n", caCertFile)
+	}
+	if proxyUrl != "" {
+		settings += fmt.Sprintf("\tproxy_url = %q\n", proxyUrl)
+	}
+	if insecureSkipVerify {
+		settings += "\tinsecure_skip_verify = true\n"
+	}
+	return settings
+}
+
 // checkRequiredArguments checks if the required arguments are provided
 func checkRequiredArguments(resourcesPtr string, tokenPtr string, organizationIdPtr string) error {
 	var missingArgs []string
@@ -0,0 +1,366 @@
+package clients
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	// CassetteEnvVar is the path of a cassette the provider records its API traffic to or replays it from
+	CassetteEnvVar = "ASTRO_HTTP_CASSETTE"
+	// CassetteModeEnvVar is the CassetteMode of the cassette set by CassetteEnvVar. Defaults to replay.
+	CassetteModeEnvVar = "ASTRO_HTTP_CASSETTE_MODE"
+
+	// redacted replaces the secrets of recorded requests and responses
+	redacted = "REDACTED"
+)
+
+// CassetteMode is whether a CassetteTransport records or replays API traffic
+type CassetteMode string
+
+const (
+	// CassetteModeRecord sends requests to the API and records them with their responses
+	CassetteModeRecord CassetteMode = "record"
+	// CassetteModeReplay answers requests with recorded responses without sending them
+	CassetteModeReplay CassetteMode = "replay"
+)
+
+// redactedJSONKeys are the JSON fields of request and response bodies that hold secrets, e.g. the value of a new API
+// token, the credentials of a notification channel or the bearer token of a metrics export
+var redactedJSONKeys = map[string]bool{
+	"token":              true,
+	"apiKey":             true,
+	"integrationKey":     true,
+	"webhookUrl":         true,
+	"deploymentApiToken": true,
+	"password":           true,
+	"basicToken":         true,
+}
+
+// secretValueJSONKey is the JSON field holding the value of environment variables and Airflow variables, which is only
+// redacted when they are secret, see isSecretObject
+const secretValueJSONKey = "value"
+
+// Cassette is a sanitized recording of API requests and their responses
+type Cassette struct {
+	// Variables are values a test needs to send the recorded requests again, e.g. the ID of the deployment it reads
+	Variables    map[string]string `json:"variables,omitempty"`
+	Interactions []Interaction     `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response. The API host and headers are not recorded.
+type Interaction struct {
+	Method     string          `json:"method"`
+	Url        string          `json:"url"`
+	Request    json.RawMessage `json:"request,omitempty"`
+	StatusCode int             `json:"statusCode"`
+	Response   json.RawMessage `json:"response,omitempty"`
+}
+
+// LoadCassette reads a cassette written by a recording CassetteTransport
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette '%s': %w", path, err)
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("failed to parse cassette '%s': %w", path, err)
+	}
+	return &cassette, nil
+}
+
+// CassetteTransport is an http.RoundTripper that records API traffic to a cassette or replays it from one. It sits
+// below the other transports of the shared HTTP client, so all the API clients are recorded and replayed alike.
+type CassetteTransport struct {
+	base     http.RoundTripper
+	path     string
+	mode     CassetteMode
+	mu       sync.Mutex
+	cassette *Cassette
+	// replayed is the number of times each recorded request has been replayed
+	replayed map[string]int
+}
+
+// NewCassetteTransport returns a CassetteTransport for the cassette at path. When recording, requests are sent with
+// base and the cassette is written after every response. The interactions are appended to the cassette if it exists,
+// so that a recording can span several provider processes, e.g. the terraform commands of a multi-step acceptance
+// test; remove the cassette to record it from scratch. When replaying, the cassette must exist and base is not used.
+func NewCassetteTransport(base http.RoundTripper, path string, mode CassetteMode) (*CassetteTransport, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	transport := &CassetteTransport{base: base, path: path, mode: mode, replayed: map[string]int{}}
+	switch mode {
+	case CassetteModeRecord:
+		transport.cassette = &Cassette{Interactions: []Interaction{}}
+		if _, err := os.Stat(path); err == nil {
+			cassette, err := LoadCassette(path)
+			if err != nil {
+				return nil, err
+			}
+			transport.cassette = cassette
+		}
+	case CassetteModeReplay:
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		transport.cassette = cassette
+	default:
+		return nil, fmt.Errorf("invalid cassette mode '%s', expected %s or %s", mode, CassetteModeRecord, CassetteModeReplay)
+	}
+	return transport, nil
+}
+
+var (
+	envCassetteTransportsMu sync.Mutex
+	// envCassetteTransports are the transports of CassetteTransportFromEnv by cassette path
+	envCassetteTransports = map[string]*CassetteTransport{}
+)
+
+// CassetteTransportFromEnv wraps base with a CassetteTransport when CassetteEnvVar is set, and returns base otherwise.
+// The provider is configured once per terraform command, and several times in a process running acceptance tests, so
+// the transport of a cassette is created once per process with the first base and shared by the later calls.
+func CassetteTransportFromEnv(base http.RoundTripper) (http.RoundTripper, error) {
+	path := os.Getenv(CassetteEnvVar)
+	if path == "" {
+		return base, nil
+	}
+	mode := CassetteMode(os.Getenv(CassetteModeEnvVar))
+	if mode == "" {
+		mode = CassetteModeReplay
+	}
+
+	envCassetteTransportsMu.Lock()
+	defer envCassetteTransportsMu.Unlock()
+	if transport, ok := envCassetteTransports[path]; ok && transport.mode == mode {
+		return transport, nil
+	}
+	transport, err := NewCassetteTransport(base, path, mode)
+	if err != nil {
+		return nil, err
+	}
+	envCassetteTransports[path] = transport
+	return transport, nil
+}
+
+// SetVariable records a value a test needs to replay the cassette
+func (t *CassetteTransport) SetVariable(key, value string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.cassette.Variables == nil {
+		t.cassette.Variables = map[string]string{}
+	}
+	t.cassette.Variables[key] = value
+}
+
+// Variable returns a value recorded with SetVariable
+func (t *CassetteTransport) Variable(key string) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.cassette.Variables[key]
+}
+
+// Save writes the cassette to its path. Recording transports save after every response, so this is only needed to
+// persist variables set after the last request.
+func (t *CassetteTransport) Save() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.save()
+}
+
+func (t *CassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	requestBody, err := readBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	secrets := requestSecrets(req)
+	interaction := Interaction{
+		Method:  req.Method,
+		Url:     req.URL.RequestURI(),
+		Request: sanitize(requestBody, secrets),
+	}
+
+	if t.mode == CassetteModeReplay {
+		return t.replay(req, interaction)
+	}
+
+	if requestBody != nil {
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := readBody(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+	interaction.StatusCode = resp.StatusCode
+	interaction.Response = sanitize(responseBody, secrets)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.cassette.Interactions = append(t.cassette.Interactions, interaction)
+	if err := t.save(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// replay answers req with the recorded response of the same request. Identical requests, e.g. status polling, are
+// answered in the order they were recorded, and the last response is repeated once they are exhausted.
+func (t *CassetteTransport) replay(req *http.Request, interaction Interaction) (*http.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := interactionKey(interaction)
+	var matches []Interaction
+	for _, recorded := range t.cassette.Interactions {
+		if interactionKey(recorded) == key {
+			matches = append(matches, recorded)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no interaction recorded in cassette '%s' for %s %s", t.path, interaction.Method, interaction.Url)
+	}
+	recorded := matches[min(t.replayed[key], len(matches)-1)]
+	t.replayed[key]++
+
+	body := []byte(recorded.Response)
+	header := http.Header{}
+	var text string
+	if json.Unmarshal(recorded.Response, &text) == nil {
+		body = []byte(text)
+	} else if len(body) > 0 {
+		header.Set("Content-Type", "application/json")
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func (t *CassetteTransport) save() error {
+	data, err := json.MarshalIndent(t.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(t.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+	if err := os.WriteFile(t.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write cassette '%s': %w", t.path, err)
+	}
+	return nil
+}
+
+// interactionKey identifies the requests a recorded response can answer. Request bodies are compacted since cassettes
+// are saved indented.
+func interactionKey(interaction Interaction) string {
+	var body bytes.Buffer
+	if len(interaction.Request) > 0 {
+		_ = json.Compact(&body, interaction.Request)
+	}
+	return interaction.Method + " " + interaction.Url + " " + body.String()
+}
+
+func readBody(body io.ReadCloser) ([]byte, error) {
+	if body == nil || body == http.NoBody {
+		return nil, nil
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body: %w", err)
+	}
+	return data, nil
+}
+
+// requestSecrets returns the values sent by req that must not be recorded anywhere, e.g. its API token
+func requestSecrets(req *http.Request) []string {
+	token := strings.TrimSpace(strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer"))
+	if token == "" {
+		return nil
+	}
+	return []string{token}
+}
+
+// sanitize returns body as compact JSON with the values of redactedJSONKeys and any occurrence of secrets redacted.
+// Bodies that are not JSON are recorded as a JSON string.
+func sanitize(body []byte, secrets []string) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+	for _, secret := range secrets {
+		body = bytes.ReplaceAll(body, []byte(secret), []byte(redacted))
+	}
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		data, _ := json.Marshal(string(body))
+		return data
+	}
+	data, err := json.Marshal(redact(value))
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// redact replaces the values of redactedJSONKeys, and the values of secret variables, in a decoded JSON value
+func redact(value any) any {
+	return redactSecrets(value, false)
+}
+
+// redactSecrets redacts value, whose enclosing objects are secret when secret is set
+func redactSecrets(value any, secret bool) any {
+	switch v := value.(type) {
+	case map[string]any:
+		secret = secret || isSecretObject(v)
+		for key, field := range v {
+			if s, ok := field.(string); ok && s != "" && (redactedJSONKeys[key] || secret && key == secretValueJSONKey) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactSecrets(field, secret)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactSecrets(item, secret)
+		}
+	}
+	return value
+}
+
+// isSecretObject returns whether a JSON object is a secret variable, i.e. it has isSecret set, or describes one in a
+// field, e.g. an environment object whose airflowVariable is secret and whose overrides then hold secret values too
+func isSecretObject(object map[string]any) bool {
+	if isSecret, _ := object["isSecret"].(bool); isSecret {
+		return true
+	}
+	for _, field := range object {
+		if child, ok := field.(map[string]any); ok {
+			if isSecret, _ := child["isSecret"].(bool); isSecret {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,194 @@
+package clients_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+)
+
+func TestUnit_CassetteTransport(t *testing.T) {
+	const secret = "secret-api-token"
+
+	record := func(t *testing.T, path string, handler http.HandlerFunc, requests func(client *http.Client, url string)) {
+		t.Helper()
+		srv := httptest.NewServer(handler)
+		defer srv.Close()
+		transport, err := clients.NewCassetteTransport(nil, path, clients.CassetteModeRecord)
+		require.NoError(t, err)
+		requests(&http.Client{Transport: transport}, srv.URL)
+	}
+	send := func(t *testing.T, client *http.Client, method, url, body string) (int, string) {
+		t.Helper()
+		req, err := http.NewRequest(method, url, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+secret)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(data)
+	}
+
+	t.Run("redacts secrets from the recorded cassette", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "cassette.json")
+		record(t, path, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"token-id","token":"new-token-value","description":"created with ` + secret + `"}`))
+		}, func(client *http.Client, url string) {
+			status, body := send(t, client, http.MethodPost, url+"/tokens", `{"name":"token"}`)
+			assert.Equal(t, http.StatusOK, status)
+			// the caller still gets the response as sent by the API
+			assert.Contains(t, body, "new-token-value")
+		})
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), secret)
+		assert.NotContains(t, string(data), "new-token-value")
+		assert.Contains(t, string(data), `"token": "REDACTED"`)
+		assert.Contains(t, string(data), `"id": "token-id"`)
+	})
+
+	t.Run("redacts the values of secret variables", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "cassette.json")
+		record(t, path, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"environmentVariables":[{"key":"SECRET","isSecret":true,"value":"secret-env-value"},{"key":"PLAIN","isSecret":false,"value":"plain-env-value"}]}`))
+		}, func(client *http.Client, url string) {
+			send(t, client, http.MethodPost, url+"/deployments/id", `{"environmentVariables":[{"key":"SECRET","isSecret":true,"value":"secret-env-value"},{"key":"PLAIN","isSecret":false,"value":"plain-env-value"}]}`)
+			send(t, client, http.MethodPost, url+"/environment-objects", `{"objectKey":"variable","objectType":"AIRFLOW_VARIABLE","airflowVariable":{"isSecret":true,"value":"secret-variable-value"},"overrides":{"airflowVariable":{"value":"secret-override-value"}}}`)
+		})
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "secret-env-value")
+		assert.NotContains(t, string(data), "secret-variable-value")
+		assert.NotContains(t, string(data), "secret-override-value")
+		assert.Contains(t, string(data), "plain-env-value")
+	})
+
+	t.Run("redacts the bearer token of a basic-auth metrics export", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "cassette.json")
+		record(t, path, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"object-id"}`))
+		}, func(client *http.Client, url string) {
+			send(t, client, http.MethodPost, url+"/environment-objects", `{"objectKey":"metrics","objectType":"METRICS_EXPORT","metricsExport":{"authType":"BASIC","endpoint":"https://metrics.example.com","exporterType":"PROMETHEUS","basicToken":"metrics-bearer-token","username":"metrics","password":"metrics-password"}}`)
+		})
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "metrics-bearer-token")
+		assert.NotContains(t, string(data), "metrics-password")
+		assert.Contains(t, string(data), `"username": "metrics"`)
+	})
+
+	t.Run("replays recorded responses in order", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "cassette.json")
+		statuses := []string{"CREATING", "CREATED"}
+		reads := 0
+		record(t, path, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"status":"` + statuses[reads] + `"}`))
+			reads++
+		}, func(client *http.Client, url string) {
+			send(t, client, http.MethodGet, url+"/clusters/id", "")
+			send(t, client, http.MethodGet, url+"/clusters/id", "")
+		})
+
+		transport, err := clients.NewCassetteTransport(nil, path, clients.CassetteModeReplay)
+		require.NoError(t, err)
+		client := &http.Client{Transport: transport}
+		for _, expected := range []string{"CREATING", "CREATED", "CREATED"} {
+			status, body := send(t, client, http.MethodGet, "https://api.astronomer.io/clusters/id", "")
+			assert.Equal(t, http.StatusOK, status)
+			assert.JSONEq(t, `{"status":"`+expected+`"}`, body)
+		}
+	})
+
+	t.Run("matches requests by body", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "cassette.json")
+		record(t, path, func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			if strings.Contains(string(body), "invalid") {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte("invalid name"))
+				return
+			}
+			_, _ = w.Write([]byte(`{"name":"valid"}`))
+		}, func(client *http.Client, url string) {
+			send(t, client, http.MethodPost, url+"/workspaces", `{"name":"valid"}`)
+			send(t, client, http.MethodPost, url+"/workspaces", `{"name":"invalid"}`)
+		})
+
+		transport, err := clients.NewCassetteTransport(nil, path, clients.CassetteModeReplay)
+		require.NoError(t, err)
+		client := &http.Client{Transport: transport}
+		status, body := send(t, client, http.MethodPost, "https://api.astronomer.io/workspaces", `{ "name": "invalid" }`)
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "invalid name", body)
+		status, _ = send(t, client, http.MethodPost, "https://api.astronomer.io/workspaces", `{"name":"valid"}`)
+		assert.Equal(t, http.StatusOK, status)
+
+		req, err := http.NewRequest(http.MethodPost, "https://api.astronomer.io/workspaces", strings.NewReader(`{"name":"other"}`))
+		require.NoError(t, err)
+		_, err = client.Do(req)
+		assert.ErrorContains(t, err, "no interaction recorded")
+	})
+
+	t.Run("appends to an existing cassette", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "cassette.json")
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"path":"` + r.URL.Path + `"}`))
+		}
+		// e.g. the plan and apply commands of an acceptance test step, each configuring the provider
+		record(t, path, handler, func(client *http.Client, url string) {
+			send(t, client, http.MethodGet, url+"/workspaces/plan", "")
+		})
+		record(t, path, handler, func(client *http.Client, url string) {
+			send(t, client, http.MethodGet, url+"/workspaces/apply", "")
+		})
+
+		cassette, err := clients.LoadCassette(path)
+		require.NoError(t, err)
+		require.Len(t, cassette.Interactions, 2)
+		assert.Equal(t, "/workspaces/plan", cassette.Interactions[0].Url)
+		assert.Equal(t, "/workspaces/apply", cassette.Interactions[1].Url)
+	})
+
+	t.Run("shares the transport of a cassette in a process", func(t *testing.T) {
+		t.Setenv(clients.CassetteEnvVar, filepath.Join(t.TempDir(), "cassette.json"))
+		t.Setenv(clients.CassetteModeEnvVar, string(clients.CassetteModeRecord))
+		first, err := clients.CassetteTransportFromEnv(http.DefaultTransport)
+		require.NoError(t, err)
+		second, err := clients.CassetteTransportFromEnv(http.DefaultTransport)
+		require.NoError(t, err)
+		assert.Same(t, first, second)
+	})
+
+	t.Run("fails to replay a missing cassette", func(t *testing.T) {
+		_, err := clients.NewCassetteTransport(nil, filepath.Join(t.TempDir(), "missing.json"), clients.CassetteModeReplay)
+		assert.ErrorContains(t, err, "failed to read cassette")
+	})
+
+	t.Run("is only used when configured", func(t *testing.T) {
+		t.Setenv(clients.CassetteEnvVar, "")
+		transport, err := clients.CassetteTransportFromEnv(http.DefaultTransport)
+		require.NoError(t, err)
+		assert.Equal(t, http.DefaultTransport, transport)
+
+		t.Setenv(clients.CassetteEnvVar, filepath.Join(t.TempDir(), "cassette.json"))
+		t.Setenv(clients.CassetteModeEnvVar, "invalid")
+		_, err = clients.CassetteTransportFromEnv(http.DefaultTransport)
+		assert.ErrorContains(t, err, "invalid cassette mode")
+	})
+}
@@ -8,14 +8,16 @@ import (
 	"github.com/astronomer/terraform-provider-astro/internal/clients"
 )
 
-func NewIamClient(host, token, version string) (*ClientWithResponses, error) {
+// NewIamClient creates an IAM API client. opts are applied after the request editor, e.g. WithHTTPClient to send requests
+// through the HTTP client shared by all the API clients of the provider.
+func NewIamClient(host, token, version string, opts ...ClientOption) (*ClientWithResponses, error) {
 	// we append base url in request editor, so set to an empty string here
-	cl, err := NewClientWithResponses(
-		"",
+	opts = append([]ClientOption{
 		WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
 			baseUrl := fmt.Sprintf("%s/iam/v1beta1", host)
 			return clients.CoreRequestEditor(ctx, req, baseUrl, token, version)
 		}),
-	)
+	}, opts...)
+	cl, err := NewClientWithResponses("", opts...)
 	return cl, err
 }
@@ -0,0 +1,174 @@
+package clients
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// maxThrottledRetries is the number of times a request rejected with 429 Too Many Requests is retried
+	maxThrottledRetries = 5
+	// throttledRetryBaseDelay is the delay before the first retry of a throttled request when the API does not
+	// send a Retry-After header. It doubles on every following retry.
+	throttledRetryBaseDelay = 1 * time.Second
+	// throttledRetryMaxDelay caps the delay between retries of a throttled request
+	throttledRetryMaxDelay = 30 * time.Second
+)
+
+// TransportConfig holds the TLS and proxy settings of the HTTP transport shared by the API clients
+type TransportConfig struct {
+	CaCertFile         string
+	ProxyUrl           string
+	InsecureSkipVerify bool
+}
+
+// NewBaseTransport returns a copy of http.DefaultTransport with the TLS and proxy settings of config applied
+func NewBaseTransport(config TransportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.ProxyUrl != "" {
+		proxyUrl, err := url.Parse(config.ProxyUrl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL '%s': %w", config.ProxyUrl, err)
+		}
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	if config.CaCertFile != "" || config.InsecureSkipVerify {
+		tlsConfig := &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: config.InsecureSkipVerify,
+		}
+		if config.CaCertFile != "" {
+			caCert, err := os.ReadFile(config.CaCertFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read CA certificate file '%s': %w", config.CaCertFile, err)
+			}
+			// Trust the CA bundle in addition to the system certificates
+			rootCAs, err := x509.SystemCertPool()
+			if err != nil {
+				rootCAs = x509.NewCertPool()
+			}
+			if !rootCAs.AppendCertsFromPEM(caCert) {
+				return nil, fmt.Errorf("no PEM encoded certificates found in CA certificate file '%s'", config.CaCertFile)
+			}
+			tlsConfig.RootCAs = rootCAs
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return transport, nil
+}
+
+// RateLimitedTransport is an http.RoundTripper that bounds the number of in-flight requests, paces the rate at which
+// requests are sent and retries requests rejected with 429 Too Many Requests. A single instance is shared by all the
+// API clients so the limits apply to the provider as a whole.
+type RateLimitedTransport struct {
+	base      http.RoundTripper
+	semaphore chan struct{}
+	limiter   *rate.Limiter
+}
+
+// NewRateLimitedTransport wraps base with a RateLimitedTransport. A maxConcurrentRequests or requestsPerSecond of 0
+// disables the corresponding limit.
+func NewRateLimitedTransport(base http.RoundTripper, maxConcurrentRequests int64, requestsPerSecond float64) *RateLimitedTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	transport := &RateLimitedTransport{base: base}
+	if maxConcurrentRequests > 0 {
+		transport.semaphore = make(chan struct{}, maxConcurrentRequests)
+	}
+	if requestsPerSecond > 0 {
+		// Allow a burst of one second's worth of requests, and at least one request
+		burst := int(requestsPerSecond)
+		if burst < 1 {
+			burst = 1
+		}
+		transport.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+	return transport
+}
+
+func (t *RateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		resp, err := t.roundTrip(ctx, req)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests || attempt >= maxThrottledRetries {
+			return resp, err
+		}
+
+		// The request can only be retried if its body can be sent again
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			return resp, nil
+		}
+
+		delay := throttledRetryDelay(resp, attempt)
+		tflog.Debug(ctx, "request throttled by the Astro API, retrying", map[string]interface{}{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"attempt": attempt + 1,
+			"delay":   delay.String(),
+		})
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		req = req.Clone(ctx)
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// roundTrip sends a single request once the concurrency and rate limits allow it
+func (t *RateLimitedTransport) roundTrip(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if t.semaphore != nil {
+		select {
+		case t.semaphore <- struct{}{}:
+			defer func() { <-t.semaphore }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return t.base.RoundTrip(req)
+}
+
+// throttledRetryDelay returns the delay requested by the Retry-After header of a throttled response, or an
+// exponential backoff if the header is missing or invalid
+func throttledRetryDelay(resp *http.Response, attempt int) time.Duration {
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, throttledRetryMaxDelay)
+		}
+		if retryAt, err := http.ParseTime(retryAfter); err == nil {
+			return min(max(time.Until(retryAt), 0), throttledRetryMaxDelay)
+		}
+	}
+	return min(throttledRetryBaseDelay<<attempt, throttledRetryMaxDelay)
+}
@@ -0,0 +1,183 @@
+package clients_test
+
+import (
+	"bytes"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+)
+
+func TestUnit_RateLimitedTransport(t *testing.T) {
+	t.Run("limits the number of concurrent requests", func(t *testing.T) {
+		var inFlight, maxInFlight int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			current := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				observed := atomic.LoadInt32(&maxInFlight)
+				if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		client := &http.Client{Transport: clients.NewRateLimitedTransport(nil, 2, 0)}
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, err := client.Get(srv.URL)
+				if assert.NoError(t, err) {
+					resp.Body.Close()
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(2))
+	})
+
+	t.Run("paces requests", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		client := &http.Client{Transport: clients.NewRateLimitedTransport(nil, 0, 20)}
+		start := time.Now()
+		// The first 20 requests use the burst, the next 10 are paced at 20 per second
+		for i := 0; i < 30; i++ {
+			resp, err := client.Get(srv.URL)
+			require.NoError(t, err)
+			resp.Body.Close()
+		}
+
+		assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+	})
+
+	t.Run("retries throttled requests with their body", func(t *testing.T) {
+		var attempts int32
+		var bodies []string
+		var mu sync.Mutex
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			bodies = append(bodies, string(body))
+			mu.Unlock()
+			if atomic.AddInt32(&attempts, 1) < 3 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		client := &http.Client{Transport: clients.NewRateLimitedTransport(nil, 0, 0)}
+		resp, err := client.Post(srv.URL, "application/json", bytes.NewReader([]byte(`{"name":"test"}`)))
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+		assert.Equal(t, []string{`{"name":"test"}`, `{"name":"test"}`, `{"name":"test"}`}, bodies)
+	})
+
+	t.Run("returns the throttled response once retries are exhausted", func(t *testing.T) {
+		var attempts int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&attempts, 1)
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer srv.Close()
+
+		client := &http.Client{Transport: clients.NewRateLimitedTransport(nil, 0, 0)}
+		resp, err := client.Get(srv.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.Equal(t, int32(6), atomic.LoadInt32(&attempts))
+	})
+}
+
+func TestUnit_NewBaseTransport(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	t.Run("rejects an untrusted certificate by default", func(t *testing.T) {
+		transport, err := clients.NewBaseTransport(clients.TransportConfig{})
+		require.NoError(t, err)
+
+		_, err = (&http.Client{Transport: transport}).Get(srv.URL)
+		assert.Error(t, err)
+	})
+
+	t.Run("trusts the CA certificate file", func(t *testing.T) {
+		caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+		caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+		require.NoError(t, os.WriteFile(caCertFile, caCert, 0600))
+
+		transport, err := clients.NewBaseTransport(clients.TransportConfig{CaCertFile: caCertFile})
+		require.NoError(t, err)
+
+		resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("errors if the CA certificate file has no certificates", func(t *testing.T) {
+		caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, os.WriteFile(caCertFile, []byte("not a certificate"), 0600))
+
+		_, err := clients.NewBaseTransport(clients.TransportConfig{CaCertFile: caCertFile})
+		assert.ErrorContains(t, err, "no PEM encoded certificates found")
+	})
+
+	t.Run("skips certificate verification", func(t *testing.T) {
+		transport, err := clients.NewBaseTransport(clients.TransportConfig{InsecureSkipVerify: true})
+		require.NoError(t, err)
+
+		resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("sends requests through the proxy", func(t *testing.T) {
+		var proxied int32
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&proxied, 1)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer proxy.Close()
+
+		transport, err := clients.NewBaseTransport(clients.TransportConfig{ProxyUrl: proxy.URL})
+		require.NoError(t, err)
+
+		resp, err := (&http.Client{Transport: transport}).Get("http://astro-api.example.com/v1")
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, int32(1), atomic.LoadInt32(&proxied))
+	})
+}
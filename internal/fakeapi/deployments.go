@@ -0,0 +1,524 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/lucsky/cuid"
+	"github.com/samber/lo"
+)
+
+// runtimeReleases are the Astro Runtime releases offered by the deployment options, latest first
+var runtimeReleases = []platform.RuntimeRelease{
+	{Version: "3.0-4", AirflowVersion: "3.0.3", Channel: "stable", ReleaseDate: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)},
+	{Version: "12.9.0", AirflowVersion: "2.10.5", Channel: "stable", ReleaseDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)},
+	{Version: "11.19.0", AirflowVersion: "2.9.3", Channel: "stable", ReleaseDate: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)},
+	{Version: "9.18.0", AirflowVersion: "2.7.3", Channel: "deprecated", ReleaseDate: time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)},
+}
+
+// machineSpecs maps the scheduler and worker machine names to their CPU and memory
+var machineSpecs = map[string]platform.MachineSpec{
+	"SMALL":       {Cpu: "1", Memory: "2Gi"},
+	"MEDIUM":      {Cpu: "2", Memory: "4Gi"},
+	"LARGE":       {Cpu: "4", Memory: "8Gi"},
+	"EXTRA_LARGE": {Cpu: "8", Memory: "16Gi"},
+	"A5":          {Cpu: "1", Memory: "2Gi"},
+	"A10":         {Cpu: "2", Memory: "4Gi"},
+	"A20":         {Cpu: "4", Memory: "8Gi"},
+	"A40":         {Cpu: "8", Memory: "16Gi"},
+	"A60":         {Cpu: "12", Memory: "24Gi"},
+	"A120":        {Cpu: "24", Memory: "48Gi"},
+	"A160":        {Cpu: "32", Memory: "64Gi"},
+}
+
+// deploymentRequest holds the fields of every variant of the create and update deployment requests
+type deploymentRequest struct {
+	AstroRuntimeVersion  string                                           `json:"astroRuntimeVersion"`
+	CloudProvider        *string                                          `json:"cloudProvider"`
+	ClusterId            *string                                          `json:"clusterId"`
+	ContactEmails        *[]string                                        `json:"contactEmails"`
+	DefaultTaskPodCpu    *string                                          `json:"defaultTaskPodCpu"`
+	DefaultTaskPodMemory *string                                          `json:"defaultTaskPodMemory"`
+	Description          *string                                          `json:"description"`
+	DrWorkloadIdentity   *string                                          `json:"drWorkloadIdentity"`
+	EnvironmentVariables *[]platform.DeploymentEnvironmentVariableRequest `json:"environmentVariables"`
+	Executor             string                                           `json:"executor"`
+	IsCicdEnforced       bool                                             `json:"isCicdEnforced"`
+	IsDagDeployEnabled   bool                                             `json:"isDagDeployEnabled"`
+	IsDevelopmentMode    *bool                                            `json:"isDevelopmentMode"`
+	IsHighAvailability   *bool                                            `json:"isHighAvailability"`
+	Name                 string                                           `json:"name"`
+	Region               *string                                          `json:"region"`
+	RemoteExecution      *platform.DeploymentRemoteExecutionRequest       `json:"remoteExecution"`
+	ResourceQuotaCpu     *string                                          `json:"resourceQuotaCpu"`
+	ResourceQuotaMemory  *string                                          `json:"resourceQuotaMemory"`
+	ScalingSpec          *platform.DeploymentScalingSpecRequest           `json:"scalingSpec"`
+	Scheduler            *platform.DeploymentInstanceSpecRequest          `json:"scheduler"`
+	SchedulerSize        *string                                          `json:"schedulerSize"`
+	TaskPodNodePoolId    *string                                          `json:"taskPodNodePoolId"`
+	Type                 string                                           `json:"type"`
+	WorkerQueues         *[]workerQueueRequest                            `json:"workerQueues"`
+	WorkloadIdentity     *string                                          `json:"workloadIdentity"`
+	WorkspaceId          string                                           `json:"workspaceId"`
+}
+
+// workerQueueRequest holds the fields of the hosted and hybrid worker queue requests
+type workerQueueRequest struct {
+	AstroMachine      *string `json:"astroMachine"`
+	Id                *string `json:"id"`
+	IsDefault         bool    `json:"isDefault"`
+	MaxWorkerCount    int     `json:"maxWorkerCount"`
+	MinWorkerCount    int     `json:"minWorkerCount"`
+	Name              string  `json:"name"`
+	NodePoolId        *string `json:"nodePoolId"`
+	WorkerConcurrency int     `json:"workerConcurrency"`
+}
+
+func (s *Server) getDeploymentOptions(org *organization, r *http.Request) (int, any) {
+	schedulerMachines := lo.Map([]string{"SMALL", "MEDIUM", "LARGE", "EXTRA_LARGE"}, func(name string, _ int) platform.SchedulerMachine {
+		return platform.SchedulerMachine{Name: platform.SchedulerMachineName(name), Spec: machineSpecs[name]}
+	})
+	workerMachines := lo.Map([]string{"A5", "A10", "A20", "A40", "A60", "A120", "A160"}, func(name string, _ int) platform.WorkerMachine {
+		return platform.WorkerMachine{
+			Name:        platform.WorkerMachineName(name),
+			Spec:        machineSpecs[name],
+			Concurrency: platform.Range{Floor: 1, Default: 5, Ceiling: 256},
+		}
+	})
+	return http.StatusOK, platform.DeploymentOptions{
+		Executors:       []string{"CELERY", "KUBERNETES"},
+		RuntimeReleases: runtimeReleases,
+		ResourceQuotas: platform.ResourceQuotaOptions{
+			DefaultPodSize: platform.ResourceOption{
+				Cpu:    platform.ResourceRange{Floor: "0.25", Default: "1", Ceiling: "6"},
+				Memory: platform.ResourceRange{Floor: "0.5Gi", Default: "2Gi", Ceiling: "12Gi"},
+			},
+			ResourceQuota: platform.ResourceOption{
+				Cpu:    platform.ResourceRange{Floor: "2", Default: "10", Ceiling: "1000"},
+				Memory: platform.ResourceRange{Floor: "4Gi", Default: "20Gi", Ceiling: "2000Gi"},
+			},
+		},
+		SchedulerMachines: schedulerMachines,
+		WorkerMachines:    workerMachines,
+		WorkerQueues: platform.WorkerQueueOptions{
+			MaxWorkers:        platform.Range{Floor: 1, Default: 10, Ceiling: 30},
+			MinWorkers:        platform.Range{Floor: 0, Default: 0, Ceiling: 30},
+			WorkerConcurrency: platform.Range{Floor: 1, Default: 5, Ceiling: 256},
+		},
+		WorkloadIdentityOptions: &[]platform.WorkloadIdentityOption{
+			{Label: "Default", Role: "arn:aws:iam::123456789012:role/astro-default"},
+		},
+	}
+}
+
+func (s *Server) listDeployments(org *organization, r *http.Request) (int, any) {
+	deploymentIds, names, workspaceIds := queryValues(r, "deploymentIds"), queryValues(r, "names"), queryValues(r, "workspaceIds")
+	deployments := filter(org.deployments.list(), func(d *platform.Deployment) bool {
+		return matchesAny(deploymentIds, d.Id) && matchesAny(names, d.Name) && matchesAny(workspaceIds, d.WorkspaceId)
+	})
+	items := make([]platform.Deployment, 0, len(deployments))
+	for _, deployment := range deployments {
+		s.advanceDeployment(org, deployment)
+		items = append(items, deploymentResponse(deployment))
+	}
+	items, offset, limit := page(r, items)
+	return http.StatusOK, platform.DeploymentsPaginated{
+		Deployments: items,
+		Offset:      offset,
+		Limit:       limit,
+		TotalCount:  len(deployments),
+	}
+}
+
+func (s *Server) createDeployment(org *organization, r *http.Request) (int, any) {
+	var req deploymentRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if req.Name == "" || req.WorkspaceId == "" || req.AstroRuntimeVersion == "" {
+		return badRequest("name, workspaceId and astroRuntimeVersion are required")
+	}
+	workspace, ok := org.workspaces.get(req.WorkspaceId)
+	if !ok {
+		return badRequest("workspace with id %s not found", req.WorkspaceId)
+	}
+	release, ok := lo.Find(runtimeReleases, func(release platform.RuntimeRelease) bool {
+		return release.Version == req.AstroRuntimeVersion
+	})
+	if !ok {
+		return badRequest("astro runtime version %s is not supported", req.AstroRuntimeVersion)
+	}
+
+	id := cuid.New()
+	namespace := fmt.Sprintf("%s-%s-%s", lo.Sample(namespaceAdjectives), lo.Sample(namespaceNouns), id[len(id)-4:])
+	host := fmt.Sprintf("%s.astronomer.run/d%s", strings.ToLower(org.Id), id[len(id)-8:])
+	deployment := &platform.Deployment{
+		Id:                       id,
+		Name:                     req.Name,
+		OrganizationId:           org.Id,
+		WorkspaceId:              workspace.Id,
+		WorkspaceName:            &workspace.Name,
+		Type:                     lo.ToPtr(platform.DeploymentType(req.Type)),
+		Status:                   platform.DeploymentStatusCREATING,
+		AstroRuntimeVersion:      release.Version,
+		RuntimeVersion:           release.Version,
+		AirflowVersion:           release.AirflowVersion,
+		ImageRepository:          "quay.io/astronomer/astro-runtime",
+		ImageTag:                 release.Version,
+		ImageVersion:             lo.ToPtr(release.Version),
+		Namespace:                namespace,
+		WebServerIngressHostname: host,
+		WebServerUrl:             host + "?orgId=" + org.Id,
+		WebServerAirflowApiUrl:   host + "/api/v1",
+		ApiUrl:                   host + "/api/v1",
+		UiUrl:                    host,
+		CreatedAt:                now(),
+		CreatedBy:                org.platformSubject(),
+	}
+
+	switch req.Type {
+	case string(platform.DeploymentTypeSTANDARD):
+		deployment.CloudProvider = (*platform.DeploymentCloudProvider)(req.CloudProvider)
+		deployment.Region = req.Region
+	case string(platform.DeploymentTypeDEDICATED), string(platform.DeploymentTypeHYBRID):
+		cluster, ok := org.clusters.get(lo.FromPtr(req.ClusterId))
+		if !ok {
+			return badRequest("cluster with id %s not found", lo.FromPtr(req.ClusterId))
+		}
+		if req.Type == string(platform.DeploymentTypeHYBRID) != (cluster.Type == platform.ClusterTypeHYBRID) {
+			return badRequest("cannot create a %s deployment on %s cluster %s", req.Type, cluster.Type, cluster.Id)
+		}
+		deployment.ClusterId = &cluster.Id
+		deployment.ClusterName = &cluster.Name
+		deployment.CloudProvider = lo.ToPtr(platform.DeploymentCloudProvider(cluster.CloudProvider))
+		deployment.Region = &cluster.Region
+		if cluster.Metadata != nil {
+			deployment.ExternalIPs = cluster.Metadata.ExternalIPs
+			deployment.OidcIssuerUrl = cluster.Metadata.OidcIssuerUrl
+		}
+		if cluster.IsDrEnabled {
+			deployment.DrExternalIPs = &[]string{"203.0.113.40"}
+			deployment.DrOidcIssuerUrl = lo.ToPtr(fmt.Sprintf("https://oidc.astronomer.io/%s", strings.ToLower(cuid.New())))
+			deployment.DrWorkloadIdentity = lo.ToPtr(fmt.Sprintf("arn:aws:iam::123456789012:role/%s-dr", deployment.Namespace))
+		}
+	default:
+		return badRequest("invalid deployment type %s", req.Type)
+	}
+	if deployment.ExternalIPs == nil {
+		deployment.ExternalIPs = &[]string{"198.51.100.20"}
+	}
+
+	if code, body := applyDeploymentRequest(org, deployment, req); code != http.StatusOK {
+		return code, body
+	}
+	org.deployments.put(deployment.Id, deployment)
+	org.startTransition(deployment.Id, string(platform.DeploymentStatusHEALTHY), 0, s.DeploymentStartupTime)
+	return http.StatusOK, deploymentResponse(deployment)
+}
+
+func (s *Server) getDeployment(org *organization, r *http.Request) (int, any) {
+	deployment, ok := org.deployments.get(r.PathValue("deploymentId"))
+	if !ok {
+		return notFound("deployment", r.PathValue("deploymentId"))
+	}
+	s.advanceDeployment(org, deployment)
+	return http.StatusOK, deploymentResponse(deployment)
+}
+
+func (s *Server) updateDeployment(org *organization, r *http.Request) (int, any) {
+	deployment, ok := org.deployments.get(r.PathValue("deploymentId"))
+	if !ok {
+		return notFound("deployment", r.PathValue("deploymentId"))
+	}
+	var req deploymentRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if req.Type != string(lo.FromPtr(deployment.Type)) {
+		return badRequest("deployment type cannot be changed from %s to %s", lo.FromPtr(deployment.Type), req.Type)
+	}
+	if req.WorkspaceId != "" && req.WorkspaceId != deployment.WorkspaceId {
+		workspace, ok := org.workspaces.get(req.WorkspaceId)
+		if !ok {
+			return badRequest("workspace with id %s not found", req.WorkspaceId)
+		}
+		if deployment.ClusterId != nil {
+			cluster, _ := org.clusters.get(*deployment.ClusterId)
+			// A cluster without authorized workspaces can be used by every workspace
+			if cluster != nil && len(lo.FromPtr(cluster.WorkspaceIds)) > 0 && !lo.Contains(*cluster.WorkspaceIds, workspace.Id) {
+				return badRequest("workspace %s is not authorized to use cluster %s", workspace.Id, cluster.Id)
+			}
+		}
+		deployment.WorkspaceId = workspace.Id
+		deployment.WorkspaceName = &workspace.Name
+	}
+	if req.Name != "" {
+		deployment.Name = req.Name
+	}
+	if code, body := applyDeploymentRequest(org, deployment, req); code != http.StatusOK {
+		return code, body
+	}
+	return http.StatusOK, deploymentResponse(deployment)
+}
+
+func (s *Server) deleteDeployment(org *organization, r *http.Request) (int, any) {
+	deploymentId := r.PathValue("deploymentId")
+	if !org.deployments.remove(deploymentId) {
+		return notFound("deployment", deploymentId)
+	}
+	delete(org.transitions, deploymentId)
+	for _, token := range org.agentTokens.list() {
+		if token.deploymentId == deploymentId {
+			org.agentTokens.remove(token.Id)
+		}
+	}
+	return noContent()
+}
+
+// updateDeploymentHibernationOverride hibernates or wakes up a development deployment regardless of its hibernation
+// schedules
+func (s *Server) updateDeploymentHibernationOverride(org *organization, r *http.Request) (int, any) {
+	deployment, ok := org.deployments.get(r.PathValue("deploymentId"))
+	if !ok {
+		return notFound("deployment", r.PathValue("deploymentId"))
+	}
+	if !lo.FromPtr(deployment.IsDevelopmentMode) {
+		return badRequest("hibernation is only available for deployments in development mode")
+	}
+	var req platform.OverrideDeploymentHibernationBody
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if req.IsHibernating == nil {
+		return badRequest("isHibernating is required")
+	}
+	if req.OverrideUntil != nil && !req.OverrideUntil.After(time.Now()) {
+		return badRequest("overrideUntil must be in the future")
+	}
+	override := &platform.DeploymentHibernationOverride{
+		IsActive:      lo.ToPtr(true),
+		IsHibernating: req.IsHibernating,
+		OverrideUntil: req.OverrideUntil,
+	}
+	if deployment.ScalingSpec == nil {
+		deployment.ScalingSpec = &platform.DeploymentScalingSpec{}
+	}
+	if deployment.ScalingSpec.HibernationSpec == nil {
+		deployment.ScalingSpec.HibernationSpec = &platform.DeploymentHibernationSpec{}
+	}
+	deployment.ScalingSpec.HibernationSpec.Override = override
+	if *req.IsHibernating {
+		deployment.Status = platform.DeploymentStatusHIBERNATING
+	} else {
+		deployment.Status = platform.DeploymentStatusHEALTHY
+	}
+	return http.StatusOK, override
+}
+
+// deleteDeploymentHibernationOverride returns a development deployment to its hibernation schedules
+func (s *Server) deleteDeploymentHibernationOverride(org *organization, r *http.Request) (int, any) {
+	deployment, ok := org.deployments.get(r.PathValue("deploymentId"))
+	if !ok {
+		return notFound("deployment", r.PathValue("deploymentId"))
+	}
+	if deployment.ScalingSpec != nil && deployment.ScalingSpec.HibernationSpec != nil {
+		deployment.ScalingSpec.HibernationSpec.Override = nil
+	}
+	return noContent()
+}
+
+// advanceDeployment records a read of the deployment and applies its final status once its transition is over
+func (s *Server) advanceDeployment(org *organization, deployment *platform.Deployment) {
+	if status, done := org.advance(deployment.Id); done {
+		deployment.Status = platform.DeploymentStatus(status)
+	}
+}
+
+// applyDeploymentRequest sets the fields shared by the create and update deployment requests
+func applyDeploymentRequest(org *organization, deployment *platform.Deployment, req deploymentRequest) (int, any) {
+	deployment.Description = req.Description
+	deployment.ContactEmails = lo.ToPtr(lo.FromPtr(req.ContactEmails))
+	deployment.IsCicdEnforced = req.IsCicdEnforced
+	deployment.IsDagDeployEnabled = req.IsDagDeployEnabled
+	if req.Executor != "" {
+		deployment.Executor = lo.ToPtr(platform.DeploymentExecutor(req.Executor))
+	}
+	if req.WorkloadIdentity != nil {
+		deployment.WorkloadIdentity = req.WorkloadIdentity
+	} else if deployment.WorkloadIdentity == nil {
+		deployment.WorkloadIdentity = lo.ToPtr(fmt.Sprintf("arn:aws:iam::123456789012:role/%s", deployment.Namespace))
+	}
+	if req.DrWorkloadIdentity != nil {
+		deployment.DrWorkloadIdentity = req.DrWorkloadIdentity
+	}
+
+	if lo.FromPtr(deployment.Type) == platform.DeploymentTypeHYBRID {
+		if req.Scheduler == nil {
+			return badRequest("scheduler is required for HYBRID deployments")
+		}
+		deployment.SchedulerAu = &req.Scheduler.Au
+		deployment.SchedulerReplicas = req.Scheduler.Replicas
+		deployment.SchedulerCpu = fmt.Sprintf("%dm", req.Scheduler.Au*100)
+		deployment.SchedulerMemory = fmt.Sprintf("%dMi", req.Scheduler.Au*384)
+		deployment.TaskPodNodePoolId = req.TaskPodNodePoolId
+	} else {
+		if req.SchedulerSize == nil {
+			return badRequest("schedulerSize is required for %s deployments", lo.FromPtr(deployment.Type))
+		}
+		spec, ok := machineSpecs[*req.SchedulerSize]
+		if !ok {
+			return badRequest("invalid scheduler size %s", *req.SchedulerSize)
+		}
+		deployment.SchedulerSize = lo.ToPtr(platform.DeploymentSchedulerSize(*req.SchedulerSize))
+		deployment.SchedulerCpu = spec.Cpu
+		deployment.SchedulerMemory = spec.Memory
+		deployment.IsHighAvailability = req.IsHighAvailability
+		deployment.IsDevelopmentMode = lo.ToPtr(lo.FromPtr(req.IsDevelopmentMode))
+		deployment.SchedulerReplicas = 1
+		if lo.FromPtr(req.IsHighAvailability) {
+			deployment.SchedulerReplicas = 2
+		}
+		deployment.ResourceQuotaCpu = req.ResourceQuotaCpu
+		deployment.ResourceQuotaMemory = req.ResourceQuotaMemory
+		deployment.DefaultTaskPodCpu = req.DefaultTaskPodCpu
+		deployment.DefaultTaskPodMemory = req.DefaultTaskPodMemory
+		deployment.ScalingSpec = scalingSpec(req.ScalingSpec)
+	}
+
+	if req.RemoteExecution != nil {
+		if lo.FromPtr(deployment.Type) != platform.DeploymentTypeDEDICATED {
+			return badRequest("remote execution is only supported for DEDICATED deployments")
+		}
+		deployment.RemoteExecution = &platform.DeploymentRemoteExecution{
+			Enabled:                req.RemoteExecution.Enabled,
+			AllowedIpAddressRanges: lo.FromPtr(req.RemoteExecution.AllowedIpAddressRanges),
+			TaskLogBucket:          req.RemoteExecution.TaskLogBucket,
+			TaskLogUrlPattern:      req.RemoteExecution.TaskLogUrlPattern,
+			RemoteApiUrl:           deployment.WebServerIngressHostname + "/api/v1",
+		}
+	} else {
+		deployment.RemoteExecution = nil
+	}
+
+	deployment.EnvironmentVariables = environmentVariables(lo.FromPtr(deployment.EnvironmentVariables), lo.FromPtr(req.EnvironmentVariables))
+
+	if req.WorkerQueues != nil {
+		queues, err := workerQueues(lo.FromPtr(deployment.WorkerQueues), *req.WorkerQueues)
+		if err != nil {
+			return badRequest("%s", err)
+		}
+		deployment.WorkerQueues = &queues
+	} else {
+		deployment.WorkerQueues = nil
+	}
+
+	deployment.UpdatedAt = now()
+	deployment.UpdatedBy = org.platformSubject()
+	return http.StatusOK, nil
+}
+
+// environmentVariables returns the requested environment variables. A secret variable sent without a value keeps its
+// existing value.
+func environmentVariables(existing []platform.DeploymentEnvironmentVariable, requested []platform.DeploymentEnvironmentVariableRequest) *[]platform.DeploymentEnvironmentVariable {
+	envVars := make([]platform.DeploymentEnvironmentVariable, 0, len(requested))
+	for _, req := range requested {
+		envVar := platform.DeploymentEnvironmentVariable{
+			Key:       req.Key,
+			IsSecret:  req.IsSecret,
+			Value:     req.Value,
+			UpdatedAt: now(),
+		}
+		if e, ok := lo.Find(existing, func(e platform.DeploymentEnvironmentVariable) bool { return e.Key == req.Key }); ok {
+			if req.IsSecret && req.Value == nil {
+				envVar.Value = e.Value
+			}
+			if lo.FromPtr(envVar.Value) == lo.FromPtr(e.Value) && envVar.IsSecret == e.IsSecret {
+				envVar.UpdatedAt = e.UpdatedAt
+			}
+		}
+		envVars = append(envVars, envVar)
+	}
+	return &envVars
+}
+
+// workerQueues returns the requested worker queues, keeping the IDs of the existing queues matched by ID or name
+func workerQueues(existing []platform.WorkerQueue, requested []workerQueueRequest) ([]platform.WorkerQueue, error) {
+	queues := make([]platform.WorkerQueue, 0, len(requested))
+	for _, req := range requested {
+		queue := platform.WorkerQueue{
+			Id:                cuid.New(),
+			Name:              req.Name,
+			IsDefault:         req.IsDefault,
+			MinWorkerCount:    req.MinWorkerCount,
+			MaxWorkerCount:    req.MaxWorkerCount,
+			WorkerConcurrency: req.WorkerConcurrency,
+			AstroMachine:      req.AstroMachine,
+			NodePoolId:        req.NodePoolId,
+		}
+		if req.AstroMachine != nil {
+			spec, ok := machineSpecs[*req.AstroMachine]
+			if !ok {
+				return nil, fmt.Errorf("invalid astro machine %s", *req.AstroMachine)
+			}
+			queue.PodCpu = spec.Cpu
+			queue.PodMemory = spec.Memory
+		} else {
+			queue.PodCpu = "1"
+			queue.PodMemory = "2Gi"
+		}
+		for _, e := range existing {
+			if (req.Id != nil && e.Id == *req.Id) || (req.Id == nil && e.Name == req.Name) {
+				queue.Id = e.Id
+			}
+		}
+		queues = append(queues, queue)
+	}
+	return queues, nil
+}
+
+func scalingSpec(req *platform.DeploymentScalingSpecRequest) *platform.DeploymentScalingSpec {
+	// like the Astro API, a scaling spec without a hibernation spec is not returned
+	if req == nil || req.HibernationSpec == nil {
+		return nil
+	}
+	hibernationSpec := &platform.DeploymentHibernationSpec{Schedules: req.HibernationSpec.Schedules}
+	if override := req.HibernationSpec.Override; override != nil {
+		var overrideUntil *time.Time
+		if override.OverrideUntil != nil {
+			until, err := time.Parse(time.RFC3339, *override.OverrideUntil)
+			if err == nil {
+				overrideUntil = &until
+			}
+		}
+		isActive := override.IsHibernating != nil && (overrideUntil == nil || overrideUntil.After(time.Now()))
+		hibernationSpec.Override = &platform.DeploymentHibernationOverride{
+			IsActive:      &isActive,
+			IsHibernating: override.IsHibernating,
+			OverrideUntil: overrideUntil,
+		}
+	}
+	return &platform.DeploymentScalingSpec{HibernationSpec: hibernationSpec}
+}
+
+// deploymentResponse returns a copy of the deployment with the values of its secret environment variables removed
+func deploymentResponse(deployment *platform.Deployment) platform.Deployment {
+	response := *deployment
+	if deployment.EnvironmentVariables != nil {
+		envVars := lo.Map(*deployment.EnvironmentVariables, func(envVar platform.DeploymentEnvironmentVariable, _ int) platform.DeploymentEnvironmentVariable {
+			if envVar.IsSecret {
+				envVar.Value = nil
+			}
+			return envVar
+		})
+		response.EnvironmentVariables = &envVars
+	}
+	return response
+}
+
+var (
+	namespaceAdjectives = []string{"celestial", "cosmic", "galactic", "lunar", "nebular", "orbital", "solar", "stellar"}
+	namespaceNouns      = []string{"comet", "eclipse", "meteor", "nova", "pulsar", "quasar", "satellite", "telescope"}
+)
@@ -0,0 +1,797 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
+	"github.com/lucsky/cuid"
+	"github.com/samber/lo"
+)
+
+// tokenPrefix starts the values of the API tokens created by the fake API so they are easy to recognise in logs
+const tokenPrefix = "fake-astro-token-"
+
+func (s *Server) registerIamRoutes() {
+	s.handle("GET "+iamPrefix+"/teams", s.listTeams)
+	s.handle("POST "+iamPrefix+"/teams", s.createTeam)
+	s.handle("GET "+iamPrefix+"/teams/{teamId}", s.getTeam)
+	s.handle("POST "+iamPrefix+"/teams/{teamId}", s.updateTeam)
+	s.handle("DELETE "+iamPrefix+"/teams/{teamId}", s.deleteTeam)
+	s.handle("GET "+iamPrefix+"/teams/{teamId}/members", s.listTeamMembers)
+	s.handle("POST "+iamPrefix+"/teams/{teamId}/members", s.addTeamMembers)
+	s.handle("DELETE "+iamPrefix+"/teams/{teamId}/members/{memberId}", s.removeTeamMember)
+	s.handle("POST "+iamPrefix+"/teams/{teamId}/roles", s.updateTeamRoles)
+
+	s.handle("GET "+iamPrefix+"/users", s.listUsers)
+	s.handle("GET "+iamPrefix+"/users/{userId}", s.getUser)
+	s.handle("POST "+iamPrefix+"/users/{userId}/roles", s.updateUserRoles)
+
+	s.handle("POST "+iamPrefix+"/invites", s.createUserInvite)
+	s.handle("DELETE "+iamPrefix+"/invites/{inviteId}", s.deleteUserInvite)
+	s.handle("POST "+fakePrefix+"/invites/accept", s.acceptUserInvite)
+
+	s.handle("GET "+iamPrefix+"/tokens", s.listApiTokens)
+	s.handle("POST "+iamPrefix+"/tokens", s.createApiToken)
+	s.handle("GET "+iamPrefix+"/tokens/{tokenId}", s.getApiToken)
+	s.handle("POST "+iamPrefix+"/tokens/{tokenId}", s.updateApiToken)
+	s.handle("DELETE "+iamPrefix+"/tokens/{tokenId}", s.deleteApiToken)
+	s.handle("POST "+iamPrefix+"/tokens/{tokenId}/roles", s.updateApiTokenRoles)
+	s.handle("POST "+iamPrefix+"/tokens/{tokenId}/rotate", s.rotateApiToken)
+
+	s.handle("GET "+iamPrefix+"/deployments/{deploymentId}/agent-tokens", s.listAgentTokens)
+	s.handle("POST "+iamPrefix+"/deployments/{deploymentId}/agent-tokens", s.createAgentToken)
+	s.handle("GET "+iamPrefix+"/deployments/{deploymentId}/agent-tokens/{tokenId}", s.getAgentToken)
+	s.handle("DELETE "+iamPrefix+"/deployments/{deploymentId}/agent-tokens/{tokenId}", s.deleteAgentToken)
+
+	s.handle("GET "+iamPrefix+"/roles", s.listRoles)
+	s.handle("POST "+iamPrefix+"/roles", s.createCustomRole)
+	s.handle("GET "+iamPrefix+"/roles/{roleId}", s.getCustomRole)
+	s.handle("POST "+iamPrefix+"/roles/{roleId}", s.updateCustomRole)
+	s.handle("DELETE "+iamPrefix+"/roles/{roleId}", s.deleteCustomRole)
+
+	s.handle("GET "+iamPrefix+"/allowed-ip-address-ranges", s.listAllowedIpAddressRanges)
+}
+
+// nilIfEmpty returns nil for an empty list of roles, which the Astro API omits from its responses
+func nilIfEmpty[T any](roles *[]T) *[]T {
+	if roles == nil || len(*roles) == 0 {
+		return nil
+	}
+	return roles
+}
+
+// checkRoles returns an error if a role refers to a workspace or deployment that does not exist
+func (o *organization) checkRoles(workspaceRoles *[]iam.WorkspaceRole, deploymentRoles *[]iam.DeploymentRole, dagRoles *[]iam.DagRole) error {
+	for _, role := range lo.FromPtr(workspaceRoles) {
+		if _, ok := o.workspaces.get(role.WorkspaceId); !ok {
+			return fmt.Errorf("workspace with id %s not found", role.WorkspaceId)
+		}
+	}
+	for _, role := range lo.FromPtr(deploymentRoles) {
+		if _, ok := o.deployments.get(role.DeploymentId); !ok {
+			return fmt.Errorf("deployment with id %s not found", role.DeploymentId)
+		}
+	}
+	for _, role := range lo.FromPtr(dagRoles) {
+		if _, ok := o.deployments.get(role.DeploymentId); !ok {
+			return fmt.Errorf("deployment with id %s not found", role.DeploymentId)
+		}
+		if (role.DagId == nil) == (role.DagTag == nil) {
+			return fmt.Errorf("exactly one of dagId and dagTag must be set for a dag role")
+		}
+	}
+	return nil
+}
+
+func (s *Server) listTeams(org *organization, r *http.Request) (int, any) {
+	names := queryValues(r, "names")
+	teams := filter(org.teams.list(), func(t *iam.Team) bool {
+		return matchesAny(names, t.Name)
+	})
+	items, offset, limit := page(r, teams)
+	return http.StatusOK, iam.TeamsPaginated{
+		Teams:      derefAll(items),
+		Offset:     offset,
+		Limit:      limit,
+		TotalCount: len(teams),
+	}
+}
+
+func (s *Server) createTeam(org *organization, r *http.Request) (int, any) {
+	var req iam.CreateTeamRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if req.Name == "" {
+		return badRequest("name is required")
+	}
+	for _, memberId := range lo.FromPtr(req.MemberIds) {
+		if _, ok := org.users.get(memberId); !ok {
+			return badRequest("user with id %s not found", memberId)
+		}
+	}
+	organizationRole := iam.TeamOrganizationRoleORGANIZATIONMEMBER
+	if req.OrganizationRole != nil {
+		organizationRole = iam.TeamOrganizationRole(*req.OrganizationRole)
+	}
+	team := &iam.Team{
+		Id:               cuid.New(),
+		Name:             req.Name,
+		Description:      req.Description,
+		OrganizationId:   org.Id,
+		OrganizationRole: organizationRole,
+		CreatedAt:        now(),
+		UpdatedAt:        now(),
+		CreatedBy:        lo.ToPtr(org.iamSubject()),
+		UpdatedBy:        lo.ToPtr(org.iamSubject()),
+	}
+	org.teams.put(team.Id, team)
+	if org.teamMembers == nil {
+		org.teamMembers = map[string][]string{}
+	}
+	org.teamMembers[team.Id] = lo.Uniq(lo.FromPtr(req.MemberIds))
+	return http.StatusOK, team
+}
+
+func (s *Server) getTeam(org *organization, r *http.Request) (int, any) {
+	team, ok := org.teams.get(r.PathValue("teamId"))
+	if !ok {
+		return notFound("team", r.PathValue("teamId"))
+	}
+	return http.StatusOK, team
+}
+
+func (s *Server) updateTeam(org *organization, r *http.Request) (int, any) {
+	team, ok := org.teams.get(r.PathValue("teamId"))
+	if !ok {
+		return notFound("team", r.PathValue("teamId"))
+	}
+	var req iam.UpdateTeamRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if req.Name == "" {
+		return badRequest("name is required")
+	}
+	team.Name = req.Name
+	team.Description = req.Description
+	team.UpdatedAt = now()
+	team.UpdatedBy = lo.ToPtr(org.iamSubject())
+	return http.StatusOK, team
+}
+
+func (s *Server) deleteTeam(org *organization, r *http.Request) (int, any) {
+	if !org.teams.remove(r.PathValue("teamId")) {
+		return notFound("team", r.PathValue("teamId"))
+	}
+	delete(org.teamMembers, r.PathValue("teamId"))
+	return noContent()
+}
+
+func (s *Server) listTeamMembers(org *organization, r *http.Request) (int, any) {
+	if _, ok := org.teams.get(r.PathValue("teamId")); !ok {
+		return notFound("team", r.PathValue("teamId"))
+	}
+	var members []iam.TeamMember
+	for _, memberId := range org.teamMembers[r.PathValue("teamId")] {
+		if user, ok := org.users.get(memberId); ok {
+			members = append(members, iam.TeamMember{
+				UserId:    user.Id,
+				Username:  user.Username,
+				FullName:  &user.FullName,
+				AvatarUrl: &user.AvatarUrl,
+				CreatedAt: &user.CreatedAt,
+			})
+		}
+	}
+	items, offset, limit := page(r, members)
+	return http.StatusOK, iam.TeamMembersPaginated{
+		TeamMembers: items,
+		Offset:      offset,
+		Limit:       limit,
+		TotalCount:  len(members),
+	}
+}
+
+func (s *Server) addTeamMembers(org *organization, r *http.Request) (int, any) {
+	teamId := r.PathValue("teamId")
+	if _, ok := org.teams.get(teamId); !ok {
+		return notFound("team", teamId)
+	}
+	var req iam.AddTeamMembersRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	for _, memberId := range req.MemberIds {
+		if _, ok := org.users.get(memberId); !ok {
+			return badRequest("user with id %s not found", memberId)
+		}
+	}
+	if org.teamMembers == nil {
+		org.teamMembers = map[string][]string{}
+	}
+	org.teamMembers[teamId] = lo.Uniq(append(org.teamMembers[teamId], req.MemberIds...))
+	return noContent()
+}
+
+func (s *Server) removeTeamMember(org *organization, r *http.Request) (int, any) {
+	teamId, memberId := r.PathValue("teamId"), r.PathValue("memberId")
+	if _, ok := org.teams.get(teamId); !ok {
+		return notFound("team", teamId)
+	}
+	if !lo.Contains(org.teamMembers[teamId], memberId) {
+		return notFound("team member", memberId)
+	}
+	org.teamMembers[teamId] = lo.Without(org.teamMembers[teamId], memberId)
+	return noContent()
+}
+
+func (s *Server) updateTeamRoles(org *organization, r *http.Request) (int, any) {
+	team, ok := org.teams.get(r.PathValue("teamId"))
+	if !ok {
+		return notFound("team", r.PathValue("teamId"))
+	}
+	var req iam.UpdateTeamRolesRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if err := org.checkRoles(req.WorkspaceRoles, req.DeploymentRoles, req.DagRoles); err != nil {
+		return badRequest("%s", err)
+	}
+	team.OrganizationRole = iam.TeamOrganizationRole(req.OrganizationRole)
+	team.WorkspaceRoles = nilIfEmpty(req.WorkspaceRoles)
+	team.DeploymentRoles = nilIfEmpty(req.DeploymentRoles)
+	team.DagRoles = nilIfEmpty(req.DagRoles)
+	team.RolesCount = lo.ToPtr(1 + len(lo.FromPtr(req.WorkspaceRoles)) + len(lo.FromPtr(req.DeploymentRoles)) + len(lo.FromPtr(req.DagRoles)))
+	team.UpdatedAt = now()
+	team.UpdatedBy = lo.ToPtr(org.iamSubject())
+	return http.StatusOK, iam.SubjectRoles{
+		OrganizationRole: &req.OrganizationRole,
+		WorkspaceRoles:   team.WorkspaceRoles,
+		DeploymentRoles:  team.DeploymentRoles,
+		DagRoles:         team.DagRoles,
+	}
+}
+
+// matchesDagRoles reports whether one of dagRoles grants access to the DAG dagId or to one of the DAG tags dagTags of
+// the deployment deploymentId. Without DAG filters, every subject matches.
+func matchesDagRoles(dagRoles []iam.DagRole, deploymentId, dagId string, dagTags []string) bool {
+	if dagId == "" && len(dagTags) == 0 {
+		return true
+	}
+	return lo.ContainsBy(dagRoles, func(role iam.DagRole) bool {
+		return (deploymentId == "" || role.DeploymentId == deploymentId) &&
+			((dagId != "" && lo.FromPtr(role.DagId) == dagId) || (role.DagTag != nil && lo.Contains(dagTags, *role.DagTag)))
+	})
+}
+
+func (s *Server) listUsers(org *organization, r *http.Request) (int, any) {
+	workspaceId, deploymentId, dagId := r.URL.Query().Get("workspaceId"), r.URL.Query().Get("deploymentId"), r.URL.Query().Get("dagId")
+	dagTags := queryValues(r, "dagTags")
+	// with DAG filters, deploymentId selects the deployment of the DAG roles instead of a deployment role
+	dagFilter := dagId != "" || len(dagTags) > 0
+	users := filter(org.users.list(), func(u *iam.User) bool {
+		return (workspaceId == "" || lo.ContainsBy(lo.FromPtr(u.WorkspaceRoles), func(role iam.WorkspaceRole) bool {
+			return role.WorkspaceId == workspaceId
+		})) && (dagFilter || deploymentId == "" || lo.ContainsBy(lo.FromPtr(u.DeploymentRoles), func(role iam.DeploymentRole) bool {
+			return role.DeploymentId == deploymentId
+		})) && matchesDagRoles(lo.FromPtr(u.DagRoles), deploymentId, dagId, dagTags)
+	})
+	items, offset, limit := page(r, users)
+	return http.StatusOK, iam.UsersPaginated{
+		Users:      derefAll(items),
+		Offset:     offset,
+		Limit:      limit,
+		TotalCount: len(users),
+	}
+}
+
+func (s *Server) getUser(org *organization, r *http.Request) (int, any) {
+	user, ok := org.users.get(r.PathValue("userId"))
+	if !ok {
+		return notFound("user", r.PathValue("userId"))
+	}
+	return http.StatusOK, user
+}
+
+func (s *Server) updateUserRoles(org *organization, r *http.Request) (int, any) {
+	user, ok := org.users.get(r.PathValue("userId"))
+	if !ok {
+		return notFound("user", r.PathValue("userId"))
+	}
+	var req iam.UpdateUserRolesRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if err := org.checkRoles(req.WorkspaceRoles, req.DeploymentRoles, req.DagRoles); err != nil {
+		return badRequest("%s", err)
+	}
+	if req.OrganizationRole != nil {
+		user.OrganizationRole = lo.ToPtr(iam.UserOrganizationRole(*req.OrganizationRole))
+	}
+	user.WorkspaceRoles = nilIfEmpty(req.WorkspaceRoles)
+	user.DeploymentRoles = nilIfEmpty(req.DeploymentRoles)
+	user.DagRoles = nilIfEmpty(req.DagRoles)
+	user.UpdatedAt = now()
+	return http.StatusOK, iam.SubjectRoles{
+		OrganizationRole: (*string)(user.OrganizationRole),
+		WorkspaceRoles:   user.WorkspaceRoles,
+		DeploymentRoles:  user.DeploymentRoles,
+		DagRoles:         user.DagRoles,
+	}
+}
+
+// createUserInvite invites a user to the organization. Like in Astro, the invited user exists with the PENDING status
+// until the invite is accepted.
+func (s *Server) createUserInvite(org *organization, r *http.Request) (int, any) {
+	var req iam.CreateUserInviteRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if req.InviteeEmail == "" || req.Role == "" {
+		return badRequest("inviteeEmail and role are required")
+	}
+	user, exists := lo.Find(org.users.list(), func(u *iam.User) bool {
+		return u.Username == req.InviteeEmail
+	})
+	if exists && user.Status != iam.PENDING {
+		return apiError(http.StatusConflict, "user %s is already a member of organization %s", req.InviteeEmail, org.Id)
+	}
+	if !exists {
+		user = &iam.User{
+			Id:               cuid.New(),
+			Username:         req.InviteeEmail,
+			FullName:         req.InviteeEmail,
+			Status:           iam.PENDING,
+			OrganizationRole: lo.ToPtr(iam.UserOrganizationRole(req.Role)),
+			CreatedAt:        now(),
+			UpdatedAt:        now(),
+		}
+		org.users.put(user.Id, user)
+	}
+	invite := &iam.Invite{
+		InviteId:         cuid.New(),
+		OrganizationId:   org.Id,
+		OrganizationName: &org.Name,
+		UserId:           &user.Id,
+		Invitee:          iam.BasicSubjectProfile{Id: user.Id, Username: &user.Username, SubjectType: lo.ToPtr(iam.USER)},
+		Inviter:          org.iamSubject(),
+		ExpiresAt:        now().Add(7 * 24 * time.Hour),
+	}
+	org.invites.put(invite.InviteId, invite)
+	return http.StatusOK, invite
+}
+
+func (s *Server) deleteUserInvite(org *organization, r *http.Request) (int, any) {
+	invite, ok := org.invites.get(r.PathValue("inviteId"))
+	if !ok {
+		return notFound("invite", r.PathValue("inviteId"))
+	}
+	org.invites.remove(invite.InviteId)
+	if user, ok := org.users.get(lo.FromPtr(invite.UserId)); ok && user.Status == iam.PENDING {
+		org.users.remove(user.Id)
+	}
+	return noContent()
+}
+
+// AcceptUserInviteRequest is the body of the fake API request accepting the invites of a user
+type AcceptUserInviteRequest struct {
+	Email string `json:"email"`
+}
+
+// acceptUserInvite accepts the invites of a user, which then becomes ACTIVE like when it accepts an invite in Astro
+func (s *Server) acceptUserInvite(org *organization, r *http.Request) (int, any) {
+	var req AcceptUserInviteRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	user, ok := lo.Find(org.users.list(), func(u *iam.User) bool {
+		return u.Username == req.Email && u.Status == iam.PENDING
+	})
+	if !ok {
+		return notFound("pending user", req.Email)
+	}
+	for _, invite := range org.invites.list() {
+		if lo.FromPtr(invite.UserId) == user.Id {
+			org.invites.remove(invite.InviteId)
+		}
+	}
+	user.Status = iam.ACTIVE
+	user.UpdatedAt = now()
+	return http.StatusOK, user
+}
+
+func (s *Server) listApiTokens(org *organization, r *http.Request) (int, any) {
+	query := r.URL.Query()
+	workspaceId, deploymentId, kind := query.Get("workspaceId"), query.Get("deploymentId"), query.Get("kind")
+	dagId, dagTags := query.Get("dagId"), queryValues(r, "dagTags")
+	// with DAG filters, deploymentId selects the deployment of the DAG roles instead of a deployment role
+	dagFilter := dagId != "" || len(dagTags) > 0
+	onlyOrganization := query.Get("includeOnlyOrganizationTokens") == "true"
+	tokens := filter(org.apiTokens.list(), func(t *iam.ApiToken) bool {
+		hasRole := func(entityType iam.ApiTokenRoleEntityType, entityId string) bool {
+			return entityId == "" || lo.ContainsBy(lo.FromPtr(t.Roles), func(role iam.ApiTokenRole) bool {
+				return role.EntityType == entityType && role.EntityId == entityId
+			})
+		}
+		dagRoles := lo.FilterMap(lo.FromPtr(t.Roles), func(role iam.ApiTokenRole, _ int) (iam.DagRole, bool) {
+			dagRole := iam.DagRole{DeploymentId: lo.FromPtr(role.DeploymentId), Role: role.Role}
+			switch role.EntityType {
+			case iam.ApiTokenRoleEntityTypeDAG:
+				dagRole.DagId = lo.ToPtr(role.EntityId)
+			case iam.ApiTokenRoleEntityTypeDAGTAG:
+				dagRole.DagTag = lo.ToPtr(role.EntityId)
+			default:
+				return dagRole, false
+			}
+			return dagRole, true
+		})
+		return hasRole(iam.ApiTokenRoleEntityTypeWORKSPACE, workspaceId) &&
+			(dagFilter || hasRole(iam.ApiTokenRoleEntityTypeDEPLOYMENT, deploymentId)) &&
+			matchesDagRoles(dagRoles, deploymentId, dagId, dagTags) &&
+			(!onlyOrganization || t.Type == iam.ApiTokenTypeORGANIZATION) &&
+			(kind == "" || string(t.Kind) == kind)
+	})
+	items, offset, limit := page(r, tokens)
+	return http.StatusOK, iam.ApiTokensPaginated{
+		Tokens:     lo.Map(items, func(t *iam.ApiToken, _ int) iam.ApiToken { return withoutTokenValue(t) }),
+		Offset:     offset,
+		Limit:      limit,
+		TotalCount: len(tokens),
+	}
+}
+
+func (s *Server) createApiToken(org *organization, r *http.Request) (int, any) {
+	var req iam.CreateApiTokenRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if req.Name == "" || req.Role == "" {
+		return badRequest("name and role are required")
+	}
+	entityId := lo.FromPtr(req.EntityId)
+	switch req.Type {
+	case iam.CreateApiTokenRequestTypeORGANIZATION:
+		entityId = org.Id
+	case iam.CreateApiTokenRequestTypeWORKSPACE:
+		if _, ok := org.workspaces.get(entityId); !ok {
+			return badRequest("workspace with id %s not found", entityId)
+		}
+	case iam.CreateApiTokenRequestTypeDEPLOYMENT:
+		if _, ok := org.deployments.get(entityId); !ok {
+			return badRequest("deployment with id %s not found", entityId)
+		}
+	default:
+		return badRequest("invalid token type %s", req.Type)
+	}
+	kind := iam.ApiTokenKindSTANDARD
+	if req.Kind != nil {
+		kind = iam.ApiTokenKind(*req.Kind)
+	}
+	token := newApiToken(org, req.Name, lo.FromPtr(req.Description), req.TokenExpiryPeriodInDays)
+	token.Type = iam.ApiTokenType(req.Type)
+	token.Kind = kind
+	token.Roles = &[]iam.ApiTokenRole{{
+		EntityId:   entityId,
+		EntityType: iam.ApiTokenRoleEntityType(req.Type),
+		Role:       req.Role,
+	}}
+	org.apiTokens.put(token.Id, token)
+	return http.StatusOK, token
+}
+
+func (s *Server) getApiToken(org *organization, r *http.Request) (int, any) {
+	token, ok := org.apiTokens.get(r.PathValue("tokenId"))
+	if !ok {
+		return notFound("API token", r.PathValue("tokenId"))
+	}
+	return http.StatusOK, withoutTokenValue(token)
+}
+
+func (s *Server) updateApiToken(org *organization, r *http.Request) (int, any) {
+	token, ok := org.apiTokens.get(r.PathValue("tokenId"))
+	if !ok {
+		return notFound("API token", r.PathValue("tokenId"))
+	}
+	var req iam.UpdateApiTokenRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if req.Name == "" {
+		return badRequest("name is required")
+	}
+	token.Name = req.Name
+	token.Description = lo.FromPtr(req.Description)
+	token.UpdatedAt = now()
+	token.UpdatedBy = lo.ToPtr(org.iamSubject())
+	return http.StatusOK, withoutTokenValue(token)
+}
+
+func (s *Server) deleteApiToken(org *organization, r *http.Request) (int, any) {
+	if r.PathValue("tokenId") == org.tokenId {
+		return badRequest("the API token used for the request cannot be deleted")
+	}
+	if !org.apiTokens.remove(r.PathValue("tokenId")) {
+		return notFound("API token", r.PathValue("tokenId"))
+	}
+	return noContent()
+}
+
+func (s *Server) updateApiTokenRoles(org *organization, r *http.Request) (int, any) {
+	token, ok := org.apiTokens.get(r.PathValue("tokenId"))
+	if !ok {
+		return notFound("API token", r.PathValue("tokenId"))
+	}
+	var req iam.UpdateApiTokenRolesRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	roles := iam.SubjectRoles{}
+	for _, role := range req.Roles {
+		switch role.EntityType {
+		case iam.ApiTokenRoleEntityTypeORGANIZATION:
+			if token.Type != iam.ApiTokenTypeORGANIZATION || role.EntityId != org.Id {
+				return badRequest("invalid organization role for %s token", token.Type)
+			}
+			roles.OrganizationRole = lo.ToPtr(role.Role)
+		case iam.ApiTokenRoleEntityTypeWORKSPACE:
+			if token.Type == iam.ApiTokenTypeDEPLOYMENT {
+				return badRequest("deployment tokens cannot have workspace roles")
+			}
+			if _, ok := org.workspaces.get(role.EntityId); !ok {
+				return badRequest("workspace with id %s not found", role.EntityId)
+			}
+			roles.WorkspaceRoles = lo.ToPtr(append(lo.FromPtr(roles.WorkspaceRoles), iam.WorkspaceRole{WorkspaceId: role.EntityId, Role: iam.WorkspaceRoleRole(role.Role)}))
+		case iam.ApiTokenRoleEntityTypeDEPLOYMENT:
+			if _, ok := org.deployments.get(role.EntityId); !ok {
+				return badRequest("deployment with id %s not found", role.EntityId)
+			}
+			roles.DeploymentRoles = lo.ToPtr(append(lo.FromPtr(roles.DeploymentRoles), iam.DeploymentRole{DeploymentId: role.EntityId, Role: role.Role}))
+		case iam.ApiTokenRoleEntityTypeDAG, iam.ApiTokenRoleEntityTypeDAGTAG:
+			if _, ok := org.deployments.get(lo.FromPtr(role.DeploymentId)); !ok {
+				return badRequest("deployment with id %s not found", lo.FromPtr(role.DeploymentId))
+			}
+			dagRole := iam.DagRole{DeploymentId: *role.DeploymentId, Role: role.Role}
+			if role.EntityType == iam.ApiTokenRoleEntityTypeDAG {
+				dagRole.DagId = lo.ToPtr(role.EntityId)
+			} else {
+				dagRole.DagTag = lo.ToPtr(role.EntityId)
+			}
+			roles.DagRoles = lo.ToPtr(append(lo.FromPtr(roles.DagRoles), dagRole))
+		default:
+			return badRequest("invalid role entity type %s", role.EntityType)
+		}
+	}
+	token.Roles = &req.Roles
+	token.UpdatedAt = now()
+	token.UpdatedBy = lo.ToPtr(org.iamSubject())
+	return http.StatusOK, roles
+}
+
+func (s *Server) rotateApiToken(org *organization, r *http.Request) (int, any) {
+	token, ok := org.apiTokens.get(r.PathValue("tokenId"))
+	if !ok {
+		return notFound("API token", r.PathValue("tokenId"))
+	}
+	value := tokenPrefix + cuid.New()
+	token.Token = &value
+	token.ShortToken = value[len(value)-7:]
+	token.LastRotatedAt = lo.ToPtr(now())
+	token.UpdatedAt = now()
+	return http.StatusOK, token
+}
+
+func (s *Server) listAgentTokens(org *organization, r *http.Request) (int, any) {
+	deploymentId := r.PathValue("deploymentId")
+	if _, ok := org.deployments.get(deploymentId); !ok {
+		return notFound("deployment", deploymentId)
+	}
+	tokens := filter(org.agentTokens.list(), func(t *agentToken) bool {
+		return t.deploymentId == deploymentId
+	})
+	items, offset, limit := page(r, tokens)
+	return http.StatusOK, iam.ApiTokensPaginated{
+		Tokens:     lo.Map(items, func(t *agentToken, _ int) iam.ApiToken { return withoutTokenValue(&t.ApiToken) }),
+		Offset:     offset,
+		Limit:      limit,
+		TotalCount: len(tokens),
+	}
+}
+
+func (s *Server) createAgentToken(org *organization, r *http.Request) (int, any) {
+	deploymentId := r.PathValue("deploymentId")
+	if _, ok := org.deployments.get(deploymentId); !ok {
+		return notFound("deployment", deploymentId)
+	}
+	var req iam.CreateAgentTokenRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if req.Name == "" {
+		return badRequest("name is required")
+	}
+	token := newApiToken(org, req.Name, lo.FromPtr(req.Description), req.TokenExpiryPeriodInDays)
+	token.Type = iam.ApiTokenTypeDEPLOYMENT
+	token.Kind = iam.ApiTokenKindSTANDARD
+	token.Roles = &[]iam.ApiTokenRole{{
+		EntityId:   deploymentId,
+		EntityType: iam.ApiTokenRoleEntityTypeDEPLOYMENT,
+		Role:       "DEPLOYMENT_AGENT",
+	}}
+	org.agentTokens.put(token.Id, &agentToken{ApiToken: *token, deploymentId: deploymentId})
+	return http.StatusOK, token
+}
+
+func (s *Server) getAgentToken(org *organization, r *http.Request) (int, any) {
+	token, ok := org.agentTokens.get(r.PathValue("tokenId"))
+	if !ok || token.deploymentId != r.PathValue("deploymentId") {
+		return notFound("agent token", r.PathValue("tokenId"))
+	}
+	return http.StatusOK, withoutTokenValue(&token.ApiToken)
+}
+
+func (s *Server) deleteAgentToken(org *organization, r *http.Request) (int, any) {
+	token, ok := org.agentTokens.get(r.PathValue("tokenId"))
+	if !ok || token.deploymentId != r.PathValue("deploymentId") {
+		return notFound("agent token", r.PathValue("tokenId"))
+	}
+	org.agentTokens.remove(token.Id)
+	return noContent()
+}
+
+// newApiToken returns a token with a new value. The value is only returned when the token is created or rotated.
+func newApiToken(org *organization, name, description string, expiryPeriodInDays *int) *iam.ApiToken {
+	value := tokenPrefix + cuid.New()
+	token := &iam.ApiToken{
+		Id:                 cuid.New(),
+		Name:               name,
+		Description:        description,
+		Token:              &value,
+		ShortToken:         value[len(value)-7:],
+		ExpiryPeriodInDays: expiryPeriodInDays,
+		StartAt:            now(),
+		CreatedAt:          now(),
+		UpdatedAt:          now(),
+		CreatedBy:          lo.ToPtr(org.iamSubject()),
+		UpdatedBy:          lo.ToPtr(org.iamSubject()),
+	}
+	if expiryPeriodInDays != nil {
+		token.EndAt = lo.ToPtr(token.StartAt.AddDate(0, 0, *expiryPeriodInDays))
+	}
+	return token
+}
+
+func withoutTokenValue(token *iam.ApiToken) iam.ApiToken {
+	withoutValue := *token
+	withoutValue.Token = nil
+	return withoutValue
+}
+
+func (s *Server) listRoles(org *organization, r *http.Request) (int, any) {
+	scopeTypes := queryValues(r, "scopeTypes")
+	roles := filter(org.roles.list(), func(role *iam.RoleWithPermission) bool {
+		return matchesAny(scopeTypes, string(role.ScopeType))
+	})
+	items, offset, limit := page(r, roles)
+	resp := iam.RolesPaginated{
+		Roles:      lo.Map(items, func(role *iam.RoleWithPermission, _ int) iam.Role { return convert[iam.Role](role) }),
+		Offset:     offset,
+		Limit:      limit,
+		TotalCount: len(roles),
+	}
+	if r.URL.Query().Get("includeDefaultRoles") == "true" {
+		defaultRoles := filter(defaultRoles, func(role iam.DefaultRole) bool {
+			return matchesAny(scopeTypes, string(role.ScopeType))
+		})
+		resp.DefaultRoles = &defaultRoles
+	}
+	return http.StatusOK, resp
+}
+
+var defaultRoles = []iam.DefaultRole{
+	{Name: "ORGANIZATION_OWNER", ScopeType: iam.DefaultRoleScopeTypeORGANIZATION, Permissions: []string{"organization.get", "organization.update"}},
+	{Name: "ORGANIZATION_BILLING_ADMIN", ScopeType: iam.DefaultRoleScopeTypeORGANIZATION, Permissions: []string{"organization.get", "organization.billing.update"}},
+	{Name: "ORGANIZATION_MEMBER", ScopeType: iam.DefaultRoleScopeTypeORGANIZATION, Permissions: []string{"organization.get"}},
+	{Name: "WORKSPACE_OWNER", ScopeType: iam.DefaultRoleScopeTypeWORKSPACE, Permissions: []string{"workspace.get", "workspace.update"}},
+	{Name: "WORKSPACE_OPERATOR", ScopeType: iam.DefaultRoleScopeTypeWORKSPACE, Permissions: []string{"workspace.get", "workspace.deployments.update"}},
+	{Name: "WORKSPACE_AUTHOR", ScopeType: iam.DefaultRoleScopeTypeWORKSPACE, Permissions: []string{"workspace.get", "workspace.dags.update"}},
+	{Name: "WORKSPACE_MEMBER", ScopeType: iam.DefaultRoleScopeTypeWORKSPACE, Permissions: []string{"workspace.get"}},
+	{Name: "WORKSPACE_ACCESSOR", ScopeType: iam.DefaultRoleScopeTypeWORKSPACE, Permissions: []string{"workspace.get"}},
+	{Name: "DEPLOYMENT_ADMIN", ScopeType: iam.DefaultRoleScopeTypeDEPLOYMENT, Permissions: []string{"deployment.get", "deployment.update"}},
+	{Name: "DAG_VIEWER", ScopeType: iam.DefaultRoleScopeTypeDAG, Permissions: []string{"deployment.dags.get"}},
+	{Name: "DAG_AUTHOR", ScopeType: iam.DefaultRoleScopeTypeDAG, Permissions: []string{"deployment.dags.get", "deployment.dags.update"}},
+}
+
+func (s *Server) createCustomRole(org *organization, r *http.Request) (int, any) {
+	var req iam.CreateCustomRoleRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if req.Name == "" || len(req.Permissions) == 0 {
+		return badRequest("name and permissions are required")
+	}
+	if err := org.checkWorkspaceIds(lo.FromPtr(req.RestrictedWorkspaceIds)); err != nil {
+		return badRequest("%s", err)
+	}
+	role := &iam.RoleWithPermission{
+		Id:                     cuid.New(),
+		Name:                   req.Name,
+		Description:            req.Description,
+		Permissions:            req.Permissions,
+		RestrictedWorkspaceIds: lo.FromPtr(req.RestrictedWorkspaceIds),
+		ScopeType:              iam.RoleWithPermissionScopeType(req.ScopeType),
+		CreatedAt:              now(),
+		UpdatedAt:              now(),
+		CreatedBy:              org.iamSubject(),
+		UpdatedBy:              org.iamSubject(),
+	}
+	if role.RestrictedWorkspaceIds == nil {
+		role.RestrictedWorkspaceIds = []string{}
+	}
+	org.roles.put(role.Id, role)
+	return http.StatusOK, role
+}
+
+func (s *Server) getCustomRole(org *organization, r *http.Request) (int, any) {
+	role, ok := org.roles.get(r.PathValue("roleId"))
+	if !ok {
+		return notFound("role", r.PathValue("roleId"))
+	}
+	return http.StatusOK, role
+}
+
+func (s *Server) updateCustomRole(org *organization, r *http.Request) (int, any) {
+	role, ok := org.roles.get(r.PathValue("roleId"))
+	if !ok {
+		return notFound("role", r.PathValue("roleId"))
+	}
+	var req iam.UpdateCustomRoleRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if req.Name == "" || len(req.Permissions) == 0 {
+		return badRequest("name and permissions are required")
+	}
+	if err := org.checkWorkspaceIds(lo.FromPtr(req.RestrictedWorkspaceIds)); err != nil {
+		return badRequest("%s", err)
+	}
+	role.Name = req.Name
+	role.Description = req.Description
+	role.Permissions = req.Permissions
+	role.RestrictedWorkspaceIds = lo.FromPtr(req.RestrictedWorkspaceIds)
+	if role.RestrictedWorkspaceIds == nil {
+		role.RestrictedWorkspaceIds = []string{}
+	}
+	role.UpdatedAt = now()
+	role.UpdatedBy = org.iamSubject()
+	return http.StatusOK, role
+}
+
+func (s *Server) deleteCustomRole(org *organization, r *http.Request) (int, any) {
+	if !org.roles.remove(r.PathValue("roleId")) {
+		return notFound("role", r.PathValue("roleId"))
+	}
+	return noContent()
+}
+
+func (o *organization) checkWorkspaceIds(workspaceIds []string) error {
+	for _, workspaceId := range workspaceIds {
+		if _, ok := o.workspaces.get(workspaceId); !ok {
+			return fmt.Errorf("workspace with id %s not found", workspaceId)
+		}
+	}
+	return nil
+}
+
+func (s *Server) listAllowedIpAddressRanges(org *organization, r *http.Request) (int, any) {
+	ranges := org.allowedIpRanges.list()
+	items, offset, limit := page(r, ranges)
+	return http.StatusOK, iam.AllowedIpAddressRangesPaginated{
+		AllowedIpAddressRanges: derefAll(items),
+		Offset:                 offset,
+		Limit:                  limit,
+		TotalCount:             len(ranges),
+	}
+}
@@ -0,0 +1,165 @@
+package fakeapi
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/labs"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/lucsky/cuid"
+	"github.com/samber/lo"
+)
+
+// maxBulkItems is the maximum number of alerts in a labs bulk alerts request
+const maxBulkItems = 30
+
+// maxBulkRanges is the maximum number of allowed IP address ranges in a labs bulk request
+const maxBulkRanges = 1000
+
+func (s *Server) registerLabsRoutes() {
+	s.handle("GET "+labsPrefix+"/alerts", s.labsListAlerts)
+	s.handle("POST "+labsPrefix+"/create-alerts", s.labsCreateAlerts)
+	s.handle("POST "+labsPrefix+"/update-alerts", s.labsUpdateAlerts)
+	s.handle("POST "+labsPrefix+"/delete-alerts", s.labsDeleteAlerts)
+
+	s.handle("POST "+labsPrefix+"/allowed-ip-address-ranges", s.labsCreateAllowedIpAddressRanges)
+	s.handle("DELETE "+labsPrefix+"/allowed-ip-address-ranges", s.labsDeleteAllowedIpAddressRanges)
+}
+
+func labsAlerts(alerts []*platform.Alert) labs.AlertsList {
+	return labs.AlertsList{Alerts: lo.Map(alerts, func(a *platform.Alert, _ int) labs.Alert {
+		return convert[labs.Alert](a)
+	})}
+}
+
+func (s *Server) labsListAlerts(org *organization, r *http.Request) (int, any) {
+	items, _, _ := page(r, s.filterAlerts(org, r))
+	return http.StatusOK, labsAlerts(items)
+}
+
+// labsCreateAlerts creates all the alerts in the request or none of them
+func (s *Server) labsCreateAlerts(org *organization, r *http.Request) (int, any) {
+	var req struct {
+		Alerts []alertRequest `json:"alerts"`
+	}
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if len(req.Alerts) == 0 || len(req.Alerts) > maxBulkItems {
+		return badRequest("between 1 and %d alerts must be created per request", maxBulkItems)
+	}
+	alerts := make([]*platform.Alert, 0, len(req.Alerts))
+	for i, alertReq := range req.Alerts {
+		alert, err := newAlert(org, alertReq)
+		if err != nil {
+			return badRequest("alerts[%d]: %s", i, err)
+		}
+		alerts = append(alerts, alert)
+	}
+	for _, alert := range alerts {
+		org.alerts.put(alert.Id, alert)
+	}
+	return http.StatusOK, labsAlerts(alerts)
+}
+
+// labsUpdateAlerts updates all the alerts in the request or none of them
+func (s *Server) labsUpdateAlerts(org *organization, r *http.Request) (int, any) {
+	var req struct {
+		Alerts []alertRequest `json:"alerts"`
+	}
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if len(req.Alerts) == 0 || len(req.Alerts) > maxBulkItems {
+		return badRequest("between 1 and %d alerts must be updated per request", maxBulkItems)
+	}
+	updated := make([]*platform.Alert, 0, len(req.Alerts))
+	for i, alertReq := range req.Alerts {
+		existing, ok := org.alerts.get(lo.FromPtr(alertReq.Id))
+		if !ok {
+			return notFound("alert", lo.FromPtr(alertReq.Id))
+		}
+		alert := convert[platform.Alert](existing)
+		if err := updateAlert(org, &alert, alertReq); err != nil {
+			return badRequest("alerts[%d]: %s", i, err)
+		}
+		updated = append(updated, &alert)
+	}
+	for _, alert := range updated {
+		org.alerts.put(alert.Id, alert)
+	}
+	return http.StatusOK, labsAlerts(updated)
+}
+
+func (s *Server) labsDeleteAlerts(org *organization, r *http.Request) (int, any) {
+	var req labs.DeleteAlertsRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if len(req.AlertIds) > maxBulkItems {
+		return badRequest("at most %d alerts can be deleted per request", maxBulkItems)
+	}
+	for _, alertId := range req.AlertIds {
+		if _, ok := org.alerts.get(alertId); !ok {
+			return notFound("alert", alertId)
+		}
+	}
+	for _, alertId := range req.AlertIds {
+		org.alerts.remove(alertId)
+	}
+	return noContent()
+}
+
+func (s *Server) labsCreateAllowedIpAddressRanges(org *organization, r *http.Request) (int, any) {
+	var req labs.BulkCreateAllowedIpAddressRangesRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if len(req.AllowedIpAddressRanges) == 0 || len(req.AllowedIpAddressRanges) > maxBulkRanges {
+		return badRequest("between 1 and %d ranges must be created per request", maxBulkRanges)
+	}
+	existing := lo.Map(org.allowedIpRanges.list(), func(r *iam.AllowedIpAddressRange, _ int) string { return r.IpAddressRange })
+	for _, cidr := range req.AllowedIpAddressRanges {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return badRequest("invalid CIDR %s", cidr)
+		}
+		if lo.Contains(existing, cidr) {
+			return apiError(http.StatusConflict, "allowed IP address range %s already exists", cidr)
+		}
+	}
+	created := make([]labs.AllowedIpAddressRange, 0, len(req.AllowedIpAddressRanges))
+	for _, cidr := range req.AllowedIpAddressRanges {
+		ipRange := &iam.AllowedIpAddressRange{
+			Id:             cuid.New(),
+			IpAddressRange: cidr,
+			OrganizationId: org.Id,
+			CreatedAt:      now().Format(timeFormat),
+			UpdatedAt:      now().Format(timeFormat),
+			CreatedBy:      lo.ToPtr(org.iamSubject()),
+			UpdatedBy:      lo.ToPtr(org.iamSubject()),
+		}
+		org.allowedIpRanges.put(ipRange.Id, ipRange)
+		created = append(created, convert[labs.AllowedIpAddressRange](ipRange))
+	}
+	return http.StatusOK, labs.AllowedIpAddressRangesList{AllowedIpAddressRanges: created}
+}
+
+func (s *Server) labsDeleteAllowedIpAddressRanges(org *organization, r *http.Request) (int, any) {
+	var req labs.BulkDeleteAllowedIpAddressRangesRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if len(req.AllowedIpAddressRangeIds) > maxBulkRanges {
+		return badRequest("at most %d ranges can be deleted per request", maxBulkRanges)
+	}
+	for _, rangeId := range req.AllowedIpAddressRangeIds {
+		if _, ok := org.allowedIpRanges.get(rangeId); !ok {
+			return notFound("allowed IP address range", rangeId)
+		}
+	}
+	for _, rangeId := range req.AllowedIpAddressRangeIds {
+		org.allowedIpRanges.remove(rangeId)
+	}
+	return noContent()
+}
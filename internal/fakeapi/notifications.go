@@ -0,0 +1,407 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/lucsky/cuid"
+	"github.com/samber/lo"
+)
+
+// notificationChannelRequest holds the fields of every variant of the create and update notification channel requests
+type notificationChannelRequest struct {
+	Definition map[string]any `json:"definition"`
+	EntityId   string         `json:"entityId"`
+	EntityType string         `json:"entityType"`
+	IsShared   *bool          `json:"isShared"`
+	Name       *string        `json:"name"`
+	Type       *string        `json:"type"`
+}
+
+// alertRequest holds the fields of every variant of the create and update alert requests
+type alertRequest struct {
+	Id                     *string            `json:"id"`
+	EntityId               string             `json:"entityId"`
+	EntityType             string             `json:"entityType"`
+	Name                   *string            `json:"name"`
+	NotificationChannelIds *[]string          `json:"notificationChannelIds"`
+	Rules                  *alertRequestRules `json:"rules"`
+	Severity               *string            `json:"severity"`
+	Type                   *string            `json:"type"`
+}
+
+type alertRequestRules struct {
+	PatternMatches *[]platform.PatternMatch `json:"patternMatches"`
+	Properties     map[string]any           `json:"properties"`
+}
+
+// requiredDefinitionFields maps a notification channel type to the fields its definition requires
+var requiredDefinitionFields = map[string][]string{
+	"EMAIL":       {"recipients"},
+	"SLACK":       {"webhookUrl"},
+	"PAGERDUTY":   {"integrationKey"},
+	"OPSGENIE":    {"apiKey"},
+	"DAG_TRIGGER": {"dagId", "deploymentApiToken", "deploymentId"},
+}
+
+// requiredAlertProperties maps an alert type to the rule properties it requires
+var requiredAlertProperties = map[string][]string{
+	"DAG_FAILURE":    {"deploymentId"},
+	"DAG_SUCCESS":    {"deploymentId"},
+	"DAG_DURATION":   {"deploymentId", "dagDurationSeconds"},
+	"DAG_TIMELINESS": {"deploymentId", "dagDeadline", "daysOfWeek", "lookBackPeriodSeconds"},
+	"TASK_FAILURE":   {"deploymentId"},
+	"TASK_DURATION":  {"deploymentId", "taskDurationSeconds"},
+}
+
+var daysOfWeek = []any{"MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"}
+
+var (
+	patternMatchEntityTypes   = []string{"TASK_ID", "DAG_ID"}
+	patternMatchOperatorTypes = []string{"IS", "IS_NOT", "INCLUDES", "EXCLUDES"}
+)
+
+// checkFields returns an error worded like the Astro API validation errors if a required field of values is missing,
+// empty, zero, or an empty list
+func checkFields(name string, values map[string]any, required []string) error {
+	var missing []string
+	for _, field := range required {
+		switch value := values[field].(type) {
+		case nil:
+			missing = append(missing, field)
+		case string:
+			if value == "" {
+				missing = append(missing, field)
+			}
+		case float64:
+			if value == 0 {
+				missing = append(missing, field)
+			}
+		case []any:
+			if len(value) == 0 {
+				return fmt.Errorf("Invalid request: %s.%s should be min: 1", name, field)
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("Invalid request: Missing fields: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// checkPatternMatches returns an error worded like the Astro API validation errors if a pattern match is invalid
+func checkPatternMatches(patternMatches []platform.PatternMatch) error {
+	for i, match := range patternMatches {
+		if !lo.Contains(patternMatchEntityTypes, string(match.EntityType)) {
+			return fmt.Errorf("Invalid request: rules.patternMatches[%d].entityType should be one of %s", i, strings.Join(patternMatchEntityTypes, " "))
+		}
+		if !lo.Contains(patternMatchOperatorTypes, string(match.OperatorType)) {
+			return fmt.Errorf("Invalid request: rules.patternMatches[%d].operatorType should be one of %s", i, strings.Join(patternMatchOperatorTypes, " "))
+		}
+		for j, value := range match.Values {
+			if value == "" {
+				return fmt.Errorf("Invalid request: rules.patternMatches[%d].values[%d] should be min: 1", i, j)
+			}
+		}
+	}
+	return nil
+}
+
+// checkTimelinessProperties returns an error worded like the Astro API validation errors if the deadline or days of
+// week of a DAG timeliness alert are invalid
+func checkTimelinessProperties(properties map[string]any) error {
+	deadline, _ := properties["dagDeadline"].(string)
+	if _, err := time.Parse("15:04", deadline); err != nil {
+		return fmt.Errorf("Invalid request: rules.properties.dagDeadline %q has an invalid time format, expected HH:MM", deadline)
+	}
+	days, _ := properties["daysOfWeek"].([]any)
+	for i, day := range days {
+		if !lo.Contains(daysOfWeek, day) {
+			return fmt.Errorf("Invalid request: rules.properties.daysOfWeek[%d] %v is an invalid day", i, day)
+		}
+	}
+	return nil
+}
+
+// entity resolves the name, workspace and deployment of the organization, workspace or deployment a notification
+// channel or alert belongs to
+func (o *organization) entity(entityType, entityId string) (name string, workspaceId, deploymentId *string, err error) {
+	switch entityType {
+	case "ORGANIZATION":
+		if entityId != o.Id {
+			return "", nil, nil, fmt.Errorf("organization with id %s not found", entityId)
+		}
+		return o.Name, nil, nil, nil
+	case "WORKSPACE":
+		workspace, ok := o.workspaces.get(entityId)
+		if !ok {
+			return "", nil, nil, fmt.Errorf("workspace with id %s not found", entityId)
+		}
+		return workspace.Name, &workspace.Id, nil, nil
+	case "DEPLOYMENT":
+		deployment, ok := o.deployments.get(entityId)
+		if !ok {
+			return "", nil, nil, fmt.Errorf("deployment with id %s not found", entityId)
+		}
+		return deployment.Name, &deployment.WorkspaceId, &deployment.Id, nil
+	}
+	return "", nil, nil, fmt.Errorf("Invalid request: entityType should be one of ORGANIZATION WORKSPACE DEPLOYMENT, got %s", entityType)
+}
+
+func (s *Server) listNotificationChannels(org *organization, r *http.Request) (int, any) {
+	channelIds, deploymentIds, workspaceIds := queryValues(r, "notificationChannelIds"), queryValues(r, "deploymentIds"), queryValues(r, "workspaceIds")
+	channelTypes, entityType := queryValues(r, "channelTypes"), r.URL.Query().Get("entityType")
+	channels := filter(org.notificationChannels.list(), func(c *platform.NotificationChannel) bool {
+		return matchesAny(channelIds, c.Id) &&
+			matchesAny(deploymentIds, lo.FromPtr(c.DeploymentId)) &&
+			matchesAny(workspaceIds, lo.FromPtr(c.WorkspaceId)) &&
+			matchesAny(channelTypes, c.Type) &&
+			(entityType == "" || entityType == c.EntityType)
+	})
+	items, offset, limit := page(r, channels)
+	return http.StatusOK, platform.NotificationChannelsPaginated{
+		NotificationChannels: derefAll(items),
+		Offset:               offset,
+		Limit:                limit,
+		TotalCount:           len(channels),
+	}
+}
+
+func (s *Server) createNotificationChannel(org *organization, r *http.Request) (int, any) {
+	var req notificationChannelRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if lo.FromPtr(req.Name) == "" || lo.FromPtr(req.Type) == "" || req.Definition == nil {
+		return badRequest("name, type and definition are required")
+	}
+	if err := checkFields("definition", req.Definition, requiredDefinitionFields[*req.Type]); err != nil {
+		return badRequest("%s", err)
+	}
+	entityName, workspaceId, deploymentId, err := org.entity(req.EntityType, req.EntityId)
+	if err != nil {
+		return badRequest("%s", err)
+	}
+	channel := &platform.NotificationChannel{
+		Id:             cuid.New(),
+		Name:           *req.Name,
+		Type:           *req.Type,
+		Definition:     req.Definition,
+		OrganizationId: org.Id,
+		EntityId:       req.EntityId,
+		EntityType:     req.EntityType,
+		EntityName:     &entityName,
+		WorkspaceId:    workspaceId,
+		DeploymentId:   deploymentId,
+		IsShared:       lo.FromPtr(req.IsShared),
+		CreatedAt:      now(),
+		UpdatedAt:      now(),
+		CreatedBy:      org.platformSubject(),
+		UpdatedBy:      org.platformSubject(),
+	}
+	org.notificationChannels.put(channel.Id, channel)
+	return http.StatusOK, channel
+}
+
+func (s *Server) getNotificationChannel(org *organization, r *http.Request) (int, any) {
+	channel, ok := org.notificationChannels.get(r.PathValue("notificationChannelId"))
+	if !ok {
+		return notFound("notification channel", r.PathValue("notificationChannelId"))
+	}
+	return http.StatusOK, channel
+}
+
+func (s *Server) updateNotificationChannel(org *organization, r *http.Request) (int, any) {
+	channel, ok := org.notificationChannels.get(r.PathValue("notificationChannelId"))
+	if !ok {
+		return notFound("notification channel", r.PathValue("notificationChannelId"))
+	}
+	var req notificationChannelRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if req.Type != nil && *req.Type != channel.Type {
+		return badRequest("notification channel type cannot be changed from %s to %s", channel.Type, *req.Type)
+	}
+	if req.Name != nil {
+		channel.Name = *req.Name
+	}
+	if req.Definition != nil {
+		if err := checkFields("definition", req.Definition, requiredDefinitionFields[channel.Type]); err != nil {
+			return badRequest("%s", err)
+		}
+		channel.Definition = req.Definition
+	}
+	if req.IsShared != nil {
+		channel.IsShared = *req.IsShared
+	}
+	channel.UpdatedAt = now()
+	channel.UpdatedBy = org.platformSubject()
+	return http.StatusOK, channel
+}
+
+func (s *Server) deleteNotificationChannel(org *organization, r *http.Request) (int, any) {
+	channelId := r.PathValue("notificationChannelId")
+	if !org.notificationChannels.remove(channelId) {
+		return notFound("notification channel", channelId)
+	}
+	for _, alert := range org.alerts.list() {
+		if alert.NotificationChannels != nil {
+			alert.NotificationChannels = lo.ToPtr(lo.Filter(*alert.NotificationChannels, func(c platform.AlertNotificationChannel, _ int) bool {
+				return c.Id != channelId
+			}))
+		}
+	}
+	return noContent()
+}
+
+func (s *Server) listAlerts(org *organization, r *http.Request) (int, any) {
+	alerts := s.filterAlerts(org, r)
+	items, offset, limit := page(r, alerts)
+	return http.StatusOK, platform.AlertsPaginated{
+		Alerts:     derefAll(items),
+		Offset:     offset,
+		Limit:      limit,
+		TotalCount: len(alerts),
+	}
+}
+
+// filterAlerts returns the alerts matching the filters of the platform and labs list alerts endpoints
+func (s *Server) filterAlerts(org *organization, r *http.Request) []*platform.Alert {
+	alertIds, deploymentIds, workspaceIds := queryValues(r, "alertIds"), queryValues(r, "deploymentIds"), queryValues(r, "workspaceIds")
+	alertTypes, entityType := queryValues(r, "alertTypes"), r.URL.Query().Get("entityType")
+	return filter(org.alerts.list(), func(a *platform.Alert) bool {
+		return matchesAny(alertIds, a.Id) &&
+			matchesAny(deploymentIds, lo.FromPtr(a.DeploymentId)) &&
+			matchesAny(workspaceIds, lo.FromPtr(a.WorkspaceId)) &&
+			matchesAny(alertTypes, string(a.Type)) &&
+			(entityType == "" || entityType == string(a.EntityType))
+	})
+}
+
+func (s *Server) createAlert(org *organization, r *http.Request) (int, any) {
+	var req alertRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	alert, err := newAlert(org, req)
+	if err != nil {
+		return badRequest("%s", err)
+	}
+	org.alerts.put(alert.Id, alert)
+	return http.StatusOK, alert
+}
+
+func (s *Server) getAlert(org *organization, r *http.Request) (int, any) {
+	alert, ok := org.alerts.get(r.PathValue("alertId"))
+	if !ok {
+		return notFound("alert", r.PathValue("alertId"))
+	}
+	return http.StatusOK, alert
+}
+
+func (s *Server) updateAlert(org *organization, r *http.Request) (int, any) {
+	alert, ok := org.alerts.get(r.PathValue("alertId"))
+	if !ok {
+		return notFound("alert", r.PathValue("alertId"))
+	}
+	var req alertRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if err := updateAlert(org, alert, req); err != nil {
+		return badRequest("%s", err)
+	}
+	return http.StatusOK, alert
+}
+
+func (s *Server) deleteAlert(org *organization, r *http.Request) (int, any) {
+	if !org.alerts.remove(r.PathValue("alertId")) {
+		return notFound("alert", r.PathValue("alertId"))
+	}
+	return noContent()
+}
+
+// newAlert validates a create alert request and returns the alert it creates
+func newAlert(org *organization, req alertRequest) (*platform.Alert, error) {
+	if lo.FromPtr(req.Name) == "" || lo.FromPtr(req.Type) == "" || lo.FromPtr(req.Severity) == "" || req.Rules == nil {
+		return nil, fmt.Errorf("name, type, severity and rules are required")
+	}
+	entityName, workspaceId, deploymentId, err := org.entity(req.EntityType, req.EntityId)
+	if err != nil {
+		return nil, err
+	}
+	alert := &platform.Alert{
+		Id:             cuid.New(),
+		OrganizationId: org.Id,
+		EntityId:       req.EntityId,
+		EntityType:     platform.AlertEntityType(req.EntityType),
+		EntityName:     &entityName,
+		WorkspaceId:    workspaceId,
+		DeploymentId:   deploymentId,
+		Type:           platform.AlertType(*req.Type),
+		CreatedAt:      now(),
+		CreatedBy:      org.platformSubject(),
+	}
+	if err := updateAlert(org, alert, req); err != nil {
+		return nil, err
+	}
+	return alert, nil
+}
+
+// updateAlert applies the fields set in an alert request to the alert
+func updateAlert(org *organization, alert *platform.Alert, req alertRequest) error {
+	if req.Type != nil && *req.Type != string(alert.Type) {
+		return fmt.Errorf("alert type cannot be changed from %s to %s", alert.Type, *req.Type)
+	}
+	if req.Name != nil {
+		alert.Name = *req.Name
+	}
+	if req.Severity != nil {
+		alert.Severity = platform.AlertSeverity(*req.Severity)
+	}
+	if req.Rules != nil {
+		// the properties of an update request are merged into the existing ones, e.g. deploymentId is only sent on
+		// create
+		existing, _ := alert.Rules.Properties.(map[string]any)
+		properties := lo.Assign(existing, req.Rules.Properties)
+		if err := checkFields("rules.properties", properties, requiredAlertProperties[string(alert.Type)]); err != nil {
+			return err
+		}
+		if alert.Type == platform.AlertTypeDAGTIMELINESS {
+			if err := checkTimelinessProperties(properties); err != nil {
+				return err
+			}
+		}
+		alert.Rules.Properties = properties
+		if req.Rules.PatternMatches != nil {
+			if err := checkPatternMatches(*req.Rules.PatternMatches); err != nil {
+				return err
+			}
+			alert.Rules.PatternMatches = req.Rules.PatternMatches
+		}
+		if deploymentId, ok := properties["deploymentId"].(string); ok {
+			if _, ok := org.deployments.get(deploymentId); !ok {
+				return fmt.Errorf("deployment with id %s not found", deploymentId)
+			}
+			alert.DeploymentId = &deploymentId
+		}
+	}
+	if req.NotificationChannelIds != nil {
+		channels := make([]platform.AlertNotificationChannel, 0, len(*req.NotificationChannelIds))
+		for _, channelId := range *req.NotificationChannelIds {
+			channel, ok := org.notificationChannels.get(channelId)
+			if !ok {
+				return fmt.Errorf("notification channel with id %s not found", channelId)
+			}
+			channels = append(channels, convert[platform.AlertNotificationChannel](channel))
+		}
+		alert.NotificationChannels = &channels
+	}
+	alert.UpdatedAt = now()
+	alert.UpdatedBy = org.platformSubject()
+	return nil
+}
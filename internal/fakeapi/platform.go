@@ -0,0 +1,452 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/lucsky/cuid"
+	"github.com/samber/lo"
+)
+
+func (s *Server) registerPlatformRoutes() {
+	s.handle("GET "+platformPrefix, s.getOrganization)
+
+	s.handle("GET "+platformPrefix+"/workspaces", s.listWorkspaces)
+	s.handle("POST "+platformPrefix+"/workspaces", s.createWorkspace)
+	s.handle("GET "+platformPrefix+"/workspaces/{workspaceId}", s.getWorkspace)
+	s.handle("POST "+platformPrefix+"/workspaces/{workspaceId}", s.updateWorkspace)
+	s.handle("DELETE "+platformPrefix+"/workspaces/{workspaceId}", s.deleteWorkspace)
+
+	s.handle("GET "+platformPrefix+"/cluster-options", s.getClusterOptions)
+	s.handle("GET "+platformPrefix+"/clusters", s.listClusters)
+	s.handle("POST "+platformPrefix+"/clusters", s.createCluster)
+	s.handle("GET "+platformPrefix+"/clusters/{clusterId}", s.getCluster)
+	s.handle("POST "+platformPrefix+"/clusters/{clusterId}", s.updateCluster)
+	s.handle("DELETE "+platformPrefix+"/clusters/{clusterId}", s.deleteCluster)
+
+	s.handle("GET "+platformPrefix+"/deployment-options", s.getDeploymentOptions)
+	s.handle("GET "+platformPrefix+"/deployments", s.listDeployments)
+	s.handle("POST "+platformPrefix+"/deployments", s.createDeployment)
+	s.handle("GET "+platformPrefix+"/deployments/{deploymentId}", s.getDeployment)
+	s.handle("POST "+platformPrefix+"/deployments/{deploymentId}", s.updateDeployment)
+	s.handle("DELETE "+platformPrefix+"/deployments/{deploymentId}", s.deleteDeployment)
+	s.handle("POST "+platformPrefix+"/deployments/{deploymentId}/hibernation-override", s.updateDeploymentHibernationOverride)
+	s.handle("DELETE "+platformPrefix+"/deployments/{deploymentId}/hibernation-override", s.deleteDeploymentHibernationOverride)
+
+	s.handle("GET "+platformPrefix+"/notification-channels", s.listNotificationChannels)
+	s.handle("POST "+platformPrefix+"/notification-channels", s.createNotificationChannel)
+	s.handle("GET "+platformPrefix+"/notification-channels/{notificationChannelId}", s.getNotificationChannel)
+	s.handle("POST "+platformPrefix+"/notification-channels/{notificationChannelId}", s.updateNotificationChannel)
+	s.handle("DELETE "+platformPrefix+"/notification-channels/{notificationChannelId}", s.deleteNotificationChannel)
+
+	s.handle("GET "+platformPrefix+"/alerts", s.listAlerts)
+	s.handle("POST "+platformPrefix+"/alerts", s.createAlert)
+	s.handle("GET "+platformPrefix+"/alerts/{alertId}", s.getAlert)
+	s.handle("POST "+platformPrefix+"/alerts/{alertId}", s.updateAlert)
+	s.handle("DELETE "+platformPrefix+"/alerts/{alertId}", s.deleteAlert)
+}
+
+func (s *Server) getOrganization(org *organization, r *http.Request) (int, any) {
+	return http.StatusOK, org.Organization
+}
+
+func (s *Server) listWorkspaces(org *organization, r *http.Request) (int, any) {
+	workspaceIds, names := queryValues(r, "workspaceIds"), queryValues(r, "names")
+	workspaces := filter(org.workspaces.list(), func(w *platform.Workspace) bool {
+		return matchesAny(workspaceIds, w.Id) && matchesAny(names, w.Name)
+	})
+	items, offset, limit := page(r, workspaces)
+	return http.StatusOK, platform.WorkspacesPaginated{
+		Workspaces: derefAll(items),
+		Offset:     offset,
+		Limit:      limit,
+		TotalCount: len(workspaces),
+	}
+}
+
+func (s *Server) createWorkspace(org *organization, r *http.Request) (int, any) {
+	var req platform.CreateWorkspaceRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if req.Name == "" {
+		return badRequest("name is required")
+	}
+	workspace := &platform.Workspace{
+		Id:                  cuid.New(),
+		Name:                req.Name,
+		Description:         req.Description,
+		CicdEnforcedDefault: lo.FromPtr(req.CicdEnforcedDefault),
+		OrganizationId:      org.Id,
+		OrganizationName:    &org.Name,
+		CreatedAt:           now(),
+		UpdatedAt:           now(),
+		CreatedBy:           lo.ToPtr(org.platformSubject()),
+		UpdatedBy:           lo.ToPtr(org.platformSubject()),
+	}
+	org.workspaces.put(workspace.Id, workspace)
+	return http.StatusOK, workspace
+}
+
+func (s *Server) getWorkspace(org *organization, r *http.Request) (int, any) {
+	workspace, ok := org.workspaces.get(r.PathValue("workspaceId"))
+	if !ok {
+		return notFound("workspace", r.PathValue("workspaceId"))
+	}
+	return http.StatusOK, workspace
+}
+
+func (s *Server) updateWorkspace(org *organization, r *http.Request) (int, any) {
+	workspace, ok := org.workspaces.get(r.PathValue("workspaceId"))
+	if !ok {
+		return notFound("workspace", r.PathValue("workspaceId"))
+	}
+	var req platform.UpdateWorkspaceRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if req.Name == "" {
+		return badRequest("name is required")
+	}
+	workspace.Name = req.Name
+	workspace.Description = &req.Description
+	workspace.CicdEnforcedDefault = req.CicdEnforcedDefault
+	workspace.UpdatedAt = now()
+	workspace.UpdatedBy = lo.ToPtr(org.platformSubject())
+	return http.StatusOK, workspace
+}
+
+func (s *Server) deleteWorkspace(org *organization, r *http.Request) (int, any) {
+	workspaceId := r.PathValue("workspaceId")
+	if _, ok := org.workspaces.get(workspaceId); !ok {
+		return notFound("workspace", workspaceId)
+	}
+	for _, deployment := range org.deployments.list() {
+		if deployment.WorkspaceId == workspaceId {
+			return badRequest("workspace %s still has deployments, delete them first", workspaceId)
+		}
+	}
+	org.workspaces.remove(workspaceId)
+	for _, cluster := range org.clusters.list() {
+		if cluster.WorkspaceIds != nil {
+			cluster.WorkspaceIds = lo.ToPtr(lo.Without(*cluster.WorkspaceIds, workspaceId))
+		}
+	}
+	return noContent()
+}
+
+// clusterRequest holds the fields of every variant of the create and update cluster requests
+type clusterRequest struct {
+	CloudProvider                string                            `json:"cloudProvider"`
+	ClusterType                  string                            `json:"clusterType"`
+	DbInstanceType               *string                           `json:"dbInstanceType"`
+	DrPodSubnetRange             *string                           `json:"drPodSubnetRange"`
+	DrRegion                     *string                           `json:"drRegion"`
+	DrSecondaryVpcCidr           *string                           `json:"drSecondaryVpcCidr"`
+	DrServicePeeringRange        *string                           `json:"drServicePeeringRange"`
+	DrServiceSubnetRange         *string                           `json:"drServiceSubnetRange"`
+	DrVpcSubnetRange             *string                           `json:"drVpcSubnetRange"`
+	EnableDr                     *bool                             `json:"enableDr"`
+	EnableReplicationTimeControl *bool                             `json:"enableReplicationTimeControl"`
+	IsFailedOver                 *bool                             `json:"isFailedOver"`
+	K8sTags                      *[]platform.ClusterK8sTag         `json:"k8sTags"`
+	Name                         string                            `json:"name"`
+	NodePools                    *[]platform.UpdateNodePoolRequest `json:"nodePools"`
+	PodSubnetRange               *string                           `json:"podSubnetRange"`
+	ProviderAccount              *string                           `json:"providerAccount"`
+	Region                       string                            `json:"region"`
+	SecondaryVpcCidr             *string                           `json:"secondaryVpcCidr"`
+	ServicePeeringRange          *string                           `json:"servicePeeringRange"`
+	ServiceSubnetRange           *string                           `json:"serviceSubnetRange"`
+	Type                         string                            `json:"type"`
+	VpcSubnetRange               string                            `json:"vpcSubnetRange"`
+	WorkspaceIds                 *[]string                         `json:"workspaceIds"`
+}
+
+func (s *Server) getClusterOptions(org *organization, r *http.Request) (int, any) {
+	providers := []string{"AWS", "AZURE", "GCP"}
+	if provider := r.URL.Query().Get("provider"); provider != "" {
+		providers = []string{provider}
+	}
+	options := make([]platform.ClusterOptions, 0, len(providers))
+	for _, provider := range providers {
+		options = append(options, clusterOptions(provider))
+	}
+	return http.StatusOK, options
+}
+
+func (s *Server) listClusters(org *organization, r *http.Request) (int, any) {
+	names, providers := queryValues(r, "names"), queryValues(r, "provider")
+	clusters := filter(org.clusters.list(), func(c *platform.Cluster) bool {
+		return matchesAny(names, c.Name) && matchesAny(providers, string(c.CloudProvider))
+	})
+	for _, cluster := range clusters {
+		s.advanceCluster(org, cluster)
+	}
+	items, offset, limit := page(r, clusters)
+	return http.StatusOK, platform.ClustersPaginated{
+		Clusters:   derefAll(items),
+		Offset:     offset,
+		Limit:      limit,
+		TotalCount: len(clusters),
+	}
+}
+
+func (s *Server) createCluster(org *organization, r *http.Request) (int, any) {
+	var req clusterRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if req.Name == "" || req.Region == "" || req.CloudProvider == "" {
+		return badRequest("name, region and cloudProvider are required")
+	}
+	if req.Type != string(platform.ClusterTypeDEDICATED) {
+		return badRequest("only DEDICATED clusters can be created, got %s", req.Type)
+	}
+	if req.WorkspaceIds != nil {
+		for _, workspaceId := range *req.WorkspaceIds {
+			if _, ok := org.workspaces.get(workspaceId); !ok {
+				return badRequest("workspace with id %s not found", workspaceId)
+			}
+		}
+	}
+	options := clusterOptions(req.CloudProvider)
+	cluster := &platform.Cluster{
+		Id:                  cuid.New(),
+		Name:                req.Name,
+		OrganizationId:      org.Id,
+		CloudProvider:       platform.ClusterCloudProvider(req.CloudProvider),
+		Region:              req.Region,
+		Type:                platform.ClusterTypeDEDICATED,
+		Status:              platform.ClusterStatusCREATING,
+		DbInstanceType:      lo.FromPtrOr(req.DbInstanceType, options.DefaultDatabaseInstance.Name),
+		VpcSubnetRange:      req.VpcSubnetRange,
+		PodSubnetRange:      req.PodSubnetRange,
+		ServicePeeringRange: req.ServicePeeringRange,
+		ServiceSubnetRange:  req.ServiceSubnetRange,
+		SecondaryVpcCidr:    req.SecondaryVpcCidr,
+		ProviderAccount:     req.ProviderAccount,
+		WorkspaceIds:        lo.ToPtr(lo.FromPtr(req.WorkspaceIds)),
+		IsLimited:           lo.ToPtr(false),
+		Metadata: &platform.ClusterMetadata{
+			ExternalIPs:   &[]string{"203.0.113.10"},
+			KubeDnsIp:     lo.ToPtr("10.0.0.10"),
+			OidcIssuerUrl: lo.ToPtr(fmt.Sprintf("https://oidc.astronomer.io/%s", strings.ToLower(cuid.New()))),
+		},
+		CreatedAt: now(),
+		UpdatedAt: now(),
+	}
+	if req.K8sTags != nil {
+		cluster.Tags = lo.ToPtr(*req.K8sTags)
+	}
+	if cluster.CloudProvider == platform.ClusterCloudProviderAZURE {
+		cluster.TenantId = lo.ToPtr(cuid.New())
+	}
+	if lo.FromPtr(req.DrRegion) != "" {
+		cluster.IsDrEnabled = true
+		cluster.IsFailedOver = lo.ToPtr(false)
+		cluster.FailoverInProgress = lo.ToPtr(false)
+		applyClusterDrRequest(cluster, req)
+	}
+	nodePools := make([]platform.NodePool, 0)
+	if req.NodePools != nil {
+		nodePools = clusterNodePools(cluster, nil, *req.NodePools)
+	}
+	cluster.NodePools = &nodePools
+
+	org.clusters.put(cluster.Id, cluster)
+	org.startTransition(cluster.Id, string(platform.ClusterStatusCREATED), s.StatusReads, 0)
+	return http.StatusOK, cluster
+}
+
+func (s *Server) getCluster(org *organization, r *http.Request) (int, any) {
+	cluster, ok := org.clusters.get(r.PathValue("clusterId"))
+	if !ok {
+		return notFound("cluster", r.PathValue("clusterId"))
+	}
+	s.advanceCluster(org, cluster)
+	return http.StatusOK, cluster
+}
+
+func (s *Server) updateCluster(org *organization, r *http.Request) (int, any) {
+	cluster, ok := org.clusters.get(r.PathValue("clusterId"))
+	if !ok {
+		return notFound("cluster", r.PathValue("clusterId"))
+	}
+	if _, pending := org.transitions[cluster.Id]; pending {
+		return apiError(http.StatusConflict, "a workflow is already running for cluster %s", cluster.Id)
+	}
+	var req clusterRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if req.WorkspaceIds != nil {
+		for _, workspaceId := range *req.WorkspaceIds {
+			if _, ok := org.workspaces.get(workspaceId); !ok {
+				return badRequest("workspace with id %s not found", workspaceId)
+			}
+		}
+		cluster.WorkspaceIds = lo.ToPtr(*req.WorkspaceIds)
+	}
+	if req.ClusterType == string(platform.ClusterTypeHYBRID) {
+		cluster.UpdatedAt = now()
+		return http.StatusOK, cluster
+	}
+
+	if req.Name != "" {
+		cluster.Name = req.Name
+	}
+	if req.DbInstanceType != nil {
+		cluster.DbInstanceType = *req.DbInstanceType
+	}
+	cluster.Tags = lo.ToPtr(lo.FromPtr(req.K8sTags))
+	if req.NodePools != nil {
+		cluster.NodePools = lo.ToPtr(clusterNodePools(cluster, lo.FromPtr(cluster.NodePools), *req.NodePools))
+	}
+	if req.EnableDr != nil {
+		cluster.IsDrEnabled = *req.EnableDr
+		if cluster.IsDrEnabled {
+			cluster.IsFailedOver = lo.ToPtr(lo.FromPtr(cluster.IsFailedOver))
+			cluster.FailoverInProgress = lo.ToPtr(false)
+		} else {
+			cluster.DrRegion = ""
+			cluster.IsFailedOver = nil
+			cluster.FailoverInProgress = nil
+		}
+	}
+	if cluster.IsDrEnabled {
+		applyClusterDrRequest(cluster, req)
+	}
+
+	finalStatus := platform.ClusterStatusCREATED
+	cluster.Status = platform.ClusterStatusUPDATING
+	if req.IsFailedOver != nil && *req.IsFailedOver != lo.FromPtr(cluster.IsFailedOver) {
+		if !cluster.IsDrEnabled {
+			return badRequest("disaster recovery is not enabled for cluster %s", cluster.Id)
+		}
+		cluster.Status = platform.ClusterStatusFAILINGOVER
+		cluster.FailoverInProgress = lo.ToPtr(true)
+		cluster.IsFailedOver = lo.ToPtr(*req.IsFailedOver)
+	}
+	cluster.UpdatedAt = now()
+	org.startTransition(cluster.Id, string(finalStatus), s.StatusReads, 0)
+	return http.StatusOK, cluster
+}
+
+func (s *Server) deleteCluster(org *organization, r *http.Request) (int, any) {
+	clusterId := r.PathValue("clusterId")
+	if _, ok := org.clusters.get(clusterId); !ok {
+		return notFound("cluster", clusterId)
+	}
+	for _, deployment := range org.deployments.list() {
+		if lo.FromPtr(deployment.ClusterId) == clusterId {
+			return badRequest("cluster %s still has deployments, delete them first", clusterId)
+		}
+	}
+	org.clusters.remove(clusterId)
+	delete(org.transitions, clusterId)
+	return noContent()
+}
+
+// advanceCluster records a read of the cluster and applies its final status once its transition is over
+func (s *Server) advanceCluster(org *organization, cluster *platform.Cluster) {
+	if status, done := org.advance(cluster.Id); done {
+		cluster.Status = platform.ClusterStatus(status)
+		if cluster.FailoverInProgress != nil {
+			cluster.FailoverInProgress = lo.ToPtr(false)
+		}
+	}
+}
+
+func applyClusterDrRequest(cluster *platform.Cluster, req clusterRequest) {
+	if lo.FromPtr(req.DrRegion) != "" {
+		cluster.DrRegion = *req.DrRegion
+	}
+	if req.DrVpcSubnetRange != nil {
+		cluster.DrVpcSubnetRange = req.DrVpcSubnetRange
+	}
+	if req.DrSecondaryVpcCidr != nil {
+		cluster.DrSecondaryVpcCidr = req.DrSecondaryVpcCidr
+	}
+	if req.DrPodSubnetRange != nil {
+		cluster.DrPodSubnetRange = req.DrPodSubnetRange
+	}
+	if req.DrServicePeeringRange != nil {
+		cluster.DrServicePeeringRange = req.DrServicePeeringRange
+	}
+	if req.DrServiceSubnetRange != nil {
+		cluster.DrServiceSubnetRange = req.DrServiceSubnetRange
+	}
+	if req.EnableReplicationTimeControl != nil {
+		cluster.EnableReplicationTimeControl = req.EnableReplicationTimeControl
+	}
+}
+
+// clusterNodePools returns the node pools requested for a cluster, keeping the ID and creation time of the existing
+// node pools matched by ID or name. Like the platform, a node pool requested without isDefault is not the default one
+func clusterNodePools(cluster *platform.Cluster, existing []platform.NodePool, requested []platform.UpdateNodePoolRequest) []platform.NodePool {
+	nodePools := make([]platform.NodePool, 0, len(requested))
+	for _, req := range requested {
+		nodePool := platform.NodePool{
+			Id:               cuid.New(),
+			ClusterId:        cluster.Id,
+			CloudProvider:    platform.NodePoolCloudProvider(cluster.CloudProvider),
+			Name:             req.Name,
+			NodeInstanceType: req.NodeInstanceType,
+			MaxNodeCount:     req.MaxNodeCount,
+			IsDefault:        lo.FromPtr(req.IsDefault),
+			CreatedAt:        now(),
+			UpdatedAt:        now(),
+		}
+		for _, e := range existing {
+			if (req.Id != nil && e.Id == *req.Id) || (req.Id == nil && e.Name == req.Name) {
+				nodePool.Id = e.Id
+				nodePool.CreatedAt = e.CreatedAt
+			}
+		}
+		nodePools = append(nodePools, nodePool)
+	}
+	return nodePools
+}
+
+// clusterOptions returns the cluster options of a cloud provider
+func clusterOptions(provider string) platform.ClusterOptions {
+	regions := map[string][]string{
+		"AWS":   {"us-east-1", "us-west-2", "eu-west-1"},
+		"AZURE": {"eastus2", "westus2", "westeurope"},
+		"GCP":   {"us-central1", "us-east4", "europe-west1"},
+	}[provider]
+	nodeInstance := map[string]platform.ProviderInstanceType{
+		"AWS":   {Name: "m5.xlarge", Cpu: 4, Memory: "16Gi"},
+		"AZURE": {Name: "Standard_D4d_v5", Cpu: 4, Memory: "16Gi"},
+		"GCP":   {Name: "e2-standard-4", Cpu: 4, Memory: "16Gi"},
+	}[provider]
+	databaseInstance := map[string]platform.ProviderInstanceType{
+		"AWS":   {Name: "db.m6g.large", Cpu: 2, Memory: "8Gi"},
+		"AZURE": {Name: "Standard_D2ds_v4", Cpu: 2, Memory: "8Gi"},
+		"GCP":   {Name: "Medium General Purpose", Cpu: 2, Memory: "8Gi"},
+	}[provider]
+	providerRegions := lo.Map(regions, func(region string, _ int) platform.ProviderRegion {
+		return platform.ProviderRegion{Name: region}
+	})
+	return platform.ClusterOptions{
+		Provider:                platform.ClusterOptionsProvider(provider),
+		Regions:                 providerRegions,
+		DefaultRegion:           providerRegions[0],
+		NodeInstances:           []platform.ProviderInstanceType{nodeInstance},
+		DefaultNodeInstance:     nodeInstance,
+		DatabaseInstances:       []platform.ProviderInstanceType{databaseInstance},
+		DefaultDatabaseInstance: databaseInstance,
+		DefaultVpcSubnetRange:   "172.20.0.0/20",
+		NodeCountMin:            1,
+		NodeCountMax:            20,
+		NodeCountDefault:        20,
+	}
+}
+
+// derefAll returns the values of a list of pointers
+func derefAll[T any](items []*T) []T {
+	return lo.Map(items, func(item *T, _ int) T { return *item })
+}
@@ -0,0 +1,388 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+	"github.com/lucsky/cuid"
+	"github.com/samber/lo"
+)
+
+func (s *Server) registerPlatformV1Routes() {
+	s.handle("GET "+platformV1Prefix+"/environment-objects", s.listEnvironmentObjects)
+	s.handle("POST "+platformV1Prefix+"/environment-objects", s.createEnvironmentObject)
+	s.handle("GET "+platformV1Prefix+"/environment-objects/{environmentObjectId}", s.getEnvironmentObject)
+	s.handle("POST "+platformV1Prefix+"/environment-objects/{environmentObjectId}", s.updateEnvironmentObject)
+	s.handle("DELETE "+platformV1Prefix+"/environment-objects/{environmentObjectId}", s.deleteEnvironmentObject)
+	s.handle("POST "+platformV1Prefix+"/environment-objects/{environmentObjectId}/exclude-linking", s.excludeLinkingEnvironmentObject)
+}
+
+func (s *Server) listEnvironmentObjects(org *organization, r *http.Request) (int, any) {
+	query := r.URL.Query()
+	workspaceId, deploymentId := query.Get("workspaceId"), query.Get("deploymentId")
+	objectType, objectKey := query.Get("objectType"), query.Get("objectKey")
+	showSecrets, resolveLinked := query.Get("showSecrets") == "true", query.Get("resolveLinked") == "true"
+
+	var objects []platform_v1.EnvironmentObject
+	for _, object := range org.environmentObjects.list() {
+		if (objectType != "" && string(object.ObjectType) != objectType) || (objectKey != "" && object.ObjectKey != objectKey) {
+			continue
+		}
+		switch {
+		case deploymentId != "" && object.Scope == platform_v1.EnvironmentObjectScopeDEPLOYMENT:
+			if object.ScopeEntityId == deploymentId {
+				objects = append(objects, environmentObjectResponse(object, showSecrets))
+			}
+		case deploymentId != "":
+			if resolveLinked && org.isLinked(object, deploymentId) {
+				resolved := environmentObjectResponse(object, showSecrets)
+				resolved.SourceScope = lo.ToPtr(platform_v1.EnvironmentObjectSourceScopeWORKSPACE)
+				resolved.SourceScopeEntityId = &object.ScopeEntityId
+				resolved.Scope = platform_v1.EnvironmentObjectScopeDEPLOYMENT
+				resolved.ScopeEntityId = deploymentId
+				objects = append(objects, resolved)
+			}
+		case workspaceId != "":
+			if object.Scope == platform_v1.EnvironmentObjectScopeWORKSPACE && object.ScopeEntityId == workspaceId {
+				objects = append(objects, environmentObjectResponse(object, showSecrets))
+			}
+		default:
+			objects = append(objects, environmentObjectResponse(object, showSecrets))
+		}
+	}
+	items, offset, limit := page(r, objects)
+	return http.StatusOK, platform_v1.EnvironmentObjectsPaginated{
+		EnvironmentObjects: items,
+		Offset:             offset,
+		Limit:              limit,
+		TotalCount:         len(objects),
+	}
+}
+
+// isLinked returns true if the workspace environment object applies to the deployment
+func (o *organization) isLinked(object *platform_v1.EnvironmentObject, deploymentId string) bool {
+	deployment, ok := o.deployments.get(deploymentId)
+	if !ok || deployment.WorkspaceId != object.ScopeEntityId {
+		return false
+	}
+	if lo.ContainsBy(lo.FromPtr(object.ExcludeLinks), func(l platform_v1.EnvironmentObjectExcludeLink) bool {
+		return l.ScopeEntityId == deploymentId
+	}) {
+		return false
+	}
+	return lo.FromPtr(object.AutoLinkDeployments) || lo.ContainsBy(lo.FromPtr(object.Links), func(l platform_v1.EnvironmentObjectLink) bool {
+		return l.ScopeEntityId == deploymentId
+	})
+}
+
+func (s *Server) createEnvironmentObject(org *organization, r *http.Request) (int, any) {
+	var req platform_v1.CreateEnvironmentObjectRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if req.ObjectKey == "" {
+		return badRequest("objectKey is required")
+	}
+	switch req.Scope {
+	case platform_v1.CreateEnvironmentObjectRequestScopeWORKSPACE:
+		if _, ok := org.workspaces.get(req.ScopeEntityId); !ok {
+			return badRequest("workspace with id %s not found", req.ScopeEntityId)
+		}
+	case platform_v1.CreateEnvironmentObjectRequestScopeDEPLOYMENT:
+		if _, ok := org.deployments.get(req.ScopeEntityId); !ok {
+			return badRequest("deployment with id %s not found", req.ScopeEntityId)
+		}
+		if len(lo.FromPtr(req.Links)) > 0 || lo.FromPtr(req.AutoLinkDeployments) || len(lo.FromPtr(req.ExcludeLinks)) > 0 {
+			return badRequest("links can only be set on workspace environment objects")
+		}
+	default:
+		return badRequest("invalid scope %s", req.Scope)
+	}
+	for _, existing := range org.environmentObjects.list() {
+		if existing.ObjectKey == req.ObjectKey && string(existing.ObjectType) == string(req.ObjectType) &&
+			string(existing.Scope) == string(req.Scope) && existing.ScopeEntityId == req.ScopeEntityId {
+			return apiError(http.StatusConflict, "%s %s already exists in %s %s", req.ObjectType, req.ObjectKey, req.Scope, req.ScopeEntityId)
+		}
+	}
+
+	object := &platform_v1.EnvironmentObject{
+		Id:                  lo.ToPtr(cuid.New()),
+		ObjectKey:           req.ObjectKey,
+		ObjectType:          platform_v1.EnvironmentObjectObjectType(req.ObjectType),
+		Scope:               platform_v1.EnvironmentObjectScope(req.Scope),
+		ScopeEntityId:       req.ScopeEntityId,
+		Description:         req.Description,
+		AutoLinkDeployments: req.AutoLinkDeployments,
+		CreatedAt:           lo.ToPtr(now().Format(timeFormat)),
+		CreatedBy:           lo.ToPtr(convert[platform_v1.BasicSubjectProfile](org.iamSubject())),
+	}
+	switch object.ObjectType {
+	case platform_v1.EnvironmentObjectObjectTypeAIRFLOWVARIABLE:
+		if req.AirflowVariable == nil {
+			return badRequest("airflowVariable is required for %s objects", object.ObjectType)
+		}
+		object.AirflowVariable = &platform_v1.EnvironmentObjectAirflowVariable{
+			Value:    lo.FromPtr(req.AirflowVariable.Value),
+			IsSecret: lo.FromPtr(req.AirflowVariable.IsSecret),
+		}
+	case platform_v1.EnvironmentObjectObjectTypeENVIRONMENTVARIABLE:
+		if req.EnvironmentVariable == nil {
+			return badRequest("environmentVariable is required for %s objects", object.ObjectType)
+		}
+		object.EnvironmentVariable = &platform_v1.EnvironmentObjectEnvironmentVariable{
+			Value:    lo.FromPtr(req.EnvironmentVariable.Value),
+			IsSecret: lo.FromPtr(req.EnvironmentVariable.IsSecret),
+		}
+	case platform_v1.EnvironmentObjectObjectTypeCONNECTION:
+		if req.Connection == nil || req.Connection.Type == "" {
+			return badRequest("connection with a type is required for %s objects", object.ObjectType)
+		}
+		object.Connection = convert[*platform_v1.EnvironmentObjectConnection](req.Connection)
+		object.Connection.ConnectionAuthType = connectionAuthType(req.Connection.AuthTypeId, req.Connection.Type)
+	case platform_v1.EnvironmentObjectObjectTypeMETRICSEXPORT:
+		if req.MetricsExport == nil || req.MetricsExport.Endpoint == "" {
+			return badRequest("metricsExport with an endpoint is required for %s objects", object.ObjectType)
+		}
+		object.MetricsExport = convert[*platform_v1.EnvironmentObjectMetricsExport](req.MetricsExport)
+	default:
+		return badRequest("invalid object type %s", req.ObjectType)
+	}
+	if err := org.setEnvironmentObjectLinks(object, convert[*[]platform_v1.UpdateEnvironmentObjectLinkRequest](req.Links), req.ExcludeLinks); err != nil {
+		return badRequest("%s", err)
+	}
+	object.UpdatedAt, object.UpdatedBy = object.CreatedAt, object.CreatedBy
+	org.environmentObjects.put(*object.Id, object)
+	return http.StatusOK, platform_v1.CreateEnvironmentObject{Id: *object.Id}
+}
+
+func (s *Server) getEnvironmentObject(org *organization, r *http.Request) (int, any) {
+	object, ok := org.environmentObjects.get(r.PathValue("environmentObjectId"))
+	if !ok {
+		return notFound("environment object", r.PathValue("environmentObjectId"))
+	}
+	return http.StatusOK, environmentObjectResponse(object, false)
+}
+
+func (s *Server) updateEnvironmentObject(org *organization, r *http.Request) (int, any) {
+	object, ok := org.environmentObjects.get(r.PathValue("environmentObjectId"))
+	if !ok {
+		return notFound("environment object", r.PathValue("environmentObjectId"))
+	}
+	var req platform_v1.UpdateEnvironmentObjectRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	if object.Scope == platform_v1.EnvironmentObjectScopeDEPLOYMENT &&
+		(len(lo.FromPtr(req.Links)) > 0 || lo.FromPtr(req.AutoLinkDeployments) || len(lo.FromPtr(req.ExcludeLinks)) > 0) {
+		return badRequest("links can only be set on workspace environment objects")
+	}
+	updated := convert[platform_v1.EnvironmentObject](object)
+	if req.Description != nil {
+		updated.Description = req.Description
+	}
+	if req.AutoLinkDeployments != nil {
+		updated.AutoLinkDeployments = req.AutoLinkDeployments
+	}
+	// secret values are write-only, so a value missing from the request keeps the stored one
+	switch {
+	case req.AirflowVariable != nil && updated.AirflowVariable != nil:
+		updated.AirflowVariable.Value = lo.FromPtrOr(req.AirflowVariable.Value, updated.AirflowVariable.Value)
+	case req.EnvironmentVariable != nil && updated.EnvironmentVariable != nil:
+		updated.EnvironmentVariable.Value = lo.FromPtrOr(req.EnvironmentVariable.Value, updated.EnvironmentVariable.Value)
+	case req.Connection != nil && updated.Connection != nil:
+		password := coalesce(req.Connection.Password, updated.Connection.Password)
+		updated.Connection = convert[*platform_v1.EnvironmentObjectConnection](req.Connection)
+		updated.Connection.Password = password
+		updated.Connection.ConnectionAuthType = connectionAuthType(req.Connection.AuthTypeId, req.Connection.Type)
+	case req.MetricsExport != nil && updated.MetricsExport != nil:
+		password := coalesce(req.MetricsExport.Password, updated.MetricsExport.Password)
+		basicToken := coalesce(req.MetricsExport.BasicToken, updated.MetricsExport.BasicToken)
+		metricsExport := convert[platform_v1.EnvironmentObjectMetricsExport](req.MetricsExport)
+		metricsExport.Endpoint = coalesce(metricsExport.Endpoint, updated.MetricsExport.Endpoint)
+		metricsExport.ExporterType = coalesce(metricsExport.ExporterType, updated.MetricsExport.ExporterType)
+		metricsExport.Password, metricsExport.BasicToken = password, basicToken
+		updated.MetricsExport = &metricsExport
+	case req.AirflowVariable != nil || req.EnvironmentVariable != nil || req.Connection != nil || req.MetricsExport != nil:
+		return badRequest("the object type of %s %s cannot be changed", updated.ObjectType, updated.ObjectKey)
+	}
+	if err := org.setEnvironmentObjectLinks(&updated, req.Links, req.ExcludeLinks); err != nil {
+		return badRequest("%s", err)
+	}
+	updated.UpdatedAt = lo.ToPtr(now().Format(timeFormat))
+	updated.UpdatedBy = lo.ToPtr(convert[platform_v1.BasicSubjectProfile](org.iamSubject()))
+	*object = updated
+	return http.StatusOK, environmentObjectResponse(object, false)
+}
+
+func (s *Server) deleteEnvironmentObject(org *organization, r *http.Request) (int, any) {
+	if !org.environmentObjects.remove(r.PathValue("environmentObjectId")) {
+		return notFound("environment object", r.PathValue("environmentObjectId"))
+	}
+	return noContent()
+}
+
+func (s *Server) excludeLinkingEnvironmentObject(org *organization, r *http.Request) (int, any) {
+	object, ok := org.environmentObjects.get(r.PathValue("environmentObjectId"))
+	if !ok {
+		return notFound("environment object", r.PathValue("environmentObjectId"))
+	}
+	var req platform_v1.ExcludeLinkEnvironmentObjectRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("%s", err)
+	}
+	excludeLinks := append(lo.FromPtr(convert[*[]platform_v1.ExcludeLinkEnvironmentObjectRequest](object.ExcludeLinks)), req)
+	links := convert[*[]platform_v1.UpdateEnvironmentObjectLinkRequest](object.Links)
+	if err := org.setEnvironmentObjectLinks(object, links, &excludeLinks); err != nil {
+		return badRequest("%s", err)
+	}
+	return noContent()
+}
+
+// setEnvironmentObjectLinks replaces the links and exclude links of a workspace environment object with the ones in
+// the request. Nil leaves them unchanged.
+func (o *organization) setEnvironmentObjectLinks(
+	object *platform_v1.EnvironmentObject,
+	links *[]platform_v1.UpdateEnvironmentObjectLinkRequest,
+	excludeLinks *[]platform_v1.ExcludeLinkEnvironmentObjectRequest,
+) error {
+	checkDeployment := func(deploymentId string) error {
+		deployment, ok := o.deployments.get(deploymentId)
+		if !ok {
+			return fmt.Errorf("deployment with id %s not found", deploymentId)
+		}
+		if deployment.WorkspaceId != object.ScopeEntityId {
+			return fmt.Errorf("deployment %s is not in workspace %s", deploymentId, object.ScopeEntityId)
+		}
+		return nil
+	}
+	if links != nil {
+		objectLinks := make([]platform_v1.EnvironmentObjectLink, 0, len(*links))
+		for _, link := range *links {
+			if err := checkDeployment(link.ScopeEntityId); err != nil {
+				return err
+			}
+			objectLink := platform_v1.EnvironmentObjectLink{
+				Scope:         platform_v1.EnvironmentObjectLinkScope(link.Scope),
+				ScopeEntityId: link.ScopeEntityId,
+			}
+			if overrides := link.Overrides; overrides != nil {
+				objectLink.AirflowVariableOverrides = convert[*platform_v1.EnvironmentObjectAirflowVariableOverrides](overrides.AirflowVariable)
+				objectLink.EnvironmentVariableOverrides = convert[*platform_v1.EnvironmentObjectEnvironmentVariableOverrides](overrides.EnvironmentVariable)
+				objectLink.ConnectionOverrides = convert[*platform_v1.EnvironmentObjectConnectionOverrides](overrides.Connection)
+				objectLink.MetricsExportOverrides = convert[*platform_v1.EnvironmentObjectMetricsExportOverrides](overrides.MetricsExport)
+			}
+			objectLinks = append(objectLinks, objectLink)
+		}
+		object.Links = &objectLinks
+	}
+	if excludeLinks != nil {
+		objectExcludeLinks := make([]platform_v1.EnvironmentObjectExcludeLink, 0, len(*excludeLinks))
+		for _, link := range *excludeLinks {
+			if err := checkDeployment(link.ScopeEntityId); err != nil {
+				return err
+			}
+			objectExcludeLinks = append(objectExcludeLinks, platform_v1.EnvironmentObjectExcludeLink{
+				Scope:         platform_v1.EnvironmentObjectExcludeLinkScope(link.Scope),
+				ScopeEntityId: link.ScopeEntityId,
+			})
+		}
+		object.ExcludeLinks = &objectExcludeLinks
+	}
+	return nil
+}
+
+// connectionAuthType returns the auth type of a connection created with authTypeId. The fake API has no catalog of
+// auth types, so it describes one with no parameters.
+func connectionAuthType(authTypeId *string, connectionType string) *platform_v1.ConnectionAuthType {
+	if authTypeId == nil {
+		return nil
+	}
+	return &platform_v1.ConnectionAuthType{
+		Id:                  *authTypeId,
+		Name:                *authTypeId,
+		AirflowType:         connectionType,
+		AuthMethodName:      *authTypeId,
+		Description:         "Auth type " + *authTypeId,
+		ProviderPackageName: "apache-airflow-providers-" + connectionType,
+		Parameters:          []platform_v1.ConnectionAuthTypeParameter{},
+	}
+}
+
+// environmentObjectResponse returns the object as returned by the API, with its secret values removed unless
+// showSecrets is true and the set fields computed
+func environmentObjectResponse(object *platform_v1.EnvironmentObject, showSecrets bool) platform_v1.EnvironmentObject {
+	resp := convert[platform_v1.EnvironmentObject](object)
+	switch {
+	case resp.AirflowVariable != nil:
+		resp.SetFields = setFields(resp.AirflowVariable)
+		if resp.AirflowVariable.IsSecret && !showSecrets {
+			resp.AirflowVariable.Value = ""
+		}
+	case resp.EnvironmentVariable != nil:
+		resp.SetFields = setFields(resp.EnvironmentVariable)
+		if resp.EnvironmentVariable.IsSecret && !showSecrets {
+			resp.EnvironmentVariable.Value = ""
+		}
+	case resp.Connection != nil:
+		resp.SetFields = setFields(resp.Connection)
+		if !showSecrets {
+			resp.Connection.Password = nil
+		}
+	case resp.MetricsExport != nil:
+		resp.SetFields = setFields(resp.MetricsExport)
+		if !showSecrets {
+			resp.MetricsExport.Password, resp.MetricsExport.BasicToken = nil, nil
+		}
+	}
+	for i, link := range lo.FromPtr(resp.Links) {
+		link.SetFields = []string{}
+		if link.AirflowVariableOverrides != nil {
+			link.SetFields = setFields(link.AirflowVariableOverrides)
+			if resp.AirflowVariable != nil && resp.AirflowVariable.IsSecret && !showSecrets {
+				link.AirflowVariableOverrides.Value = ""
+			}
+		}
+		if link.EnvironmentVariableOverrides != nil {
+			link.SetFields = setFields(link.EnvironmentVariableOverrides)
+			if resp.EnvironmentVariable != nil && resp.EnvironmentVariable.IsSecret && !showSecrets {
+				link.EnvironmentVariableOverrides.Value = ""
+			}
+		}
+		if link.ConnectionOverrides != nil {
+			link.SetFields = setFields(link.ConnectionOverrides)
+			if !showSecrets {
+				link.ConnectionOverrides.Password = nil
+			}
+		}
+		if link.MetricsExportOverrides != nil {
+			link.SetFields = setFields(link.MetricsExportOverrides)
+			if !showSecrets {
+				link.MetricsExportOverrides.Password, link.MetricsExportOverrides.BasicToken = nil, nil
+			}
+		}
+		(*resp.Links)[i] = link
+	}
+	return resp
+}
+
+// setFields returns the sorted names of the fields of v that have a value
+func setFields(v any) []string {
+	fields := convert[map[string]json.RawMessage](v)
+	names := []string{}
+	for name, value := range fields {
+		if string(value) != "null" && string(value) != `""` {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// coalesce returns the first of values that is not the zero value
+func coalesce[T comparable](values ...T) T {
+	result, _ := lo.Coalesce(values...)
+	return result
+}
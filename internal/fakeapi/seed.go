@@ -0,0 +1,427 @@
+package fakeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/lucsky/cuid"
+	"github.com/samber/lo"
+)
+
+// TestRunnerIpCidr is the allowed IP address range the acceptance tests add for the machine running them
+const TestRunnerIpCidr = "127.0.0.1/32"
+
+// AddOrganization adds an organization with an API token owning it and returns the organization ID and the token
+func (s *Server) AddOrganization(name string, product platform.OrganizationProduct, isScimEnabled bool) (string, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	org := s.addOrganization(name, product, isScimEnabled)
+	return org.Id, org.token
+}
+
+func (s *Server) addOrganization(name string, product platform.OrganizationProduct, isScimEnabled bool) *organization {
+	org := &organization{tokenId: cuid.New()}
+	token := newApiToken(org, name+" token", "", nil)
+	token.Id = org.tokenId
+	token.Type = iam.ApiTokenTypeORGANIZATION
+	token.Kind = iam.ApiTokenKindSTANDARD
+	token.LastUsedAt = lo.ToPtr(now())
+	org.token = lo.FromPtr(token.Token)
+	org.Organization = platform.Organization{
+		Id:            strings.ToLower(cuid.New()),
+		Name:          name,
+		IsScimEnabled: isScimEnabled,
+		Product:       &product,
+		Status:        lo.ToPtr(platform.OrganizationStatusACTIVE),
+		SupportPlan:   platform.OrganizationSupportPlanBUSINESS,
+		PaymentMethod: lo.ToPtr(platform.OrganizationPaymentMethod("INVOICE")),
+		CreatedAt:     now(),
+		UpdatedAt:     now(),
+	}
+	token.Roles = &[]iam.ApiTokenRole{{
+		EntityId:   org.Id,
+		EntityType: iam.ApiTokenRoleEntityTypeORGANIZATION,
+		Role:       string(iam.UserOrganizationRoleORGANIZATIONOWNER),
+	}}
+	org.apiTokens.put(token.Id, token)
+	org.CreatedBy = org.platformSubject()
+	org.UpdatedBy = org.platformSubject()
+	s.organizations[org.Id] = org
+	return org
+}
+
+// Seed adds the HOSTED, HYBRID and HOSTED_SCIM organizations used by the acceptance tests, with the workspaces,
+// clusters, deployments and other objects they expect to exist. It returns the environment variables read by the
+// acceptance tests, e.g. HOSTED_ORGANIZATION_ID, except ASTRO_API_HOST.
+func (s *Server) Seed() (map[string]string, error) {
+	env := map[string]string{"ACC_TEST_RUNNER_IP_CIDR": TestRunnerIpCidr}
+	s.mu.Lock()
+	hosted := s.addOrganization("Hosted Organization", platform.OrganizationProductHOSTED, false)
+	hybrid := s.addOrganization("Hybrid Organization", platform.OrganizationProductHYBRID, false)
+	scim := s.addOrganization("SCIM Organization", platform.OrganizationProductHOSTED, true)
+	s.mu.Unlock()
+
+	env["HOSTED_ORGANIZATION_ID"], env["HOSTED_ORGANIZATION_API_TOKEN"] = hosted.Id, hosted.token
+	env["HYBRID_ORGANIZATION_ID"], env["HYBRID_ORGANIZATION_API_TOKEN"] = hybrid.Id, hybrid.token
+	env["HOSTED_SCIM_ORGANIZATION_ID"], env["HOSTED_SCIM_ORGANIZATION_API_TOKEN"] = scim.Id, scim.token
+
+	if err := s.seedHosted(hosted, env); err != nil {
+		return nil, fmt.Errorf("failed to seed the hosted organization: %w", err)
+	}
+	if err := s.seedHybrid(hybrid, env); err != nil {
+		return nil, fmt.Errorf("failed to seed the hybrid organization: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// seeded clusters and deployments are ready to use
+	for _, org := range []*organization{hosted, hybrid, scim} {
+		for id, t := range org.transitions {
+			if cluster, ok := org.clusters.get(id); ok {
+				cluster.Status = platform.ClusterStatus(t.finalStatus)
+			}
+			if deployment, ok := org.deployments.get(id); ok {
+				deployment.Status = platform.DeploymentStatus(t.finalStatus)
+			}
+			delete(org.transitions, id)
+		}
+	}
+	return env, nil
+}
+
+func (s *Server) seedHosted(org *organization, env map[string]string) error {
+	var workspace platform.Workspace
+	if err := s.seed(org, http.MethodPost, platformPrefix+"/workspaces", map[string]any{
+		"name":        "Acceptance Tests",
+		"description": "Workspace used by the acceptance tests",
+	}, &workspace); err != nil {
+		return err
+	}
+	env["HOSTED_WORKSPACE_ID"] = workspace.Id
+
+	var cluster platform.Cluster
+	if err := s.seed(org, http.MethodPost, platformPrefix+"/clusters", map[string]any{
+		"name":           "acceptance-tests-dedicated",
+		"type":           platform.ClusterTypeDEDICATED,
+		"cloudProvider":  platform.ClusterCloudProviderAWS,
+		"region":         "us-east-1",
+		"vpcSubnetRange": "172.20.0.0/20",
+		"workspaceIds":   []string{workspace.Id},
+	}, &cluster); err != nil {
+		return err
+	}
+	env["HOSTED_DEDICATED_CLUSTER_ID"] = cluster.Id
+
+	deployment := map[string]any{
+		"name":                 "acceptance-tests-dedicated",
+		"description":          "Dedicated deployment used by the acceptance tests",
+		"type":                 platform.DeploymentTypeDEDICATED,
+		"clusterId":            cluster.Id,
+		"workspaceId":          workspace.Id,
+		"astroRuntimeVersion":  runtimeReleases[1].Version,
+		"executor":             platform.DeploymentExecutorCELERY,
+		"schedulerSize":        "SMALL",
+		"isHighAvailability":   false,
+		"isDevelopmentMode":    false,
+		"isDagDeployEnabled":   true,
+		"defaultTaskPodCpu":    "0.25",
+		"defaultTaskPodMemory": "0.5Gi",
+		"resourceQuotaCpu":     "10",
+		"resourceQuotaMemory":  "20Gi",
+		"workerQueues": []map[string]any{{
+			"name":              "default",
+			"isDefault":         true,
+			"astroMachine":      "A5",
+			"minWorkerCount":    0,
+			"maxWorkerCount":    10,
+			"workerConcurrency": 5,
+		}},
+		"environmentVariables": []map[string]any{{"key": "ENVIRONMENT", "value": "test", "isSecret": false}},
+	}
+	var dedicated platform.Deployment
+	if err := s.seed(org, http.MethodPost, platformPrefix+"/deployments", deployment, &dedicated); err != nil {
+		return err
+	}
+	env["HOSTED_DEPLOYMENT_ID"] = dedicated.Id
+
+	deployment["name"] = "acceptance-tests-standard"
+	deployment["description"] = "Standard deployment used by the acceptance tests"
+	deployment["type"] = platform.DeploymentTypeSTANDARD
+	deployment["cloudProvider"] = platform.DeploymentCloudProviderAWS
+	deployment["region"] = "us-east-1"
+	delete(deployment, "clusterId")
+	var standard platform.Deployment
+	if err := s.seed(org, http.MethodPost, platformPrefix+"/deployments", deployment, &standard); err != nil {
+		return err
+	}
+	env["HOSTED_STANDARD_DEPLOYMENT_ID"] = standard.Id
+
+	deployment["name"] = "acceptance-tests-remote-execution"
+	deployment["description"] = "Remote execution deployment used by the acceptance tests"
+	deployment["type"] = platform.DeploymentTypeDEDICATED
+	deployment["clusterId"] = cluster.Id
+	deployment["astroRuntimeVersion"] = runtimeReleases[0].Version
+	deployment["executor"] = platform.DeploymentExecutorASTRO
+	deployment["remoteExecution"] = map[string]any{"enabled": true, "allowedIpAddressRanges": []string{"0.0.0.0/0"}}
+	delete(deployment, "cloudProvider")
+	delete(deployment, "region")
+	delete(deployment, "workerQueues")
+	var remoteExecution platform.Deployment
+	if err := s.seed(org, http.MethodPost, platformPrefix+"/deployments", deployment, &remoteExecution); err != nil {
+		return err
+	}
+	env["REMOTE_EXECUTION_DEPLOYMENT_ID"] = remoteExecution.Id
+
+	// users cannot be created through the API, they join the organization by accepting an invite
+	s.mu.Lock()
+	owner := addUser(org, "owner@astronomer.test", "Organization Owner", iam.UserOrganizationRoleORGANIZATIONOWNER)
+	owner.WorkspaceRoles = &[]iam.WorkspaceRole{{WorkspaceId: workspace.Id, Role: iam.WORKSPACEOWNER}}
+	owner.DeploymentRoles = &[]iam.DeploymentRole{{DeploymentId: dedicated.Id, Role: "DEPLOYMENT_ADMIN"}}
+	member := addUser(org, "member@astronomer.test", "Organization Member", iam.UserOrganizationRoleORGANIZATIONMEMBER)
+	dummy := addUser(org, "dummy@astronomer.test", "Dummy User", iam.UserOrganizationRoleORGANIZATIONMEMBER)
+	s.mu.Unlock()
+	env["HOSTED_USER_ID"] = owner.Id
+	env["HOSTED_DUMMY_USER_ID"] = dummy.Id
+
+	var team iam.Team
+	if err := s.seed(org, http.MethodPost, iamPrefix+"/teams", map[string]any{
+		"name":        "acceptance-tests",
+		"description": "Team used by the acceptance tests",
+		"memberIds":   []string{owner.Id, member.Id},
+	}, &team); err != nil {
+		return err
+	}
+	if err := s.seed(org, http.MethodPost, iamPrefix+"/teams/"+team.Id+"/roles", map[string]any{
+		"organizationRole": iam.TeamOrganizationRoleORGANIZATIONMEMBER,
+		"workspaceRoles":   []map[string]any{{"workspaceId": workspace.Id, "role": iam.WORKSPACEMEMBER}},
+	}, nil); err != nil {
+		return err
+	}
+	env["HOSTED_TEAM_ID"] = team.Id
+
+	var token iam.ApiToken
+	if err := s.seed(org, http.MethodPost, iamPrefix+"/tokens", map[string]any{
+		"name":        "acceptance-tests-workspace",
+		"description": "Workspace API token used by the acceptance tests",
+		"type":        iam.CreateApiTokenRequestTypeWORKSPACE,
+		"entityId":    workspace.Id,
+		"role":        iam.WORKSPACEMEMBER,
+	}, &token); err != nil {
+		return err
+	}
+	env["HOSTED_API_TOKEN_ID"] = token.Id
+
+	var deploymentToken iam.ApiToken
+	if err := s.seed(org, http.MethodPost, iamPrefix+"/tokens", map[string]any{
+		"name":     "acceptance-tests-deployment",
+		"type":     iam.CreateApiTokenRequestTypeDEPLOYMENT,
+		"entityId": dedicated.Id,
+		"role":     "DEPLOYMENT_ADMIN",
+	}, &deploymentToken); err != nil {
+		return err
+	}
+	env["HOSTED_DEPLOYMENT_API_TOKEN"] = lo.FromPtr(deploymentToken.Token)
+
+	s.mu.Lock()
+	for _, t := range []string{token.Id, deploymentToken.Id} {
+		if seeded, ok := org.apiTokens.get(t); ok {
+			seeded.LastUsedAt = lo.ToPtr(now())
+		}
+	}
+	s.mu.Unlock()
+
+	var role iam.RoleWithPermission
+	if err := s.seed(org, http.MethodPost, iamPrefix+"/roles", map[string]any{
+		"name":                   "ACCEPTANCE_TESTS_DEPLOYMENT_VIEWER",
+		"description":            "Custom role used by the acceptance tests",
+		"scopeType":              "DEPLOYMENT",
+		"permissions":            []string{"deployment.get", "deployment.dags.get"},
+		"restrictedWorkspaceIds": []string{workspace.Id},
+	}, &role); err != nil {
+		return err
+	}
+	env["HOSTED_CUSTOM_ROLE_ID"] = role.Id
+
+	var channel platform.NotificationChannel
+	if err := s.seed(org, http.MethodPost, platformPrefix+"/notification-channels", map[string]any{
+		"name":       "acceptance-tests-email",
+		"type":       "EMAIL",
+		"entityId":   dedicated.Id,
+		"entityType": "DEPLOYMENT",
+		"isShared":   true,
+		"definition": map[string]any{"recipients": []string{"alerts@astronomer.test"}},
+	}, &channel); err != nil {
+		return err
+	}
+	env["HOSTED_NOTIFICATION_CHANNEL_ID"] = channel.Id
+	if err := s.seed(org, http.MethodPost, platformPrefix+"/notification-channels", map[string]any{
+		"name":       "acceptance-tests-slack",
+		"type":       "SLACK",
+		"entityId":   org.Id,
+		"entityType": "ORGANIZATION",
+		"isShared":   true,
+		"definition": map[string]any{"webhookUrl": "https://hooks.slack.test/services/acceptance-tests"},
+	}, nil); err != nil {
+		return err
+	}
+
+	var alert platform.Alert
+	if err := s.seed(org, http.MethodPost, platformPrefix+"/alerts", map[string]any{
+		"name":                   "acceptance-tests-dag-failure",
+		"type":                   "DAG_FAILURE",
+		"severity":               "INFO",
+		"entityId":               dedicated.Id,
+		"entityType":             "DEPLOYMENT",
+		"notificationChannelIds": []string{channel.Id},
+		"rules": map[string]any{
+			"properties": map[string]any{"deploymentId": dedicated.Id},
+			"patternMatches": []map[string]any{
+				{"entityType": "DAG_ID", "operatorType": "IS", "values": []string{"example_dag"}},
+				{"entityType": "TASK_ID", "operatorType": "INCLUDES", "values": []string{"extract", "load"}},
+			},
+		},
+	}, &alert); err != nil {
+		return err
+	}
+	env["HOSTED_ALERT_ID"] = alert.Id
+	if err := s.seed(org, http.MethodPost, platformPrefix+"/alerts", map[string]any{
+		"name":                   "acceptance-tests-task-failure",
+		"type":                   "TASK_FAILURE",
+		"severity":               "WARNING",
+		"entityId":               dedicated.Id,
+		"entityType":             "DEPLOYMENT",
+		"notificationChannelIds": []string{channel.Id},
+		"rules": map[string]any{
+			"properties": map[string]any{"deploymentId": dedicated.Id},
+			"patternMatches": []map[string]any{
+				{"entityType": "DAG_ID", "operatorType": "IS", "values": []string{"example_dag"}},
+			},
+		},
+	}, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// seedHybrid adds the hybrid clusters of the hybrid organization and a deployment on one of them. Hybrid clusters are
+// provisioned by Astronomer in the customer's cloud account, so they cannot be created through the API.
+func (s *Server) seedHybrid(org *organization, env map[string]string) error {
+	s.mu.Lock()
+	cluster := addHybridCluster(org, "acceptance-tests-hybrid")
+	dryRunCluster := addHybridCluster(org, "acceptance-tests-hybrid-dry-run")
+	s.mu.Unlock()
+	nodePoolId := (*cluster.NodePools)[0].Id
+	env["HYBRID_CLUSTER_ID"] = cluster.Id
+	env["HYBRID_NODE_POOL_ID"] = nodePoolId
+	env["HYBRID_DRY_RUN_CLUSTER_ID"] = dryRunCluster.Id
+
+	var workspace platform.Workspace
+	if err := s.seed(org, http.MethodPost, platformPrefix+"/workspaces", map[string]any{
+		"name":        "Acceptance Tests",
+		"description": "Workspace used by the acceptance tests",
+	}, &workspace); err != nil {
+		return err
+	}
+	if err := s.seed(org, http.MethodPost, platformPrefix+"/clusters/"+cluster.Id, map[string]any{
+		"clusterType":  platform.ClusterTypeHYBRID,
+		"workspaceIds": []string{workspace.Id},
+	}, nil); err != nil {
+		return err
+	}
+	return s.seed(org, http.MethodPost, platformPrefix+"/deployments", map[string]any{
+		"name":                "acceptance-tests-hybrid",
+		"description":         "Hybrid deployment used by the acceptance tests",
+		"type":                platform.DeploymentTypeHYBRID,
+		"clusterId":           cluster.Id,
+		"workspaceId":         workspace.Id,
+		"astroRuntimeVersion": runtimeReleases[1].Version,
+		"executor":            platform.DeploymentExecutorCELERY,
+		"isDagDeployEnabled":  true,
+		"scheduler":           map[string]any{"au": 5, "replicas": 1},
+		"taskPodNodePoolId":   nodePoolId,
+		"workerQueues": []map[string]any{{
+			"name":              "default",
+			"isDefault":         true,
+			"nodePoolId":        nodePoolId,
+			"minWorkerCount":    0,
+			"maxWorkerCount":    10,
+			"workerConcurrency": 16,
+		}},
+	}, nil)
+}
+
+func addUser(org *organization, username, fullName string, organizationRole iam.UserOrganizationRole) *iam.User {
+	user := &iam.User{
+		Id:               cuid.New(),
+		Username:         username,
+		FullName:         fullName,
+		AvatarUrl:        "https://avatars.astronomer.test/" + strings.Split(username, "@")[0],
+		Status:           iam.ACTIVE,
+		OrganizationRole: &organizationRole,
+		CreatedAt:        now(),
+		UpdatedAt:        now(),
+	}
+	org.users.put(user.Id, user)
+	return user
+}
+
+func addHybridCluster(org *organization, name string) *platform.Cluster {
+	cluster := &platform.Cluster{
+		Id:              cuid.New(),
+		Name:            name,
+		OrganizationId:  org.Id,
+		CloudProvider:   platform.ClusterCloudProviderAWS,
+		Region:          "us-east-1",
+		Type:            platform.ClusterTypeHYBRID,
+		Status:          platform.ClusterStatusCREATED,
+		DbInstanceType:  clusterOptions("AWS").DefaultDatabaseInstance.Name,
+		VpcSubnetRange:  "172.30.0.0/20",
+		ProviderAccount: lo.ToPtr("123456789012"),
+		WorkspaceIds:    &[]string{},
+		IsLimited:       lo.ToPtr(false),
+		IsDrEnabled:     false,
+		Metadata: &platform.ClusterMetadata{
+			ExternalIPs:   &[]string{"203.0.113.30"},
+			KubeDnsIp:     lo.ToPtr("10.0.0.10"),
+			OidcIssuerUrl: lo.ToPtr("https://oidc.astronomer.io/" + strings.ToLower(cuid.New())),
+		},
+		CreatedAt: now(),
+		UpdatedAt: now(),
+	}
+	nodePools := clusterNodePools(cluster, nil, []platform.UpdateNodePoolRequest{{
+		Name:             "default",
+		NodeInstanceType: "m5.xlarge",
+		MaxNodeCount:     20,
+		IsDefault:        lo.ToPtr(true),
+	}})
+	cluster.NodePools = &nodePools
+	org.clusters.put(cluster.Id, cluster)
+	return cluster
+}
+
+// seed sends a request to the organization through the fake API routes, so seeded objects get the same defaults and
+// validation as the objects created by the tests
+func (s *Server) seed(org *organization, method, pattern string, body, out any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	path := strings.Replace(pattern, "{organizationId}", org.Id, 1)
+	req := httptest.NewRequest(method, path, bytes.NewReader(data))
+	req.Header.Set("Authorization", "Bearer "+org.token)
+	rec := httptest.NewRecorder()
+	s.mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		return fmt.Errorf("%s %s returned %d: %s", method, path, rec.Code, rec.Body.String())
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(rec.Body.Bytes(), out)
+}
@@ -0,0 +1,247 @@
+// Package fakeapi implements an in-memory stand-in for the Astro platform, IAM, labs and platform v1 APIs. It serves
+// the endpoints called by the provider so the acceptance tests can run without network access or real Astronomer
+// organizations.
+package fakeapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lucsky/cuid"
+)
+
+const (
+	platformPrefix   = "/platform/v1beta1/organizations/{organizationId}"
+	iamPrefix        = "/iam/v1beta1/organizations/{organizationId}"
+	labsPrefix       = "/labs/v1/organizations/{organizationId}"
+	platformV1Prefix = "/v1/organizations/{organizationId}"
+	// fakePrefix is the prefix of the endpoints that only exist in the fake API. They let the tests take actions that
+	// happen outside of the Astro API, e.g. a user accepting an invite.
+	fakePrefix = "/fake/organizations/{organizationId}"
+)
+
+// Server is a fake Astro API listening on a loopback address. The provider can be pointed at it with
+// allow_custom_host and host set to URL.
+type Server struct {
+	// URL is the base URL of the server, e.g. http://127.0.0.1:53712
+	URL string
+	// StatusReads is the number of reads that still return the transitional status of a cluster, e.g. CREATING, after
+	// the create or update response. With the default of 0, the first read returns its final status, e.g. CREATED, so
+	// the provider's status polling finishes after one poll.
+	StatusReads int
+	// DeploymentStartupTime is how long a new deployment is CREATING before it is HEALTHY. The provider does not wait
+	// for deployments to be healthy, so like in Astro, a deployment created by a test is usually CREATING until the end
+	// of the test. Defaults to 10 minutes.
+	DeploymentStartupTime time.Duration
+
+	listener net.Listener
+	server   *http.Server
+	mux      *http.ServeMux
+
+	mu            sync.Mutex
+	organizations map[string]*organization
+}
+
+// handlerFunc handles a request to an organization scoped endpoint and returns the status code and response body
+type handlerFunc func(org *organization, r *http.Request) (int, any)
+
+// NewServer returns a fake Astro API with no organizations. It is not listening until Start is called.
+func NewServer() *Server {
+	s := &Server{
+		DeploymentStartupTime: 10 * time.Minute,
+		mux:                   http.NewServeMux(),
+		organizations:         map[string]*organization{},
+	}
+	s.registerPlatformRoutes()
+	s.registerIamRoutes()
+	s.registerLabsRoutes()
+	s.registerPlatformV1Routes()
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusNotFound, newError(http.StatusNotFound, "no fake API route for %s %s", r.Method, r.URL.Path))
+	})
+	return s
+}
+
+// Start listens on a random loopback port and serves requests in the background
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return fmt.Errorf("failed to listen on a loopback address: %w", err)
+	}
+	s.listener = listener
+	s.URL = "http://" + listener.Addr().String()
+	s.server = &http.Server{Handler: s.mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		_ = s.server.Serve(listener)
+	}()
+	return nil
+}
+
+// Close stops the server
+func (s *Server) Close() error {
+	if s.server == nil {
+		return nil
+	}
+	return s.server.Shutdown(context.Background())
+}
+
+// ServeHTTP allows the server to be used as an http.Handler, e.g. with httptest.NewServer
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// handle registers h for pattern. The request is rejected unless it is authenticated with the API token of the
+// organization in its path. Requests are served one at a time so handlers can use the organization state freely.
+func (s *Server) handle(pattern string, h handlerFunc) {
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		org, ok := s.organizations[r.PathValue("organizationId")]
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		switch {
+		case token == "" || !s.isKnownToken(token):
+			writeJSON(w, http.StatusUnauthorized, newError(http.StatusUnauthorized, "invalid authorization token"))
+			return
+		case !ok:
+			writeJSON(w, http.StatusNotFound, newError(http.StatusNotFound, "organization with id %s not found", r.PathValue("organizationId")))
+			return
+		case token != org.token:
+			writeJSON(w, http.StatusForbidden, newError(http.StatusForbidden, "token does not have access to organization %s", org.Id))
+			return
+		}
+
+		status, body := h(org, r)
+		writeJSON(w, status, body)
+	})
+}
+
+func (s *Server) isKnownToken(token string) bool {
+	for _, org := range s.organizations {
+		if org.token == token {
+			return true
+		}
+	}
+	return false
+}
+
+// errorResponse has the shape of the Error model shared by all the Astro APIs
+type errorResponse struct {
+	Message    string `json:"message"`
+	RequestId  string `json:"requestId"`
+	StatusCode int    `json:"statusCode"`
+}
+
+func newError(statusCode int, format string, args ...any) errorResponse {
+	return errorResponse{
+		Message:    fmt.Sprintf(format, args...),
+		RequestId:  cuid.New(),
+		StatusCode: statusCode,
+	}
+}
+
+// apiError returns an error response for a handler
+func apiError(statusCode int, format string, args ...any) (int, any) {
+	return statusCode, newError(statusCode, format, args...)
+}
+
+func notFound(kind, id string) (int, any) {
+	return apiError(http.StatusNotFound, "%s with id %s not found", kind, id)
+}
+
+func badRequest(format string, args ...any) (int, any) {
+	return apiError(http.StatusBadRequest, format, args...)
+}
+
+// noContent is the response of the delete endpoints
+func noContent() (int, any) {
+	return http.StatusNoContent, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	if body == nil {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// decode reads the JSON body of the request into v
+func decode(r *http.Request, v any) error {
+	if r.Body == nil {
+		return errors.New("missing request body")
+	}
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+	return nil
+}
+
+// queryValues returns the values of a repeated query parameter, e.g. names=a&names=b
+func queryValues(r *http.Request, name string) []string {
+	var values []string
+	for _, value := range r.URL.Query()[name] {
+		for _, v := range strings.Split(value, ",") {
+			if v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
+// page returns the items of a list endpoint selected by the offset and limit query parameters
+func page[T any](r *http.Request, items []T) (pageItems []T, offset int, limit int) {
+	offset, _ = strconv.Atoi(r.URL.Query().Get("offset"))
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	if offset >= len(items) {
+		return []T{}, offset, limit
+	}
+	end := min(offset+limit, len(items))
+	return items[offset:end], offset, limit
+}
+
+// filter returns the items for which keep returns true
+func filter[T any](items []T, keep func(T) bool) []T {
+	filtered := make([]T, 0, len(items))
+	for _, item := range items {
+		if keep(item) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// matchesAny returns true if values is empty or contains value
+func matchesAny(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// timeFormat is the format of the timestamps the Astro API models represent as strings
+const timeFormat = time.RFC3339
+
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
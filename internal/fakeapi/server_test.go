@@ -0,0 +1,137 @@
+package fakeapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/fakeapi"
+)
+
+func TestUnit_FakeApi(t *testing.T) {
+	ctx := context.Background()
+	server := fakeapi.NewServer()
+	server.DeploymentStartupTime = 0
+	env, err := server.Seed()
+	require.NoError(t, err)
+	srv := httptest.NewServer(server)
+	defer srv.Close()
+
+	orgId, token := env["HOSTED_ORGANIZATION_ID"], env["HOSTED_ORGANIZATION_API_TOKEN"]
+	platformClient, err := platform.NewPlatformClient(srv.URL, token, "test")
+	require.NoError(t, err)
+
+	t.Run("seeds the resources read by the acceptance tests", func(t *testing.T) {
+		for _, key := range []string{
+			"HOSTED_ORGANIZATION_ID", "HOSTED_ORGANIZATION_API_TOKEN",
+			"HYBRID_ORGANIZATION_ID", "HYBRID_ORGANIZATION_API_TOKEN",
+			"HOSTED_SCIM_ORGANIZATION_ID", "HOSTED_SCIM_ORGANIZATION_API_TOKEN",
+			"HYBRID_CLUSTER_ID", "HYBRID_DRY_RUN_CLUSTER_ID", "HYBRID_NODE_POOL_ID",
+			"HOSTED_DEPLOYMENT_ID", "HOSTED_STANDARD_DEPLOYMENT_ID", "HOSTED_API_TOKEN_ID",
+		} {
+			assert.NotEmpty(t, env[key], key)
+		}
+
+		deployment, err := platformClient.GetDeploymentWithResponse(ctx, orgId, env["HOSTED_DEPLOYMENT_ID"])
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, deployment.StatusCode(), string(deployment.Body))
+		assert.Equal(t, platform.DeploymentStatusHEALTHY, deployment.JSON200.Status)
+	})
+
+	t.Run("rejects requests without a valid token", func(t *testing.T) {
+		invalidClient, err := platform.NewPlatformClient(srv.URL, "invalid", "test")
+		require.NoError(t, err)
+		resp, err := invalidClient.GetOrganizationWithResponse(ctx, orgId, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	})
+
+	t.Run("rejects requests with the token of another organization", func(t *testing.T) {
+		hybridClient, err := platform.NewPlatformClient(srv.URL, env["HYBRID_ORGANIZATION_API_TOKEN"], "test")
+		require.NoError(t, err)
+		resp, err := hybridClient.GetOrganizationWithResponse(ctx, orgId, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode())
+	})
+
+	t.Run("returns not found for unknown resources", func(t *testing.T) {
+		resp, err := platformClient.GetClusterWithResponse(ctx, orgId, "unknown")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+		require.NotNil(t, resp.JSON404)
+		assert.Equal(t, "cluster with id unknown not found", resp.JSON404.Message)
+	})
+
+	t.Run("clusters are CREATING for StatusReads reads", func(t *testing.T) {
+		server.StatusReads = 2
+		defer func() { server.StatusReads = 0 }()
+
+		var cluster platform.Cluster
+		post(t, srv.URL+"/platform/v1beta1/organizations/"+orgId+"/clusters", token, map[string]any{
+			"name":          "status-reads",
+			"type":          platform.ClusterTypeDEDICATED,
+			"cloudProvider": platform.ClusterCloudProviderAWS,
+			"region":        "us-east-1",
+		}, &cluster)
+		assert.Equal(t, platform.ClusterStatusCREATING, cluster.Status)
+
+		for _, expected := range []platform.ClusterStatus{
+			platform.ClusterStatusCREATING,
+			platform.ClusterStatusCREATING,
+			platform.ClusterStatusCREATED,
+		} {
+			resp, err := platformClient.GetClusterWithResponse(ctx, orgId, cluster.Id)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+			assert.Equal(t, expected, resp.JSON200.Status)
+		}
+	})
+
+	t.Run("deployments are HEALTHY after DeploymentStartupTime", func(t *testing.T) {
+		seeded, err := platformClient.GetDeploymentWithResponse(ctx, orgId, env["HOSTED_STANDARD_DEPLOYMENT_ID"])
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, seeded.StatusCode(), string(seeded.Body))
+
+		var deployment platform.Deployment
+		post(t, srv.URL+"/platform/v1beta1/organizations/"+orgId+"/deployments", token, map[string]any{
+			"name":                "startup-time",
+			"type":                platform.DeploymentTypeSTANDARD,
+			"cloudProvider":       platform.DeploymentCloudProviderAWS,
+			"region":              "us-east-1",
+			"workspaceId":         seeded.JSON200.WorkspaceId,
+			"astroRuntimeVersion": seeded.JSON200.AstroRuntimeVersion,
+			"executor":            platform.DeploymentExecutorCELERY,
+			"schedulerSize":       "SMALL",
+		}, &deployment)
+		assert.Equal(t, platform.DeploymentStatusCREATING, deployment.Status)
+
+		resp, err := platformClient.GetDeploymentWithResponse(ctx, orgId, deployment.Id)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+		assert.Equal(t, platform.DeploymentStatusHEALTHY, resp.JSON200.Status)
+	})
+}
+
+// post sends body to url and decodes the response into out. The generated clients are not used here because the
+// create requests are unions that are cumbersome to build in a test.
+func post(t *testing.T, url, token string, body, out any) {
+	t.Helper()
+	data, err := json.Marshal(body)
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(out))
+}
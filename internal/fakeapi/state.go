@@ -0,0 +1,147 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+)
+
+// organization holds the in-memory state of one Astro organization
+type organization struct {
+	platform.Organization
+	// token is the API token accepted for requests to the organization
+	token string
+	// tokenId is the ID of the organization API token, used as the creator of the objects created through the API
+	tokenId string
+
+	workspaces           records[platform.Workspace]
+	clusters             records[platform.Cluster]
+	deployments          records[platform.Deployment]
+	notificationChannels records[platform.NotificationChannel]
+	alerts               records[platform.Alert]
+	environmentObjects   records[platform_v1.EnvironmentObject]
+	teams                records[iam.Team]
+	users                records[iam.User]
+	apiTokens            records[iam.ApiToken]
+	agentTokens          records[agentToken]
+	invites              records[iam.Invite]
+	roles                records[iam.RoleWithPermission]
+	allowedIpRanges      records[iam.AllowedIpAddressRange]
+	// teamMembers maps a team ID to the IDs of its members
+	teamMembers map[string][]string
+	// transitions maps the ID of a cluster or deployment to its pending status transition
+	transitions map[string]*transition
+}
+
+// agentToken is an API token scoped to a deployment
+type agentToken struct {
+	iam.ApiToken
+	deploymentId string
+}
+
+// records holds the objects of one kind in creation order. The zero value is ready to use.
+type records[T any] struct {
+	ids   []string
+	items map[string]*T
+}
+
+func (r *records[T]) get(id string) (*T, bool) {
+	item, ok := r.items[id]
+	return item, ok
+}
+
+func (r *records[T]) put(id string, item *T) {
+	if r.items == nil {
+		r.items = map[string]*T{}
+	}
+	if _, ok := r.items[id]; !ok {
+		r.ids = append(r.ids, id)
+	}
+	r.items[id] = item
+}
+
+func (r *records[T]) remove(id string) bool {
+	if _, ok := r.items[id]; !ok {
+		return false
+	}
+	delete(r.items, id)
+	for i, existing := range r.ids {
+		if existing == id {
+			r.ids = append(r.ids[:i], r.ids[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+func (r *records[T]) list() []*T {
+	items := make([]*T, 0, len(r.ids))
+	for _, id := range r.ids {
+		items = append(items, r.items[id])
+	}
+	return items
+}
+
+// transition moves a cluster or deployment from a transitional status, e.g. CREATING, to its final status after it
+// has been read a number of times and a duration has passed, like an Astro API workflow completing in the background
+type transition struct {
+	remainingReads int
+	readyAt        time.Time
+	finalStatus    string
+}
+
+// startTransition sets the final status of the object with the given ID once it has been read reads times and delay
+// has passed
+func (o *organization) startTransition(id, finalStatus string, reads int, delay time.Duration) {
+	if o.transitions == nil {
+		o.transitions = map[string]*transition{}
+	}
+	o.transitions[id] = &transition{remainingReads: reads, readyAt: now().Add(delay), finalStatus: finalStatus}
+}
+
+// advance records a read of the object with the given ID and returns its final status once its transition is over
+func (o *organization) advance(id string) (string, bool) {
+	t, ok := o.transitions[id]
+	if !ok {
+		return "", false
+	}
+	if t.remainingReads > 0 {
+		t.remainingReads--
+		return "", false
+	}
+	if now().Before(t.readyAt) {
+		return "", false
+	}
+	delete(o.transitions, id)
+	return t.finalStatus, true
+}
+
+// platformSubject returns the profile of the organization API token, the creator of objects created through the API
+func (o *organization) platformSubject() platform.BasicSubjectProfile {
+	return convert[platform.BasicSubjectProfile](o.iamSubject())
+}
+
+func (o *organization) iamSubject() iam.BasicSubjectProfile {
+	subjectType := iam.SERVICEKEY
+	profile := iam.BasicSubjectProfile{Id: o.tokenId, SubjectType: &subjectType}
+	if token, ok := o.apiTokens.get(o.tokenId); ok {
+		profile.ApiTokenName = &token.Name
+	}
+	return profile
+}
+
+// convert copies v into a T with the same JSON representation, e.g. the same model generated in two API clients
+func convert[T any](v any) T {
+	var converted T
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(data, &converted); err != nil {
+		panic(err)
+	}
+	return converted
+}
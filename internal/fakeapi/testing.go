@@ -0,0 +1,125 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+)
+
+// EnvVar selects the API the acceptance tests run against. When true, they run against a seeded fake API, and when
+// false against the Astro API configured by the environment variables checked by TestAccPreCheck. When unset, the fake
+// API is used unless HOSTED_ORGANIZATION_ID is set.
+const EnvVar = "ASTRO_FAKE_API"
+
+// Enabled returns whether the acceptance tests run against the fake API
+func Enabled() bool {
+	if value, ok := os.LookupEnv(EnvVar); ok {
+		return value == "true"
+	}
+	return os.Getenv("HOSTED_ORGANIZATION_ID") == ""
+}
+
+// Run runs the tests of a package. When Enabled, it starts and seeds a fake API and sets the environment variables
+// read by the acceptance tests to point at it. Unless TF_ACC is already set, it also enables the acceptance tests when
+// a Terraform CLI is available, so they run with a plain go test. It is meant to be called from TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		os.Exit(fakeapi.Run(m))
+//	}
+func Run(m *testing.M) int {
+	if !Enabled() {
+		return m.Run()
+	}
+	server := NewServer()
+	if err := server.Start(); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to start the fake Astro API: %v\n", err)
+		return 1
+	}
+	defer server.Close()
+
+	env, err := server.Seed()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to seed the fake Astro API: %v\n", err)
+		return 1
+	}
+	env["ASTRO_API_HOST"] = server.URL
+	env[EnvVar] = "true"
+	if _, ok := os.LookupEnv("TF_ACC"); !ok && hasTerraform() {
+		env["TF_ACC"] = "1"
+	}
+	for key, value := range env {
+		if err := os.Setenv(key, value); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to set %s: %v\n", key, err)
+			return 1
+		}
+	}
+	return m.Run()
+}
+
+// hasTerraform returns whether the acceptance tests can find a Terraform CLI without downloading it
+func hasTerraform() bool {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return true
+	}
+	_, err := exec.LookPath("terraform")
+	return err == nil
+}
+
+// TestServer is a seeded fake API served for a unit test, with API clients authenticated as the owner of its hosted
+// organization
+type TestServer struct {
+	*Server
+	// Env holds the environment variables of the seeded objects returned by Seed
+	Env              map[string]string
+	OrganizationId   string
+	PlatformClient   *platform.ClientWithResponses
+	PlatformV1Client *platform_v1.ClientWithResponses
+	IamClient        *iam.ClientWithResponses
+}
+
+// NewTestServer starts a seeded fake API that is closed when t ends. The requests go through wrap when it is given,
+// for example to inspect them before the fake API serves them.
+func NewTestServer(t testing.TB, wrap ...func(http.Handler) http.Handler) *TestServer {
+	t.Helper()
+	server := NewServer()
+	env, err := server.Seed()
+	if err != nil {
+		t.Fatalf("failed to seed the fake Astro API: %v", err)
+	}
+	var handler http.Handler = server
+	for _, w := range wrap {
+		handler = w(handler)
+	}
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	server.URL = srv.URL
+
+	token := env["HOSTED_ORGANIZATION_API_TOKEN"]
+	platformClient, err := platform.NewPlatformClient(srv.URL, token, "test")
+	if err != nil {
+		t.Fatalf("failed to create the platform client: %v", err)
+	}
+	platformV1Client, err := platform_v1.NewPlatformV1Client(srv.URL, token, "test")
+	if err != nil {
+		t.Fatalf("failed to create the platform v1 client: %v", err)
+	}
+	iamClient, err := iam.NewIamClient(srv.URL, token, "test")
+	if err != nil {
+		t.Fatalf("failed to create the IAM client: %v", err)
+	}
+	return &TestServer{
+		Server:           server,
+		Env:              env,
+		OrganizationId:   env["HOSTED_ORGANIZATION_ID"],
+		PlatformClient:   platformClient,
+		PlatformV1Client: platformV1Client,
+		IamClient:        iamClient,
+	}
+}
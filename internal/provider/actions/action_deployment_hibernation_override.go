@@ -0,0 +1,134 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &deploymentHibernationOverrideAction{}
+var _ action.ActionWithConfigure = &deploymentHibernationOverrideAction{}
+
+func NewDeploymentHibernationOverrideAction() action.Action {
+	return &deploymentHibernationOverrideAction{}
+}
+
+// deploymentHibernationOverrideAction hibernates or wakes up a development deployment immediately, regardless of its
+// hibernation schedules.
+type deploymentHibernationOverrideAction struct {
+	platformClient *platform.ClientWithResponses
+	organizationId string
+}
+
+func (a *deploymentHibernationOverrideAction) Metadata(
+	ctx context.Context,
+	req action.MetadataRequest,
+	resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_hibernation_override"
+}
+
+func (a *deploymentHibernationOverrideAction) Schema(
+	ctx context.Context,
+	req action.SchemaRequest,
+	resp *action.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Hibernates or wakes up a deployment in development mode immediately, regardless of its hibernation schedules. " +
+			"An `astro_deployment` resource that sets `scaling_spec.hibernation_spec.override` reverts the override on its next apply.",
+		Attributes: schemas.DeploymentHibernationOverrideActionSchemaAttributes(),
+	}
+}
+
+func (a *deploymentHibernationOverrideAction) Configure(
+	ctx context.Context,
+	req action.ConfigureRequest,
+	resp *action.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClients, ok := req.ProviderData.(models.ApiClientsModel)
+	if !ok {
+		utils.ActionApiClientConfigureError(ctx, req, resp)
+		return
+	}
+
+	a.platformClient = apiClients.PlatformClient
+	a.organizationId = apiClients.OrganizationId
+}
+
+func (a *deploymentHibernationOverrideAction) Invoke(
+	ctx context.Context,
+	req action.InvokeRequest,
+	resp *action.InvokeResponse,
+) {
+	var data models.DeploymentHibernationOverrideAction
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	overrideRequest := platform.UpdateDeploymentHibernationOverrideJSONRequestBody{
+		IsHibernating: data.IsHibernating.ValueBoolPointer(),
+	}
+	if !data.OverrideUntil.IsNull() {
+		overrideUntil, err := time.Parse(time.RFC3339, data.OverrideUntil.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("override_until"),
+				"Invalid override_until",
+				fmt.Sprintf("override_until must be an RFC 3339 timestamp, e.g. 2025-01-01T00:00:00Z, got error: %s", err),
+			)
+			return
+		}
+		overrideRequest.OverrideUntil = &overrideUntil
+	}
+
+	override, err := a.platformClient.UpdateDeploymentHibernationOverrideWithResponse(
+		ctx,
+		a.organizationId,
+		data.DeploymentId.ValueString(),
+		overrideRequest,
+	)
+	if err != nil {
+		tflog.Error(ctx, "failed to override deployment hibernation", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to override deployment hibernation, got error: %s", err),
+		)
+		return
+	}
+	_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, override.HTTPResponse, override.Body, override.JSON200, "override deployment hibernation")
+	if diagnostic != nil {
+		resp.Diagnostics.Append(diagnostic)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("overrode the hibernation of a deployment: %v", data.DeploymentId.ValueString()))
+
+	message := fmt.Sprintf("Woke up deployment %s", data.DeploymentId.ValueString())
+	if data.IsHibernating.ValueBool() {
+		message = fmt.Sprintf("Hibernated deployment %s", data.DeploymentId.ValueString())
+	}
+	if override.JSON200.OverrideUntil != nil {
+		message += fmt.Sprintf(" until %s", override.JSON200.OverrideUntil.Format(time.RFC3339))
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: message})
+}
@@ -0,0 +1,114 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &userInviteResendAction{}
+var _ action.ActionWithConfigure = &userInviteResendAction{}
+
+func NewUserInviteResendAction() action.Action {
+	return &userInviteResendAction{}
+}
+
+// userInviteResendAction sends a new invite to a user who has not joined the organization, e.g. because their invite
+// expired.
+type userInviteResendAction struct {
+	iamClient      *iam.ClientWithResponses
+	organizationId string
+}
+
+func (a *userInviteResendAction) Metadata(
+	ctx context.Context,
+	req action.MetadataRequest,
+	resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_user_invite_resend"
+}
+
+func (a *userInviteResendAction) Schema(
+	ctx context.Context,
+	req action.SchemaRequest,
+	resp *action.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Sends a new invite to a user who has not joined the Organization yet, e.g. because their invite expired. " +
+			"The `invite_id` and `expires_at` of an `astro_user_invite` resource for the user keep describing the previous invite.",
+		Attributes: schemas.UserInviteResendActionSchemaAttributes(),
+	}
+}
+
+func (a *userInviteResendAction) Configure(
+	ctx context.Context,
+	req action.ConfigureRequest,
+	resp *action.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClients, ok := req.ProviderData.(models.ApiClientsModel)
+	if !ok {
+		utils.ActionApiClientConfigureError(ctx, req, resp)
+		return
+	}
+
+	a.iamClient = apiClients.IamClient
+	a.organizationId = apiClients.OrganizationId
+}
+
+func (a *userInviteResendAction) Invoke(
+	ctx context.Context,
+	req action.InvokeRequest,
+	resp *action.InvokeResponse,
+) {
+	var data models.UserInviteResendAction
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userInvite, err := a.iamClient.CreateUserInviteWithResponse(
+		ctx,
+		a.organizationId,
+		iam.CreateUserInviteRequest{
+			InviteeEmail: data.Email.ValueString(),
+			Role:         iam.CreateUserInviteRequestRole(data.Role.ValueString()),
+		},
+	)
+	if err != nil {
+		tflog.Error(ctx, "failed to resend User Invite", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to resend User Invite, got error: %s", err),
+		)
+		return
+	}
+	_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, userInvite.HTTPResponse, userInvite.Body, userInvite.JSON200, "resend user invite")
+	if diagnostic != nil {
+		resp.Diagnostics.Append(diagnostic)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("resent a User Invite: %v", userInvite.JSON200.InviteId))
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Invited %s again, the invite expires at %s", data.Email.ValueString(), userInvite.JSON200.ExpiresAt.Format(time.RFC3339)),
+	})
+}
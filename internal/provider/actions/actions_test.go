@@ -0,0 +1,140 @@
+package actions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/fakeapi"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// invoke invokes the action returned by newAction with the configuration attributes set to config and the other
+// attributes null, and returns the progress messages it sent
+func invoke(
+	t *testing.T,
+	ctx context.Context,
+	apiClients models.ApiClientsModel,
+	newAction func() action.Action,
+	config map[string]tftypes.Value,
+) ([]string, diag.Diagnostics) {
+	t.Helper()
+	a := newAction()
+	var configureResp action.ConfigureResponse
+	a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: apiClients}, &configureResp)
+	require.False(t, configureResp.Diagnostics.HasError(), configureResp.Diagnostics)
+
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	var messages []string
+	resp := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			messages = append(messages, event.Message)
+		},
+	}
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: utils.TestObjectValue(ctx, schemaResp.Schema.Type(), config)},
+	}, &resp)
+	return messages, resp.Diagnostics
+}
+
+func TestUnit_Actions(t *testing.T) {
+	ctx := context.Background()
+	server := fakeapi.NewTestServer(t)
+	env, orgId, platformClient := server.Env, server.OrganizationId, server.PlatformClient
+	apiClients := models.ApiClientsModel{
+		OrganizationId: orgId,
+		PlatformClient: platformClient,
+		IamClient:      server.IamClient,
+	}
+
+	t.Run("overrides the hibernation of a development deployment", func(t *testing.T) {
+		var createRequest platform.CreateDeploymentRequest
+		require.NoError(t, createRequest.FromCreateStandardDeploymentRequest(platform.CreateStandardDeploymentRequest{
+			AstroRuntimeVersion: "12.9.0",
+			CloudProvider:       lo.ToPtr(platform.CreateStandardDeploymentRequestCloudProviderGCP),
+			Executor:            platform.CreateStandardDeploymentRequestExecutorCELERY,
+			IsDevelopmentMode:   lo.ToPtr(true),
+			Name:                "hibernation-override",
+			Region:              lo.ToPtr("us-east4"),
+			SchedulerSize:       platform.CreateStandardDeploymentRequestSchedulerSizeSMALL,
+			Type:                platform.CreateStandardDeploymentRequestTypeSTANDARD,
+			WorkspaceId:         env["HOSTED_WORKSPACE_ID"],
+		}))
+		created, err := platformClient.CreateDeploymentWithResponse(ctx, orgId, createRequest)
+		require.NoError(t, err)
+		require.NotNil(t, created.JSON200, string(created.Body))
+
+		overrideUntil := time.Now().Add(time.Hour).UTC().Truncate(time.Second).Format(time.RFC3339)
+		messages, diags := invoke(t, ctx, apiClients, NewDeploymentHibernationOverrideAction, map[string]tftypes.Value{
+			"deployment_id":  tftypes.NewValue(tftypes.String, created.JSON200.Id),
+			"is_hibernating": tftypes.NewValue(tftypes.Bool, true),
+			"override_until": tftypes.NewValue(tftypes.String, overrideUntil),
+		})
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, []string{"Hibernated deployment " + created.JSON200.Id + " until " + overrideUntil}, messages)
+
+		deployment, err := platformClient.GetDeploymentWithResponse(ctx, orgId, created.JSON200.Id)
+		require.NoError(t, err)
+		require.NotNil(t, deployment.JSON200)
+		assert.Equal(t, platform.DeploymentStatusHIBERNATING, deployment.JSON200.Status)
+
+		messages, diags = invoke(t, ctx, apiClients, NewDeploymentHibernationOverrideAction, map[string]tftypes.Value{
+			"deployment_id":  tftypes.NewValue(tftypes.String, created.JSON200.Id),
+			"is_hibernating": tftypes.NewValue(tftypes.Bool, false),
+		})
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, []string{"Woke up deployment " + created.JSON200.Id}, messages)
+	})
+
+	t.Run("reports a deployment that is not in development mode", func(t *testing.T) {
+		_, diags := invoke(t, ctx, apiClients, NewDeploymentHibernationOverrideAction, map[string]tftypes.Value{
+			"deployment_id":  tftypes.NewValue(tftypes.String, env["HOSTED_DEPLOYMENT_ID"]),
+			"is_hibernating": tftypes.NewValue(tftypes.Bool, true),
+		})
+		assert.True(t, diags.HasError())
+	})
+
+	t.Run("reports an invalid override_until", func(t *testing.T) {
+		_, diags := invoke(t, ctx, apiClients, NewDeploymentHibernationOverrideAction, map[string]tftypes.Value{
+			"deployment_id":  tftypes.NewValue(tftypes.String, env["HOSTED_DEPLOYMENT_ID"]),
+			"is_hibernating": tftypes.NewValue(tftypes.Bool, true),
+			"override_until": tftypes.NewValue(tftypes.String, "tomorrow"),
+		})
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Invalid override_until", diags.Errors()[0].Summary())
+	})
+
+	t.Run("resends a user invite", func(t *testing.T) {
+		for range 2 {
+			messages, diags := invoke(t, ctx, apiClients, NewUserInviteResendAction, map[string]tftypes.Value{
+				"email": tftypes.NewValue(tftypes.String, "invitee@astronomer.test"),
+				"role":  tftypes.NewValue(tftypes.String, string(iam.CreateUserInviteRequestRoleORGANIZATIONMEMBER)),
+			})
+			require.False(t, diags.HasError(), diags)
+			require.Len(t, messages, 1)
+			assert.Contains(t, messages[0], "Invited invitee@astronomer.test again")
+		}
+	})
+
+	t.Run("reports a user who already joined", func(t *testing.T) {
+		_, diags := invoke(t, ctx, apiClients, NewUserInviteResendAction, map[string]tftypes.Value{
+			"email": tftypes.NewValue(tftypes.String, "owner@astronomer.test"),
+			"role":  tftypes.NewValue(tftypes.String, string(iam.CreateUserInviteRequestRoleORGANIZATIONMEMBER)),
+		})
+		assert.True(t, diags.HasError())
+	})
+}
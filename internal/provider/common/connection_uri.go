@@ -0,0 +1,181 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// connectionUriExtraKey is the query parameter holding the whole extra JSON object of a connection URI
+const connectionUriExtraKey = "__extra__"
+
+// connectionUriScheme matches the schemes Airflow accepts as connection types. Underscores are not valid in a URI
+// scheme, connection types with underscores are written with dashes, e.g. google-cloud-platform://
+var connectionUriScheme = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9+.-]*$`)
+
+// ConnectionUri is an Airflow connection parsed from its URI representation, e.g. the value of an AIRFLOW_CONN_*
+// environment variable. Fields absent from the URI are nil.
+type ConnectionUri struct {
+	Type     string
+	Host     *string
+	Port     *int64
+	Schema   *string
+	Login    *string
+	Password *string
+	// Extra is the extra JSON object of the connection
+	Extra *string
+}
+
+// ParseConnectionUri parses a connection URI with the rules of Airflow's Connection:
+//   - the scheme is the connection type, with dashes replaced by underscores and postgresql normalized to postgres
+//   - a second scheme is the protocol of the host, e.g. http://https://example.com has the host https://example.com
+//   - the user info, host, port and the path without its leading slash are the login, password, host, port and schema,
+//     all URL-decoded
+//   - the __extra__ query parameter is the extra JSON object, otherwise the query parameters are the extra fields
+func ParseConnectionUri(uri string) (*ConnectionUri, error) {
+	schemes := strings.Count(uri, "://")
+	if schemes == 0 || schemes > 2 {
+		return nil, fmt.Errorf("expected <conn-type>://[login[:password]@]host[:port][/schema][?extra], got %d schemes", schemes)
+	}
+
+	connType, rest, _ := strings.Cut(uri, "://")
+	if !connectionUriScheme.MatchString(connType) {
+		return nil, fmt.Errorf("invalid connection type '%s', connection types with underscores are written with dashes, e.g. google-cloud-platform://", connType)
+	}
+	conn := &ConnectionUri{Type: normalizeConnectionType(connType)}
+
+	var protocol string
+	if schemes == 2 {
+		protocol, rest, _ = strings.Cut(rest, "://")
+		if strings.ContainsAny(protocol, "@:") || !connectionUriScheme.MatchString(protocol) {
+			return nil, fmt.Errorf("invalid host protocol '%s'", protocol)
+		}
+	}
+
+	netloc, rest := cutAny(rest, "/?#")
+	path, rest := cutAny(rest, "?#")
+	query, _ := cutAny(strings.TrimPrefix(rest, "?"), "#")
+	if !strings.HasPrefix(rest, "?") {
+		query = ""
+	}
+
+	// The host is after the last @, an unencoded @ may be part of the password
+	hostport := netloc
+	if at := strings.LastIndex(netloc, "@"); at >= 0 {
+		hostport = netloc[at+1:]
+		login, password, hasPassword := strings.Cut(netloc[:at], ":")
+		var err error
+		if conn.Login, err = unescapeNonEmpty(login, "login"); err != nil {
+			return nil, err
+		}
+		if hasPassword {
+			if conn.Password, err = unescapeNonEmpty(password, "password"); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	host, port, err := splitHostPort(hostport)
+	if err != nil {
+		return nil, err
+	}
+	if port != "" {
+		value, err := strconv.ParseInt(port, 10, 64)
+		if err != nil || value < 0 || value > 65535 {
+			return nil, fmt.Errorf("invalid port '%s', expected a number between 0 and 65535", port)
+		}
+		conn.Port = &value
+	}
+	// Hostnames are case-insensitive and lowercased, except those encoding a path, e.g. a socket directory
+	unescapedHost, err := url.PathUnescape(host)
+	if err != nil {
+		return nil, fmt.Errorf("invalid host '%s': %w", host, err)
+	}
+	if !strings.Contains(unescapedHost, "/") {
+		unescapedHost = strings.ToLower(unescapedHost)
+	}
+	if unescapedHost != "" {
+		if protocol != "" {
+			unescapedHost = protocol + "://" + unescapedHost
+		}
+		conn.Host = &unescapedHost
+	}
+
+	if conn.Schema, err = unescapeNonEmpty(strings.TrimPrefix(path, "/"), "schema"); err != nil {
+		return nil, err
+	}
+
+	if query != "" {
+		values, err := url.ParseQuery(query)
+		if err != nil {
+			return nil, fmt.Errorf("invalid extra query parameters: %w", err)
+		}
+		if extra, ok := values[connectionUriExtraKey]; ok {
+			var probe map[string]interface{}
+			if err := json.Unmarshal([]byte(extra[len(extra)-1]), &probe); err != nil {
+				return nil, fmt.Errorf("%s must be a JSON object: %w", connectionUriExtraKey, err)
+			}
+			conn.Extra = &extra[len(extra)-1]
+		} else {
+			// Like Airflow, a repeated parameter keeps its last value
+			fields := make(map[string]string, len(values))
+			for key, value := range values {
+				fields[key] = value[len(value)-1]
+			}
+			data, err := json.Marshal(fields)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode extra: %w", err)
+			}
+			extra := string(data)
+			conn.Extra = &extra
+		}
+	}
+
+	return conn, nil
+}
+
+func normalizeConnectionType(connType string) string {
+	if connType == "postgresql" {
+		return "postgres"
+	}
+	return strings.ReplaceAll(connType, "-", "_")
+}
+
+// cutAny slices s around the first of the chars, which stays in after
+func cutAny(s, chars string) (before, after string) {
+	if i := strings.IndexAny(s, chars); i >= 0 {
+		return s[:i], s[i:]
+	}
+	return s, ""
+}
+
+// splitHostPort splits host[:port] and [ipv6-host][:port]
+func splitHostPort(hostport string) (host, port string, err error) {
+	if strings.HasPrefix(hostport, "[") {
+		end := strings.Index(hostport, "]")
+		if end < 0 {
+			return "", "", fmt.Errorf("invalid host '%s', missing ']'", hostport)
+		}
+		host, rest := hostport[1:end], hostport[end+1:]
+		if rest != "" && !strings.HasPrefix(rest, ":") {
+			return "", "", fmt.Errorf("invalid host '%s'", hostport)
+		}
+		return host, strings.TrimPrefix(rest, ":"), nil
+	}
+	host, port, _ = strings.Cut(hostport, ":")
+	return host, port, nil
+}
+
+func unescapeNonEmpty(s, name string) (*string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	value, err := url.PathUnescape(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return &value, nil
+}
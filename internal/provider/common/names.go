@@ -0,0 +1,124 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+// NameLookupLimit is the number of objects listed to find an object by name, more than enough to find duplicate names
+const NameLookupLimit = 1000
+
+// UniqueByName returns the only object named name among objects, which are listed with describe when several have the
+// name. The names filters of the list APIs are not guaranteed to be exact matches, so the objects are filtered again.
+func UniqueByName[T any](
+	kind string,
+	name string,
+	location string,
+	objects []T,
+	objectName func(T) string,
+	describe func(T) string,
+) (T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var none T
+	matches := lo.Filter(objects, func(object T, _ int) bool {
+		return objectName(object) == name
+	})
+	switch len(matches) {
+	case 0:
+		diags.AddError(
+			fmt.Sprintf("%s not found", kind),
+			fmt.Sprintf("No %s named '%s' was found in %s", kind, name, location),
+		)
+		return none, diags
+	case 1:
+		return matches[0], diags
+	default:
+		diags.AddError(
+			fmt.Sprintf("Multiple %ss found", kind),
+			fmt.Sprintf("%d %ss named '%s' were found in %s: %s. Select one of them by ID instead", len(matches), kind, name, location, strings.Join(lo.Map(matches, func(object T, _ int) string {
+				return describe(object)
+			}), ", ")),
+		)
+		return none, diags
+	}
+}
+
+// FindWorkspaceIdByName returns the ID of the only Workspace named name
+func FindWorkspaceIdByName(
+	ctx context.Context,
+	platformClient platform.ClientWithResponsesInterface,
+	organizationId string,
+	name string,
+) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	workspacesResp, err := platformClient.ListWorkspacesWithResponse(ctx, organizationId, &platform.ListWorkspacesParams{
+		Names: &[]string{name},
+		Limit: lo.ToPtr(NameLookupLimit),
+	})
+	if err != nil {
+		tflog.Error(ctx, "failed to list workspaces", map[string]interface{}{"error": err})
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list workspaces, got error: %s", err),
+		)
+		return "", diags
+	}
+	_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, workspacesResp.HTTPResponse, workspacesResp.Body, workspacesResp.JSON200, "list workspaces")
+	if diagnostic != nil {
+		diags.Append(diagnostic)
+		return "", diags
+	}
+	workspace, diags := UniqueByName("Workspace", name, "the Organization", workspacesResp.JSON200.Workspaces,
+		func(workspace platform.Workspace) string { return workspace.Name },
+		func(workspace platform.Workspace) string { return workspace.Id },
+	)
+	return workspace.Id, diags
+}
+
+// FindDeploymentIdByName returns the ID of the only Deployment named name, in the Workspace if workspaceId is set
+func FindDeploymentIdByName(
+	ctx context.Context,
+	platformClient platform.ClientWithResponsesInterface,
+	organizationId string,
+	workspaceId string,
+	name string,
+) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	params := &platform.ListDeploymentsParams{
+		Names: &[]string{name},
+		Limit: lo.ToPtr(NameLookupLimit),
+	}
+	location := "the Organization"
+	if workspaceId != "" {
+		params.WorkspaceIds = &[]string{workspaceId}
+		location = fmt.Sprintf("Workspace '%s'", workspaceId)
+	}
+	deploymentsResp, err := platformClient.ListDeploymentsWithResponse(ctx, organizationId, params)
+	if err != nil {
+		tflog.Error(ctx, "failed to list deployments", map[string]interface{}{"error": err})
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list deployments, got error: %s", err),
+		)
+		return "", diags
+	}
+	_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, deploymentsResp.HTTPResponse, deploymentsResp.Body, deploymentsResp.JSON200, "list deployments")
+	if diagnostic != nil {
+		diags.Append(diagnostic)
+		return "", diags
+	}
+	deployment, diags := UniqueByName("Deployment", name, location, deploymentsResp.JSON200.Deployments,
+		func(deployment platform.Deployment) string { return deployment.Name },
+		func(deployment platform.Deployment) string {
+			return fmt.Sprintf("%s (Workspace %s)", deployment.Id, deployment.WorkspaceId)
+		},
+	)
+	return deployment.Id, diags
+}
@@ -95,10 +95,26 @@ func (d *apiTokensDataSource) Read(
 	if deploymentId != "" {
 		params.DeploymentId = &deploymentId
 	}
+	dagId := data.DagId.ValueString()
+	if dagId != "" {
+		params.DagId = &dagId
+	}
+	dagTags, diags := utils.TypesSetToStringSlice(ctx, data.DagTags)
+	if len(dagTags) > 0 {
+		params.DagTags = &dagTags
+	}
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
 	includeOnlyOrganizationTokens := data.IncludeOnlyOrganizationTokens.ValueBool()
 	if includeOnlyOrganizationTokens {
 		params.IncludeOnlyOrganizationTokens = &includeOnlyOrganizationTokens
 	}
+	kind := data.Kind.ValueString()
+	if kind != "" {
+		params.Kind = (*iam.ListApiTokensParamsKind)(&kind)
+	}
 
 	var apiTokens []iam.ApiToken
 	offset := 0
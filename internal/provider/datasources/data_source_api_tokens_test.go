@@ -3,6 +3,7 @@ package datasources_test
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/astronomer/terraform-provider-astro/internal/provider/common"
@@ -33,6 +34,20 @@ func TestAcc_DataSourceApiTokens(t *testing.T) {
 		},
 		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + fmt.Sprintf(`
+data astro_api_tokens "%v" {
+	dag_tags = ["production"]
+}`, tfVarName),
+				ExpectError: regexp.MustCompile(`Attribute "deployment_id" must be specified when "dag_tags" is specified`),
+			},
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + fmt.Sprintf(`
+data astro_api_tokens "%v" {
+	kind = "NOT_A_KIND"
+}`, tfVarName),
+				ExpectError: regexp.MustCompile("Attribute kind value must be one of"),
+			},
 			{
 				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + apiTokens(tfVarName),
 				Check: resource.ComposeTestCheckFunc(
@@ -77,6 +92,73 @@ func TestAcc_DataSourceApiTokens(t *testing.T) {
 	})
 }
 
+// TestAcc_DataSourceApiTokensDagFilters creates a deployment API token with roles on a DAG and on a DAG tag, then
+// checks that the API tokens data sources filtered on them return the token with its DAG roles
+func TestAcc_DataSourceApiTokensDagFilters(t *testing.T) {
+	namePrefix := utils.GenerateTestResourceName(10)
+	apiTokenName := fmt.Sprintf("%v_dag_roles", namePrefix)
+	deploymentId := os.Getenv("HOSTED_DEPLOYMENT_ID")
+
+	apiTokenConfig := fmt.Sprintf(`
+resource astro_api_token "dag_roles" {
+	name = "%v"
+	type = "DEPLOYMENT"
+	roles = [
+		{
+			role = "DEPLOYMENT_ADMIN"
+			entity_id = "%v"
+			entity_type = "DEPLOYMENT"
+		},
+		{
+			role = "DAG_VIEWER"
+			entity_id = "test_dag_id"
+			entity_type = "DAG"
+			deployment_id = "%v"
+		},
+		{
+			role = "DAG_AUTHOR"
+			entity_id = "production"
+			entity_type = "DAG_TAG"
+			deployment_id = "%v"
+		},
+	]
+	expiry_period_in_days = 30
+}`, apiTokenName, deploymentId, deploymentId, deploymentId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			astronomerprovider.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + apiTokenConfig +
+					apiTokensWithDagAccess("dag_id", deploymentId, `dag_id = "test_dag_id"`) +
+					apiTokensWithDagAccess("dag_tags", deploymentId, `dag_tags = ["production"]
+	kind = "STANDARD"`) +
+					apiTokensWithDagAccess("other_dag_id", deploymentId, fmt.Sprintf(`dag_id = "%v"`, namePrefix)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.astro_api_tokens.dag_id", "api_tokens.*", map[string]string{
+						"name":        apiTokenName,
+						"kind":        "STANDARD",
+						"dag_roles.#": "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.astro_api_tokens.dag_id", "api_tokens.*.dag_roles.*", map[string]string{
+						"dag_id":        "test_dag_id",
+						"deployment_id": deploymentId,
+						"role":          "DAG_VIEWER",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.astro_api_tokens.dag_tags", "api_tokens.*", map[string]string{
+						"name":        apiTokenName,
+						"dag_roles.#": "2",
+					}),
+					resource.TestCheckResourceAttr("data.astro_api_tokens.other_dag_id", "api_tokens.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func apiTokens(tfVarName string) string {
 	return fmt.Sprintf(`
 data astro_api_tokens "%v" {}`, tfVarName)
@@ -103,6 +185,17 @@ data astro_api_tokens "%v" {
 }`, tfVarName)
 }
 
+// apiTokensWithDagAccess returns an astro_api_tokens data source filtered with filter on the DAGs of the deployment,
+// read after the API token with DAG roles is created
+func apiTokensWithDagAccess(tfVarName, deploymentId, filter string) string {
+	return fmt.Sprintf(`
+data astro_api_tokens "%v" {
+	deployment_id = "%v"
+	%v
+	depends_on = [astro_api_token.dag_roles]
+}`, tfVarName, deploymentId, filter)
+}
+
 func checkApiTokens(tfVarName string, input checkApiTokensInput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		instanceState, numApiTokens, err := utils.GetDataSourcesLength(s, tfVarName, "api_tokens")
@@ -6,11 +6,13 @@ import (
 
 	"github.com/astronomer/terraform-provider-astro/internal/clients"
 	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/common"
 	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
 	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
 	"github.com/astronomer/terraform-provider-astro/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -81,10 +83,20 @@ func (d *deploymentDataSource) Read(
 		return
 	}
 
+	deploymentId := data.Id.ValueString()
+	if data.Id.IsNull() {
+		var diags diag.Diagnostics
+		deploymentId, diags = common.FindDeploymentIdByName(ctx, d.PlatformClient, d.OrganizationId, data.WorkspaceId.ValueString(), data.Name.ValueString())
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
 	deployment, err := d.PlatformClient.GetDeploymentWithResponse(
 		ctx,
 		d.OrganizationId,
-		data.Id.ValueString(),
+		deploymentId,
 	)
 	if err != nil {
 		tflog.Error(ctx, "failed to get deployment", map[string]interface{}{"error": err})
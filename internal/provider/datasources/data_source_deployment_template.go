@@ -0,0 +1,113 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &deploymentTemplateDataSource{}
+var _ datasource.DataSourceWithConfigure = &deploymentTemplateDataSource{}
+
+func NewDeploymentTemplateDataSource() datasource.DataSource {
+	return &deploymentTemplateDataSource{}
+}
+
+// deploymentTemplateDataSource copies the configuration of an existing deployment, so that it can be used to create
+// another astro_deployment.
+type deploymentTemplateDataSource struct {
+	PlatformClient platform.ClientWithResponsesInterface
+	OrganizationId string
+}
+
+func (d *deploymentTemplateDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_template"
+}
+
+func (d *deploymentTemplateDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Deployment template data source. Copies the configuration of an existing Deployment into attributes named after the `astro_deployment` arguments, e.g. to create a preview or staging copy of a production Deployment",
+		Attributes:          schemas.DeploymentTemplateDataSourceSchemaAttributes(),
+	}
+}
+
+func (d *deploymentTemplateDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClients, ok := req.ProviderData.(models.ApiClientsModel)
+	if !ok {
+		utils.DataSourceApiClientConfigureError(ctx, req, resp)
+		return
+	}
+
+	d.PlatformClient = apiClients.PlatformClient
+	d.OrganizationId = apiClients.OrganizationId
+}
+
+func (d *deploymentTemplateDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data models.DeploymentTemplateDataSource
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := d.PlatformClient.GetDeploymentWithResponse(
+		ctx,
+		d.OrganizationId,
+		data.SourceDeploymentId.ValueString(),
+	)
+	if err != nil {
+		tflog.Error(ctx, "failed to get deployment", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read source deployment, got error: %s", err),
+		)
+		return
+	}
+	_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, deployment.HTTPResponse, deployment.Body, deployment.JSON200, "read source deployment")
+	if diagnostic != nil {
+		resp.Diagnostics.Append(diagnostic)
+		return
+	}
+
+	// Populate the model with the response data
+	diags := data.ReadFromResponse(ctx, deployment.JSON200)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
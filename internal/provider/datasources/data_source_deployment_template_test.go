@@ -0,0 +1,82 @@
+package datasources_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	astronomerprovider "github.com/astronomer/terraform-provider-astro/internal/provider"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceDeploymentTemplate(t *testing.T) {
+	deploymentName := utils.GenerateTestResourceName(10)
+	sourceDeploymentId := os.Getenv("HOSTED_DEPLOYMENT_ID")
+	dataSourceVar := "data.astro_deployment_template.test_data_deployment_template"
+	sourceVar := "data.astro_deployment.test_data_source_deployment"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			astronomerprovider.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + deploymentTemplate(sourceDeploymentId, deploymentName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceVar, "source_deployment_id", sourceDeploymentId),
+					resource.TestCheckResourceAttrPair(dataSourceVar, "workspace_id", sourceVar, "workspace_id"),
+					resource.TestCheckResourceAttrPair(dataSourceVar, "type", sourceVar, "type"),
+					resource.TestCheckResourceAttrPair(dataSourceVar, "executor", sourceVar, "executor"),
+					resource.TestCheckResourceAttrPair(dataSourceVar, "original_astro_runtime_version", sourceVar, "astro_runtime_version"),
+					resource.TestCheckResourceAttrPair(dataSourceVar, "scheduler_size", sourceVar, "scheduler_size"),
+					resource.TestCheckResourceAttrPair(dataSourceVar, "worker_queues.#", sourceVar, "worker_queues.#"),
+					resource.TestCheckNoResourceAttr(dataSourceVar, "worker_queues.0.pod_cpu"),
+
+					// The clone is created from the template
+					resource.TestCheckResourceAttr("astro_deployment.test_deployment_clone", "name", deploymentName),
+					resource.TestCheckResourceAttrPair("astro_deployment.test_deployment_clone", "executor", sourceVar, "executor"),
+					resource.TestCheckResourceAttrPair("astro_deployment.test_deployment_clone", "scheduler_size", sourceVar, "scheduler_size"),
+					resource.TestCheckResourceAttrPair("astro_deployment.test_deployment_clone", "astro_runtime_version", sourceVar, "astro_runtime_version"),
+				),
+			},
+		},
+	})
+}
+
+func deploymentTemplate(sourceDeploymentId, name string) string {
+	return fmt.Sprintf(`
+data "astro_deployment" "test_data_source_deployment" {
+	id = "%v"
+}
+
+data "astro_deployment_template" "test_data_deployment_template" {
+	source_deployment_id = "%v"
+}
+
+resource "astro_deployment" "test_deployment_clone" {
+	name                           = "%v"
+	description                    = "%v"
+	workspace_id                   = data.astro_deployment_template.test_data_deployment_template.workspace_id
+	type                           = data.astro_deployment_template.test_data_deployment_template.type
+	cloud_provider                 = data.astro_deployment_template.test_data_deployment_template.cloud_provider
+	region                         = data.astro_deployment_template.test_data_deployment_template.region
+	original_astro_runtime_version = data.astro_deployment_template.test_data_deployment_template.original_astro_runtime_version
+	executor                       = data.astro_deployment_template.test_data_deployment_template.executor
+	contact_emails                 = data.astro_deployment_template.test_data_deployment_template.contact_emails
+	is_cicd_enforced               = data.astro_deployment_template.test_data_deployment_template.is_cicd_enforced
+	is_dag_deploy_enabled          = data.astro_deployment_template.test_data_deployment_template.is_dag_deploy_enabled
+	is_development_mode            = data.astro_deployment_template.test_data_deployment_template.is_development_mode
+	is_high_availability           = data.astro_deployment_template.test_data_deployment_template.is_high_availability
+	scheduler_size                 = data.astro_deployment_template.test_data_deployment_template.scheduler_size
+	resource_quota_cpu             = data.astro_deployment_template.test_data_deployment_template.resource_quota_cpu
+	resource_quota_memory          = data.astro_deployment_template.test_data_deployment_template.resource_quota_memory
+	default_task_pod_cpu           = data.astro_deployment_template.test_data_deployment_template.default_task_pod_cpu
+	default_task_pod_memory        = data.astro_deployment_template.test_data_deployment_template.default_task_pod_memory
+	environment_variables          = data.astro_deployment_template.test_data_deployment_template.environment_variables
+	worker_queues                  = data.astro_deployment_template.test_data_deployment_template.worker_queues
+	scaling_spec                   = data.astro_deployment_template.test_data_deployment_template.scaling_spec
+}
+`, sourceDeploymentId, sourceDeploymentId, name, utils.TestResourceDescription)
+}
@@ -2,6 +2,7 @@ package datasources_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/lucsky/cuid"
@@ -104,6 +105,114 @@ func TestAcc_DataSourceDeployments(t *testing.T) {
 	})
 }
 
+func TestAcc_DataSourceDeploymentByName(t *testing.T) {
+	deploymentName := utils.GenerateTestResourceName(10)
+	dataSourceName := "data.astro_deployment.test_data_deployment_by_name"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			astronomerprovider.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// id and name are mutually exclusive, workspace_id narrows down a name lookup
+			{
+				Config:      astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + deploymentByName(deploymentName, fmt.Sprintf(`id = "%v"`+"\n"+`name = "%v"`, cuid.New(), deploymentName)),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + deploymentByName(deploymentName, ""),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + deploymentByName(deploymentName, fmt.Sprintf(`id = "%v"`+"\n"+`workspace_id = "%v"`, cuid.New(), cuid.New())),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// The same name in two workspaces is found with workspace_id
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + deploymentByName(deploymentName, fmt.Sprintf(`name = "%v"`+"\n"+`workspace_id = astro_workspace.test_workspace2.id`, deploymentName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "astro_deployment.test_deployment2", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "workspace_id", "astro_workspace.test_workspace2", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", deploymentName),
+					resource.TestCheckResourceAttr(dataSourceName, "executor", "CELERY"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "webserver_url"),
+				),
+			},
+			{
+				Config:      astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + deploymentByName(deploymentName, fmt.Sprintf(`name = "%v"`, deploymentName)),
+				ExpectError: regexp.MustCompile(`Multiple Deployments found`),
+			},
+			{
+				Config:      astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + deploymentByName(deploymentName, fmt.Sprintf(`name = "%v-missing"`+"\n"+`workspace_id = astro_workspace.test_workspace1.id`, deploymentName)),
+				ExpectError: regexp.MustCompile(`Deployment not found`),
+			},
+		},
+	})
+}
+
+func deploymentByName(name, lookup string) string {
+	return fmt.Sprintf(`
+resource "astro_workspace" "test_workspace1" {
+	name = "%[1]v-1"
+	description = "%[2]v"
+	cicd_enforced_default = true
+}
+
+resource "astro_workspace" "test_workspace2" {
+	name = "%[1]v-2"
+	description = "%[2]v"
+	cicd_enforced_default = true
+}
+
+data "astro_deployment_options" "deployment_options" {}
+
+%[3]v
+
+%[4]v
+
+data astro_deployment "test_data_deployment_by_name" {
+	depends_on = [astro_deployment.test_deployment1, astro_deployment.test_deployment2]
+	%[5]v
+}`, name, utils.TestResourceDescription,
+		standardCeleryDeployment("test_deployment1", name, "astro_workspace.test_workspace1.id"),
+		standardCeleryDeployment("test_deployment2", name, "astro_workspace.test_workspace2.id"),
+		lookup)
+}
+
+func standardCeleryDeployment(tfVarName, name, workspaceId string) string {
+	return fmt.Sprintf(`
+resource "astro_deployment" "%v" {
+	original_astro_runtime_version = tolist(data.astro_deployment_options.deployment_options.runtime_releases)[0].version
+	name = "%v"
+	description = "%v"
+	type = "STANDARD"
+	region = "us-west-2"
+	cloud_provider = "AWS"
+	contact_emails = []
+	default_task_pod_cpu = "0.25"
+	default_task_pod_memory = "0.5Gi"
+	executor = "CELERY"
+	is_cicd_enforced = true
+	is_dag_deploy_enabled = true
+	is_development_mode = false
+	is_high_availability = false
+	resource_quota_cpu = "10"
+	resource_quota_memory = "20Gi"
+	scheduler_size = "SMALL"
+	workspace_id = %v
+	environment_variables = []
+	worker_queues = [{
+		name = "default"
+		is_default = true
+		astro_machine = "A5"
+		max_worker_count = 10
+		min_worker_count = 0
+		worker_concurrency = 1
+	}]
+}`, tfVarName, name, utils.TestResourceDescription, workspaceId)
+}
+
 func hybridDeployments() string {
 	return `
 data astro_deployments "test_data_deployments_hybrid_no_filters" {}`
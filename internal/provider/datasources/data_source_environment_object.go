@@ -73,7 +73,7 @@ func (d *environmentObjectDataSource) Read(
 	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
-	var data models.EnvironmentObject
+	var data models.EnvironmentObjectDataSource
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -98,7 +98,7 @@ func (d *environmentObjectDataSource) Read(
 	}
 
 	// Populate the model with the response data
-	diags := data.ReadFromResponse(ctx, environmentObject.JSON200, nil)
+	diags := data.ReadFromResponse(ctx, environmentObject.JSON200)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -96,6 +96,18 @@ func (d *usersDataSource) Read(
 	if deploymentId != "" {
 		params.DeploymentId = &deploymentId
 	}
+	dagId := data.DagId.ValueString()
+	if dagId != "" {
+		params.DagId = &dagId
+	}
+	dagTags, diags := utils.TypesSetToStringSlice(ctx, data.DagTags)
+	if len(dagTags) > 0 {
+		params.DagTags = &dagTags
+	}
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
 
 	var users []iam.User
 	offset := 0
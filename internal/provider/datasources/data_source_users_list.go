@@ -105,6 +105,18 @@ func (d *usersListDataSource) Read(
 	if deploymentId != "" {
 		params.DeploymentId = &deploymentId
 	}
+	dagId := data.DagId.ValueString()
+	if dagId != "" {
+		params.DagId = &dagId
+	}
+	dagTags, diags := utils.TypesSetToStringSlice(ctx, data.DagTags)
+	if len(dagTags) > 0 {
+		params.DagTags = &dagTags
+	}
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
 
 	var users []iam.User
 	offset := 0
@@ -3,6 +3,7 @@ package datasources_test
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	astronomerprovider "github.com/astronomer/terraform-provider-astro/internal/provider"
@@ -22,6 +23,14 @@ func TestAcc_DataSourceUsers(t *testing.T) {
 		},
 		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// DAG filters select DAGs of a deployment
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + fmt.Sprintf(`
+data astro_users "%v" {
+dag_id = "test_dag_id"
+}`, tfVarName),
+				ExpectError: regexp.MustCompile(`Attribute "deployment_id" must be specified when "dag_id" is specified`),
+			},
 			{
 				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + users(tfVarName),
 				Check: resource.ComposeTestCheckFunc(
@@ -44,6 +53,66 @@ func TestAcc_DataSourceUsers(t *testing.T) {
 	})
 }
 
+// TestAcc_DataSourceUsersDagFilters gives the test user roles on a DAG and on a DAG tag of the deployment, then checks
+// that the users data sources filtered on them return the user with its DAG roles
+func TestAcc_DataSourceUsersDagFilters(t *testing.T) {
+	userId := os.Getenv("HOSTED_DUMMY_USER_ID")
+	workspaceId := os.Getenv("HOSTED_WORKSPACE_ID")
+	deploymentId := os.Getenv("HOSTED_DEPLOYMENT_ID")
+
+	userRolesConfig := fmt.Sprintf(`
+resource "astro_user_roles" "dag_roles" {
+	user_id = "%v"
+	organization_role = "ORGANIZATION_OWNER"
+	workspace_roles = [{
+		workspace_id = "%v"
+		role = "WORKSPACE_OWNER"
+	}]
+	deployment_roles = [{
+		deployment_id = "%v"
+		role = "DEPLOYMENT_ACCESSOR"
+	}]
+	dag_roles = [
+		{
+			deployment_id = "%v"
+			dag_id = "test_dag_id"
+			role = "DAG_VIEWER"
+		},
+		{
+			deployment_id = "%v"
+			tag = "production"
+			role = "DAG_AUTHOR"
+		},
+	]
+}`, userId, workspaceId, deploymentId, deploymentId, deploymentId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			astronomerprovider.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + userRolesConfig +
+					usersWithDagAccess("dag_id", deploymentId, `dag_id = "test_dag_id"`) +
+					usersWithDagAccess("dag_tags", deploymentId, `dag_tags = ["production", "staging"]`) +
+					usersWithDagAccess("other_dag_id", deploymentId, fmt.Sprintf(`dag_id = "%v"`, utils.GenerateTestResourceName(10))),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.astro_users.dag_id", "users.*", map[string]string{
+						"id":          userId,
+						"dag_roles.#": "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.astro_users.dag_tags", "users.*", map[string]string{
+						"id":          userId,
+						"dag_roles.#": "2",
+					}),
+					resource.TestCheckResourceAttr("data.astro_users.other_dag_id", "users.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func users(tfVarName string) string {
 	return fmt.Sprintf(`
 data astro_users "%v" {}`, tfVarName)
@@ -63,6 +132,17 @@ deployment_id = "%v"
 }`, tfVarName, deploymentId)
 }
 
+// usersWithDagAccess returns an astro_users data source filtered with filter on the DAGs of the deployment, read after
+// the DAG roles of the test user are updated
+func usersWithDagAccess(tfVarName, deploymentId, filter string) string {
+	return fmt.Sprintf(`
+data astro_users "%v" {
+deployment_id = "%v"
+%v
+depends_on = [astro_user_roles.dag_roles]
+}`, tfVarName, deploymentId, filter)
+}
+
 func checkUsers(tfVarName string, filterWorkspaceId bool, filterDeploymentId bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		instanceState, numUsers, err := utils.GetDataSourcesLength(s, tfVarName, "users")
@@ -2,6 +2,7 @@ package datasources_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	astronomerprovider "github.com/astronomer/terraform-provider-astro/internal/provider"
@@ -64,6 +65,67 @@ func TestAcc_DataSourceWorkspaces(t *testing.T) {
 	})
 }
 
+func TestAcc_DataSourceWorkspaceByName(t *testing.T) {
+	workspaceName := utils.GenerateTestResourceName(10)
+	dataSourceName := "data.astro_workspace.test_data_workspace_by_name"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			astronomerprovider.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + workspaceByName(workspaceName, ""),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + workspaceByName(workspaceName, fmt.Sprintf(`name = "%v-1"`, workspaceName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "astro_workspace.test_workspace1", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", fmt.Sprintf("%v-1", workspaceName)),
+					resource.TestCheckResourceAttr(dataSourceName, "cicd_enforced_default", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "created_at"),
+				),
+			},
+			{
+				Config:      astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + workspaceByName(workspaceName, fmt.Sprintf(`name = "%v-dup"`, workspaceName)),
+				ExpectError: regexp.MustCompile(`Multiple Workspaces found`),
+			},
+			{
+				Config:      astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + workspaceByName(workspaceName, fmt.Sprintf(`name = "%v-missing"`, workspaceName)),
+				ExpectError: regexp.MustCompile(`Workspace not found`),
+			},
+		},
+	})
+}
+
+func workspaceByName(name, lookup string) string {
+	return fmt.Sprintf(`
+resource "astro_workspace" "test_workspace1" {
+	name = "%[1]v-1"
+	description = "%[2]v"
+	cicd_enforced_default = true
+}
+
+resource "astro_workspace" "test_workspace_dup1" {
+	name = "%[1]v-dup"
+	description = "%[2]v"
+	cicd_enforced_default = false
+}
+
+resource "astro_workspace" "test_workspace_dup2" {
+	name = "%[1]v-dup"
+	description = "%[2]v"
+	cicd_enforced_default = false
+}
+
+data astro_workspace "test_data_workspace_by_name" {
+	depends_on = [astro_workspace.test_workspace1, astro_workspace.test_workspace_dup1, astro_workspace.test_workspace_dup2]
+	%[3]v
+}`, name, utils.TestResourceDescription, lookup)
+}
+
 func workspaces(name, filter string) string {
 	return fmt.Sprintf(`
 resource "astro_workspace" "test_workspace1" {
@@ -1,3 +1,5 @@
 package provider
 
 var ProviderSchema = providerSchema
+
+var ResolveToken = resolveToken
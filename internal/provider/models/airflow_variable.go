@@ -0,0 +1,82 @@
+package models
+
+import (
+	"context"
+
+	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AirflowVariable describes the astro_airflow_variable resource data model, an
+// environment object with object_type=AIRFLOW_VARIABLE. The resource converts it
+// to and from EnvironmentObject to share the environment object API calls.
+type AirflowVariable struct {
+	Id                  types.String `tfsdk:"id"`
+	Key                 types.String `tfsdk:"key"`
+	Description         types.String `tfsdk:"description"`
+	Scope               types.String `tfsdk:"scope"`
+	ScopeEntityId       types.String `tfsdk:"scope_entity_id"`
+	SourceScope         types.String `tfsdk:"source_scope"`
+	SourceScopeEntityId types.String `tfsdk:"source_scope_entity_id"`
+	AutoLinkDeployments types.Bool   `tfsdk:"auto_link_deployments"`
+	Value               types.String `tfsdk:"value"`
+	IsSecret            types.Bool   `tfsdk:"is_secret"`
+	Links               types.Set    `tfsdk:"links"`
+	ExcludeLinks        types.Set    `tfsdk:"exclude_links"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	UpdatedAt           types.String `tfsdk:"updated_at"`
+	CreatedBy           types.Object `tfsdk:"created_by"`
+	UpdatedBy           types.Object `tfsdk:"updated_by"`
+}
+
+// EnvironmentObject converts the Airflow variable to an EnvironmentObject
+func (data *AirflowVariable) EnvironmentObject(ctx context.Context) (EnvironmentObject, diag.Diagnostics) {
+	obj := EnvironmentObject{
+		Id:                  data.Id,
+		ObjectKey:           data.Key,
+		ObjectType:          types.StringValue(string(platform_v1.CreateEnvironmentObjectRequestObjectTypeAIRFLOWVARIABLE)),
+		Description:         data.Description,
+		Scope:               data.Scope,
+		ScopeEntityId:       data.ScopeEntityId,
+		SourceScope:         data.SourceScope,
+		SourceScopeEntityId: data.SourceScopeEntityId,
+		AutoLinkDeployments: data.AutoLinkDeployments,
+		ExcludeLinks:        data.ExcludeLinks,
+		CreatedAt:           data.CreatedAt,
+		UpdatedAt:           data.UpdatedAt,
+		CreatedBy:           data.CreatedBy,
+		UpdatedBy:           data.UpdatedBy,
+	}
+	obj.nullAllTypeSpecific()
+	obj.Value = data.Value
+	obj.IsSecret = data.IsSecret
+
+	var diags diag.Diagnostics
+	obj.Links, diags = ProjectEnvironmentObjectLinks(ctx, data.Links, schemas.EnvironmentObjectOverridesAttributeTypes())
+	return obj, diags
+}
+
+// ReadFromEnvironmentObject sets the Airflow variable from an EnvironmentObject with object_type=AIRFLOW_VARIABLE
+func (data *AirflowVariable) ReadFromEnvironmentObject(ctx context.Context, obj *EnvironmentObject) diag.Diagnostics {
+	data.Id = obj.Id
+	data.Key = obj.ObjectKey
+	data.Description = obj.Description
+	data.Scope = obj.Scope
+	data.ScopeEntityId = obj.ScopeEntityId
+	data.SourceScope = obj.SourceScope
+	data.SourceScopeEntityId = obj.SourceScopeEntityId
+	data.AutoLinkDeployments = obj.AutoLinkDeployments
+	data.Value = obj.Value
+	data.IsSecret = obj.IsSecret
+	data.ExcludeLinks = obj.ExcludeLinks
+	data.CreatedAt = obj.CreatedAt
+	data.UpdatedAt = obj.UpdatedAt
+	data.CreatedBy = obj.CreatedBy
+	data.UpdatedBy = obj.UpdatedBy
+
+	var diags diag.Diagnostics
+	data.Links, diags = ProjectEnvironmentObjectLinks(ctx, obj.Links, schemas.AirflowVariableOverridesAttributeTypes())
+	return diags
+}
@@ -46,3 +46,12 @@ func (data *Alerts) ReadFromResponse(
 
 	return nil
 }
+
+// AlertsListConfig describes the alert list resource configuration.
+type AlertsListConfig struct {
+	AlertIds      []string     `tfsdk:"alert_ids"`
+	AlertTypes    []string     `tfsdk:"alert_types"`
+	EntityType    types.String `tfsdk:"entity_type"`
+	DeploymentIds []string     `tfsdk:"deployment_ids"`
+	WorkspaceIds  []string     `tfsdk:"workspace_ids"`
+}
@@ -6,6 +6,9 @@ import "github.com/hashicorp/terraform-plugin-framework/types"
 // model. The resource authoritatively manages the organization's full IP access list as a single
 // set of CIDR ranges.
 type AllowedIpAddressRangesResource struct {
-	Id              types.String `tfsdk:"id"`
-	IpAddressRanges types.Set    `tfsdk:"ip_address_ranges"`
+	Id                         types.String `tfsdk:"id"`
+	IpAddressRanges            types.Set    `tfsdk:"ip_address_ranges"`
+	IpAddressRangeDescriptions types.Map    `tfsdk:"ip_address_range_descriptions"`
+	IpAddressRangeIds          types.Map    `tfsdk:"ip_address_range_ids"`
+	AllowSelfLockout           types.Bool   `tfsdk:"allow_self_lockout"`
 }
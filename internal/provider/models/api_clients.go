@@ -1,6 +1,8 @@
 package models
 
 import (
+	"net/http"
+
 	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
 	"github.com/astronomer/terraform-provider-astro/internal/clients/labs"
 	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
@@ -13,4 +15,11 @@ type ApiClientsModel struct {
 	PlatformV1Client *platform_v1.ClientWithResponses
 	IamClient        *iam.ClientWithResponses
 	LabsClient       *labs.ClientWithResponses
+
+	// HttpClient is the HTTP client shared by the API clients, with the CA, proxy, TLS and request limits of the
+	// provider, for the requests the provider makes outside of the Astro API
+	HttpClient *http.Client
+
+	// DeploymentDefaults are the provider-level defaults applied to astro_deployment resources
+	DeploymentDefaults DeploymentDefaults
 }
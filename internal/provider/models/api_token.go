@@ -26,6 +26,8 @@ type ApiTokenDataSource struct {
 	ExpiryPeriodInDays types.Int64  `tfsdk:"expiry_period_in_days"`
 	LastUsedAt         types.String `tfsdk:"last_used_at"`
 	Roles              types.Set    `tfsdk:"roles"`
+	Kind               types.String `tfsdk:"kind"`
+	DagRoles           types.Set    `tfsdk:"dag_roles"`
 }
 
 // ApiTokenResource defines the resource implementation.
@@ -45,6 +47,7 @@ type ApiTokenResource struct {
 	LastUsedAt         types.String `tfsdk:"last_used_at"`
 	Roles              types.Set    `tfsdk:"roles"`
 	Token              types.String `tfsdk:"token"`
+	RotationTrigger    types.String `tfsdk:"rotation_trigger"`
 }
 
 func (data *ApiTokenDataSource) ReadFromResponse(ctx context.Context, apiToken *iam.ApiToken) diag.Diagnostics {
@@ -84,6 +87,12 @@ func (data *ApiTokenDataSource) ReadFromResponse(ctx context.Context, apiToken *
 	if diags.HasError() {
 		return diags
 	}
+	data.Kind = types.StringValue(string(apiToken.Kind))
+	dagRoles := ApiTokenDagRoles(apiToken.Roles)
+	data.DagRoles, diags = utils.ObjectSet(ctx, &dagRoles, schemas.DagRoleAttributeTypes(), DagRoleTypesObject)
+	if diags.HasError() {
+		return diags
+	}
 	return diags
 }
 
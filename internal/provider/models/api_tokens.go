@@ -16,6 +16,9 @@ type ApiTokens struct {
 	WorkspaceId                   types.String `tfsdk:"workspace_id"`                     // query parameter
 	DeploymentId                  types.String `tfsdk:"deployment_id"`                    // query parameter
 	IncludeOnlyOrganizationTokens types.Bool   `tfsdk:"include_only_organization_tokens"` // query parameter
+	DagId                         types.String `tfsdk:"dag_id"`                           // query parameter
+	DagTags                       types.Set    `tfsdk:"dag_tags"`                         // query parameter
+	Kind                          types.String `tfsdk:"kind"`                             // query parameter
 }
 
 func (data *ApiTokens) ReadFromResponse(ctx context.Context, apiTokens []iam.ApiToken) diag.Diagnostics {
@@ -41,3 +44,13 @@ func (data *ApiTokens) ReadFromResponse(ctx context.Context, apiTokens []iam.Api
 
 	return nil
 }
+
+// ApiTokensListConfig describes the API token list resource configuration.
+type ApiTokensListConfig struct {
+	WorkspaceId                   types.String `tfsdk:"workspace_id"`
+	DeploymentId                  types.String `tfsdk:"deployment_id"`
+	IncludeOnlyOrganizationTokens types.Bool   `tfsdk:"include_only_organization_tokens"`
+	DagId                         types.String `tfsdk:"dag_id"`
+	DagTags                       []string     `tfsdk:"dag_tags"`
+	Kind                          types.String `tfsdk:"kind"`
+}
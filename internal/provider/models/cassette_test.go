@@ -0,0 +1,197 @@
+package models_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+)
+
+// The tests in this file replay the cassettes in testdata/cassettes and compare the models read from them with the
+// snapshots recorded next to them, so a change to a ReadFromResponse method that alters the state read from a recorded
+// response shows up as a snapshot diff. The committed cassettes are fake API fixtures recorded from internal/fakeapi,
+// not from an organization, so they do not check the models against the responses of the Astro API until they are
+// recorded again from an organization.
+//
+// To record the cassettes and snapshots from an organization, run:
+//
+//	ASTRO_HTTP_CASSETTE_MODE=record go test ./internal/provider/models -run Cassette
+//
+// with ASTRO_API_HOST, HOSTED_ORGANIZATION_ID, HOSTED_ORGANIZATION_API_TOKEN and the IDs read by the tests set as for
+// the acceptance tests. The API token and other secrets are redacted from the recorded cassettes.
+
+// cassette is a replayed, or recorded, cassette and the API clients using it
+type cassette struct {
+	name           string
+	recording      bool
+	organizationId string
+	variables      map[string]string
+	platformClient *platform.ClientWithResponses
+	iamClient      *iam.ClientWithResponses
+}
+
+func useCassette(t *testing.T, name string, variables ...string) cassette {
+	t.Helper()
+	path := filepath.Join("testdata", "cassettes", name+".json")
+	recording := os.Getenv(clients.CassetteModeEnvVar) == string(clients.CassetteModeRecord)
+	host, token := "https://api.astronomer.io", "replay"
+	mode := clients.CassetteModeReplay
+	if recording {
+		host, token = os.Getenv("ASTRO_API_HOST"), os.Getenv("HOSTED_ORGANIZATION_API_TOKEN")
+		mode = clients.CassetteModeRecord
+	}
+
+	if recording {
+		// Recording transports append to an existing cassette
+		require.NoError(t, os.RemoveAll(path))
+	}
+	transport, err := clients.NewCassetteTransport(http.DefaultTransport, path, mode)
+	require.NoError(t, err)
+	if recording {
+		for _, key := range append([]string{"HOSTED_ORGANIZATION_ID"}, variables...) {
+			value := os.Getenv(key)
+			if value == "" || host == "" || token == "" {
+				t.Skipf("ASTRO_API_HOST, HOSTED_ORGANIZATION_API_TOKEN and %s must be set to record %s", key, name)
+			}
+			transport.SetVariable(key, value)
+		}
+		t.Cleanup(func() {
+			assert.NoError(t, transport.Save())
+		})
+	}
+
+	values := map[string]string{}
+	for _, key := range variables {
+		values[key] = transport.Variable(key)
+	}
+	httpClient := &http.Client{Transport: transport}
+	platformClient, err := platform.NewPlatformClient(host, token, "test", platform.WithHTTPClient(httpClient))
+	require.NoError(t, err)
+	iamClient, err := iam.NewIamClient(host, token, "test", iam.WithHTTPClient(httpClient))
+	require.NoError(t, err)
+	return cassette{
+		name:           name,
+		recording:      recording,
+		organizationId: transport.Variable("HOSTED_ORGANIZATION_ID"),
+		variables:      values,
+		platformClient: platformClient,
+		iamClient:      iamClient,
+	}
+}
+
+// assertSnapshot compares the attributes of model with the snapshot recorded with the cassette
+func (c cassette) assertSnapshot(t *testing.T, model any) {
+	t.Helper()
+	snapshot := map[string]string{}
+	value := reflect.ValueOf(model).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		tag := value.Type().Field(i).Tag.Get("tfsdk")
+		if attribute, ok := value.Field(i).Interface().(attr.Value); ok && tag != "" {
+			snapshot[tag] = attribute.String()
+		}
+	}
+
+	path := filepath.Join("testdata", "cassettes", c.name+".snapshot.json")
+	if c.recording {
+		var data bytes.Buffer
+		encoder := json.NewEncoder(&data)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		require.NoError(t, encoder.Encode(snapshot))
+		require.NoError(t, os.WriteFile(path, data.Bytes(), 0o644))
+		return
+	}
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var expected map[string]string
+	require.NoError(t, json.Unmarshal(data, &expected))
+	assert.Equal(t, expected, snapshot)
+}
+
+func TestUnit_DeploymentReadFromResponse_Cassette(t *testing.T) {
+	c := useCassette(t, "deployment", "HOSTED_DEPLOYMENT_ID")
+	resp, err := c.platformClient.GetDeploymentWithResponse(context.Background(), c.organizationId, c.variables["HOSTED_DEPLOYMENT_ID"])
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+
+	var data models.DeploymentDataSource
+	diags := data.ReadFromResponse(context.Background(), resp.JSON200)
+	require.False(t, diags.HasError(), diags)
+	c.assertSnapshot(t, &data)
+}
+
+func TestUnit_ClusterReadFromResponse_Cassette(t *testing.T) {
+	c := useCassette(t, "cluster", "HOSTED_DEDICATED_CLUSTER_ID")
+	resp, err := c.platformClient.GetClusterWithResponse(context.Background(), c.organizationId, c.variables["HOSTED_DEDICATED_CLUSTER_ID"])
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+
+	var data models.ClusterDataSource
+	diags := data.ReadFromResponse(context.Background(), resp.JSON200)
+	require.False(t, diags.HasError(), diags)
+	c.assertSnapshot(t, &data)
+}
+
+func TestUnit_WorkspaceReadFromResponse_Cassette(t *testing.T) {
+	c := useCassette(t, "workspace", "HOSTED_WORKSPACE_ID")
+	resp, err := c.platformClient.GetWorkspaceWithResponse(context.Background(), c.organizationId, c.variables["HOSTED_WORKSPACE_ID"])
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+
+	var data models.Workspace
+	diags := data.ReadFromResponse(context.Background(), resp.JSON200)
+	require.False(t, diags.HasError(), diags)
+	c.assertSnapshot(t, &data)
+}
+
+func TestUnit_TeamReadFromResponse_Cassette(t *testing.T) {
+	c := useCassette(t, "team", "HOSTED_TEAM_ID")
+	team, err := c.iamClient.GetTeamWithResponse(context.Background(), c.organizationId, c.variables["HOSTED_TEAM_ID"])
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, team.StatusCode(), string(team.Body))
+	members, err := c.iamClient.ListTeamMembersWithResponse(context.Background(), c.organizationId, c.variables["HOSTED_TEAM_ID"], nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, members.StatusCode(), string(members.Body))
+
+	var data models.TeamDataSource
+	diags := data.ReadFromResponse(context.Background(), team.JSON200, &members.JSON200.TeamMembers)
+	require.False(t, diags.HasError(), diags)
+	c.assertSnapshot(t, &data)
+}
+
+func TestUnit_UserReadFromResponse_Cassette(t *testing.T) {
+	c := useCassette(t, "user", "HOSTED_USER_ID")
+	resp, err := c.iamClient.GetUserWithResponse(context.Background(), c.organizationId, c.variables["HOSTED_USER_ID"])
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+
+	var data models.User
+	diags := data.ReadFromResponse(context.Background(), resp.JSON200)
+	require.False(t, diags.HasError(), diags)
+	c.assertSnapshot(t, &data)
+}
+
+func TestUnit_ApiTokenReadFromResponse_Cassette(t *testing.T) {
+	c := useCassette(t, "api_token", "HOSTED_API_TOKEN_ID")
+	resp, err := c.iamClient.GetApiTokenWithResponse(context.Background(), c.organizationId, c.variables["HOSTED_API_TOKEN_ID"])
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+
+	var data models.ApiTokenDataSource
+	diags := data.ReadFromResponse(context.Background(), resp.JSON200)
+	require.False(t, diags.HasError(), diags)
+	c.assertSnapshot(t, &data)
+}
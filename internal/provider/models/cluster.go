@@ -45,6 +45,7 @@ type ClusterResource struct {
 	DrPodSubnetRange             types.String   `tfsdk:"dr_pod_subnet_range"`
 	DrServicePeeringRange        types.String   `tfsdk:"dr_service_peering_range"`
 	DrServiceSubnetRange         types.String   `tfsdk:"dr_service_subnet_range"`
+	DeletionProtection           types.Bool     `tfsdk:"deletion_protection"`
 }
 
 // ClusterDataSource describes the data source data model.
@@ -182,6 +183,11 @@ func (data *ClusterResource) ReadFromResponse(
 		data.DrServiceSubnetRange = types.StringNull()
 	}
 
+	// DeletionProtection is a Terraform-only setting and is never returned by the API
+	if data.DeletionProtection.IsNull() || data.DeletionProtection.IsUnknown() {
+		data.DeletionProtection = types.BoolValue(false)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+// ClusterFailoverResource describes the resource data model.
+type ClusterFailoverResource struct {
+	Id           types.String `tfsdk:"id"`
+	ClusterId    types.String `tfsdk:"cluster_id"`
+	IsFailedOver types.Bool   `tfsdk:"is_failed_over"`
+	Region       types.String `tfsdk:"region"`
+	DrRegion     types.String `tfsdk:"dr_region"`
+	ActiveRegion types.String `tfsdk:"active_region"`
+}
+
+func (data *ClusterFailoverResource) ReadFromResponse(cluster *platform.Cluster) {
+	data.Id = types.StringValue(cluster.Id)
+	data.ClusterId = types.StringValue(cluster.Id)
+	data.IsFailedOver = types.BoolValue(lo.FromPtr(cluster.IsFailedOver))
+	data.Region = types.StringValue(cluster.Region)
+	data.DrRegion = types.StringValue(cluster.DrRegion)
+	if lo.FromPtr(cluster.IsFailedOver) {
+		data.ActiveRegion = types.StringValue(cluster.DrRegion)
+	} else {
+		data.ActiveRegion = types.StringValue(cluster.Region)
+	}
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ClusterNodePoolResource describes the resource data model.
+type ClusterNodePoolResource struct {
+	Id                     types.String `tfsdk:"id"`
+	ClusterId              types.String `tfsdk:"cluster_id"`
+	Name                   types.String `tfsdk:"name"`
+	NodeInstanceType       types.String `tfsdk:"node_instance_type"`
+	MaxNodeCount           types.Int64  `tfsdk:"max_node_count"`
+	IsDefault              types.Bool   `tfsdk:"is_default"`
+	CloudProvider          types.String `tfsdk:"cloud_provider"`
+	SupportedAstroMachines types.Set    `tfsdk:"supported_astro_machines"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	UpdatedAt              types.String `tfsdk:"updated_at"`
+}
+
+func (data *ClusterNodePoolResource) ReadFromResponse(nodePool *platform.NodePool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	data.Id = types.StringValue(nodePool.Id)
+	data.ClusterId = types.StringValue(nodePool.ClusterId)
+	data.Name = types.StringValue(nodePool.Name)
+	data.NodeInstanceType = types.StringValue(nodePool.NodeInstanceType)
+	data.MaxNodeCount = types.Int64Value(int64(nodePool.MaxNodeCount))
+	data.IsDefault = types.BoolValue(nodePool.IsDefault)
+	data.CloudProvider = types.StringValue(string(nodePool.CloudProvider))
+	data.SupportedAstroMachines, diags = utils.StringSet(nodePool.SupportedAstroMachines)
+	if diags.HasError() {
+		return diags
+	}
+	data.CreatedAt = types.StringValue(nodePool.CreatedAt.String())
+	data.UpdatedAt = types.StringValue(nodePool.UpdatedAt.String())
+
+	return nil
+}
@@ -43,3 +43,9 @@ func (data *ClustersDataSource) ReadFromResponse(
 
 	return nil
 }
+
+// ClustersListConfig describes the cluster list resource configuration.
+type ClustersListConfig struct {
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+	Names         []string     `tfsdk:"names"`
+}
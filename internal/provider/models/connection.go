@@ -0,0 +1,106 @@
+package models
+
+import (
+	"context"
+
+	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Connection describes the astro_connection resource data model, an environment
+// object with object_type=CONNECTION. The resource converts it to and from
+// EnvironmentObject to share the environment object API calls.
+type Connection struct {
+	Id                  types.String `tfsdk:"id"`
+	Key                 types.String `tfsdk:"key"`
+	Description         types.String `tfsdk:"description"`
+	Scope               types.String `tfsdk:"scope"`
+	ScopeEntityId       types.String `tfsdk:"scope_entity_id"`
+	SourceScope         types.String `tfsdk:"source_scope"`
+	SourceScopeEntityId types.String `tfsdk:"source_scope_entity_id"`
+	AutoLinkDeployments types.Bool   `tfsdk:"auto_link_deployments"`
+	Uri                 types.String `tfsdk:"uri"`
+	Type                types.String `tfsdk:"type"`
+	Host                types.String `tfsdk:"host"`
+	Port                types.Int64  `tfsdk:"port"`
+	Schema              types.String `tfsdk:"schema"`
+	Login               types.String `tfsdk:"login"`
+	Password            types.String `tfsdk:"password"`
+	Extra               types.String `tfsdk:"extra"`
+	AuthTypeId          types.String `tfsdk:"auth_type_id"`
+	ConnectionAuthType  types.Object `tfsdk:"connection_auth_type"`
+	Links               types.Set    `tfsdk:"links"`
+	ExcludeLinks        types.Set    `tfsdk:"exclude_links"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	UpdatedAt           types.String `tfsdk:"updated_at"`
+	CreatedBy           types.Object `tfsdk:"created_by"`
+	UpdatedBy           types.Object `tfsdk:"updated_by"`
+}
+
+// EnvironmentObject converts the connection to an EnvironmentObject
+func (data *Connection) EnvironmentObject(ctx context.Context) (EnvironmentObject, diag.Diagnostics) {
+	obj := EnvironmentObject{
+		Id:                  data.Id,
+		ObjectKey:           data.Key,
+		ObjectType:          types.StringValue(string(platform_v1.CreateEnvironmentObjectRequestObjectTypeCONNECTION)),
+		Description:         data.Description,
+		Scope:               data.Scope,
+		ScopeEntityId:       data.ScopeEntityId,
+		SourceScope:         data.SourceScope,
+		SourceScopeEntityId: data.SourceScopeEntityId,
+		AutoLinkDeployments: data.AutoLinkDeployments,
+		ExcludeLinks:        data.ExcludeLinks,
+		CreatedAt:           data.CreatedAt,
+		UpdatedAt:           data.UpdatedAt,
+		CreatedBy:           data.CreatedBy,
+		UpdatedBy:           data.UpdatedBy,
+	}
+	obj.nullAllTypeSpecific()
+	obj.Uri = data.Uri
+	obj.Type = data.Type
+	obj.Host = data.Host
+	obj.Port = data.Port
+	obj.Schema = data.Schema
+	obj.Login = data.Login
+	obj.Password = data.Password
+	obj.Extra = data.Extra
+	obj.AuthTypeId = data.AuthTypeId
+	obj.ConnectionAuthType = data.ConnectionAuthType
+
+	var diags diag.Diagnostics
+	obj.Links, diags = ProjectEnvironmentObjectLinks(ctx, data.Links, schemas.EnvironmentObjectOverridesAttributeTypes())
+	return obj, diags
+}
+
+// ReadFromEnvironmentObject sets the connection from an EnvironmentObject with object_type=CONNECTION
+func (data *Connection) ReadFromEnvironmentObject(ctx context.Context, obj *EnvironmentObject) diag.Diagnostics {
+	data.Id = obj.Id
+	data.Key = obj.ObjectKey
+	data.Description = obj.Description
+	data.Scope = obj.Scope
+	data.ScopeEntityId = obj.ScopeEntityId
+	data.SourceScope = obj.SourceScope
+	data.SourceScopeEntityId = obj.SourceScopeEntityId
+	data.AutoLinkDeployments = obj.AutoLinkDeployments
+	data.Uri = obj.Uri
+	data.Type = obj.Type
+	data.Host = obj.Host
+	data.Port = obj.Port
+	data.Schema = obj.Schema
+	data.Login = obj.Login
+	data.Password = obj.Password
+	data.Extra = obj.Extra
+	data.AuthTypeId = obj.AuthTypeId
+	data.ConnectionAuthType = obj.ConnectionAuthType
+	data.ExcludeLinks = obj.ExcludeLinks
+	data.CreatedAt = obj.CreatedAt
+	data.UpdatedAt = obj.UpdatedAt
+	data.CreatedBy = obj.CreatedBy
+	data.UpdatedBy = obj.UpdatedBy
+
+	var diags diag.Diagnostics
+	data.Links, diags = ProjectEnvironmentObjectLinks(ctx, obj.Links, schemas.ConnectionOverridesAttributeTypes())
+	return diags
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
 	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
 	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -53,6 +54,9 @@ type DeploymentResource struct {
 	WorkloadIdentity            types.String `tfsdk:"workload_identity"`
 	ExternalIps                 types.Set    `tfsdk:"external_ips"`
 	OidcIssuerUrl               types.String `tfsdk:"oidc_issuer_url"`
+	DrWorkloadIdentity          types.String `tfsdk:"dr_workload_identity"`
+	DrExternalIps               types.Set    `tfsdk:"dr_external_ips"`
+	DrOidcIssuerUrl             types.String `tfsdk:"dr_oidc_issuer_url"`
 	WorkerQueues                types.Set    `tfsdk:"worker_queues"`
 
 	// Hybrid and dedicated specific fields
@@ -72,6 +76,12 @@ type DeploymentResource struct {
 	ScalingStatus        types.Object `tfsdk:"scaling_status"`
 	ScalingSpec          types.Object `tfsdk:"scaling_spec"`
 	RemoteExecution      types.Object `tfsdk:"remote_execution"`
+
+	// Terraform-only fields
+	DeletionProtection         types.Bool   `tfsdk:"deletion_protection"`
+	RuntimeDeprecationSeverity types.String `tfsdk:"runtime_deprecation_severity"`
+	RuntimeSupportStatus       types.String `tfsdk:"runtime_support_status"`
+	ProviderDefaultAttributes  types.Set    `tfsdk:"provider_default_attributes"`
 }
 
 type DeploymentDataSource struct {
@@ -112,6 +122,9 @@ type DeploymentDataSource struct {
 	WorkloadIdentity         types.String `tfsdk:"workload_identity"`
 	ExternalIps              types.Set    `tfsdk:"external_ips"`
 	OidcIssuerUrl            types.String `tfsdk:"oidc_issuer_url"`
+	DrWorkloadIdentity       types.String `tfsdk:"dr_workload_identity"`
+	DrExternalIps            types.Set    `tfsdk:"dr_external_ips"`
+	DrOidcIssuerUrl          types.String `tfsdk:"dr_oidc_issuer_url"`
 	WorkerQueues             types.Set    `tfsdk:"worker_queues"`
 
 	// Hybrid and dedicated specific fields
@@ -242,6 +255,12 @@ func (data *DeploymentResource) ReadFromResponse(
 		return diags
 	}
 	data.OidcIssuerUrl = types.StringPointerValue(deployment.OidcIssuerUrl)
+	data.DrWorkloadIdentity = types.StringPointerValue(deployment.DrWorkloadIdentity)
+	data.DrExternalIps, diags = utils.StringSet(deployment.DrExternalIPs)
+	if diags.HasError() {
+		return diags
+	}
+	data.DrOidcIssuerUrl = types.StringPointerValue(deployment.DrOidcIssuerUrl)
 	data.WorkerQueues, diags = utils.ObjectSet(ctx, deployment.WorkerQueues, schemas.WorkerQueueResourceAttributeTypes(), WorkerQueueResourceTypesObject)
 	if diags.HasError() {
 		return diags
@@ -274,6 +293,19 @@ func (data *DeploymentResource) ReadFromResponse(
 		return diags
 	}
 
+	// DeletionProtection is a Terraform-only setting and is never returned by the API
+	if data.DeletionProtection.IsNull() || data.DeletionProtection.IsUnknown() {
+		data.DeletionProtection = types.BoolValue(false)
+	}
+	// RuntimeDeprecationSeverity is a Terraform-only setting and is never returned by the API
+	if data.RuntimeDeprecationSeverity.IsNull() || data.RuntimeDeprecationSeverity.IsUnknown() {
+		data.RuntimeDeprecationSeverity = types.StringValue(schemas.RuntimeDeprecationSeverityWarning)
+	}
+	// ProviderDefaultAttributes is computed during plan modification and is only unset after an import
+	if data.ProviderDefaultAttributes.IsNull() || data.ProviderDefaultAttributes.IsUnknown() {
+		data.ProviderDefaultAttributes = types.SetValueMust(types.StringType, []attr.Value{})
+	}
+
 	return nil
 }
 
@@ -345,6 +377,12 @@ func (data *DeploymentDataSource) ReadFromResponse(
 		return diags
 	}
 	data.OidcIssuerUrl = types.StringPointerValue(deployment.OidcIssuerUrl)
+	data.DrWorkloadIdentity = types.StringPointerValue(deployment.DrWorkloadIdentity)
+	data.DrExternalIps, diags = utils.StringSet(deployment.DrExternalIPs)
+	if diags.HasError() {
+		return diags
+	}
+	data.DrOidcIssuerUrl = types.StringPointerValue(deployment.DrOidcIssuerUrl)
 	data.WorkerQueues, diags = utils.ObjectSet(ctx, deployment.WorkerQueues, schemas.WorkerQueueDataSourceAttributeTypes(), WorkerQueueDataSourceTypesObject)
 	if diags.HasError() {
 		return diags
@@ -632,3 +670,10 @@ func RemoteExecutionTypesObject(
 	}
 	return types.ObjectValueFrom(ctx, schemas.RemoteExecutionAttributeTypes(), obj)
 }
+
+// DeploymentHibernationOverrideAction describes the deployment_hibernation_override action data model.
+type DeploymentHibernationOverrideAction struct {
+	DeploymentId  types.String `tfsdk:"deployment_id"`
+	IsHibernating types.Bool   `tfsdk:"is_hibernating"`
+	OverrideUntil types.String `tfsdk:"override_until"`
+}
@@ -0,0 +1,173 @@
+package models
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DeploymentTemplateDataSource describes the data source data model.
+type DeploymentTemplateDataSource struct {
+	SourceDeploymentId                types.String `tfsdk:"source_deployment_id"`
+	IncludeSecretEnvironmentVariables types.Bool   `tfsdk:"include_secret_environment_variables"`
+	WorkspaceId                       types.String `tfsdk:"workspace_id"`
+	Description                       types.String `tfsdk:"description"`
+	Type                              types.String `tfsdk:"type"`
+	CloudProvider                     types.String `tfsdk:"cloud_provider"`
+	Region                            types.String `tfsdk:"region"`
+	ClusterId                         types.String `tfsdk:"cluster_id"`
+	OriginalAstroRuntimeVersion       types.String `tfsdk:"original_astro_runtime_version"`
+	Executor                          types.String `tfsdk:"executor"`
+	ContactEmails                     types.Set    `tfsdk:"contact_emails"`
+	IsCicdEnforced                    types.Bool   `tfsdk:"is_cicd_enforced"`
+	IsDagDeployEnabled                types.Bool   `tfsdk:"is_dag_deploy_enabled"`
+	IsDevelopmentMode                 types.Bool   `tfsdk:"is_development_mode"`
+	IsHighAvailability                types.Bool   `tfsdk:"is_high_availability"`
+	SchedulerSize                     types.String `tfsdk:"scheduler_size"`
+	SchedulerAu                       types.Int64  `tfsdk:"scheduler_au"`
+	SchedulerReplicas                 types.Int64  `tfsdk:"scheduler_replicas"`
+	ResourceQuotaCpu                  types.String `tfsdk:"resource_quota_cpu"`
+	ResourceQuotaMemory               types.String `tfsdk:"resource_quota_memory"`
+	DefaultTaskPodCpu                 types.String `tfsdk:"default_task_pod_cpu"`
+	DefaultTaskPodMemory              types.String `tfsdk:"default_task_pod_memory"`
+	TaskPodNodePoolId                 types.String `tfsdk:"task_pod_node_pool_id"`
+	EnvironmentVariables              types.Set    `tfsdk:"environment_variables"`
+	WorkerQueues                      types.Set    `tfsdk:"worker_queues"`
+	ScalingSpec                       types.Object `tfsdk:"scaling_spec"`
+	RemoteExecution                   types.Object `tfsdk:"remote_execution"`
+}
+
+// ReadFromResponse copies the configuration of the source deployment. The nested objects have the types of the
+// astro_deployment arguments, with their computed attributes left null so that they can be assigned to the resource.
+func (data *DeploymentTemplateDataSource) ReadFromResponse(
+	ctx context.Context,
+	deployment *platform.Deployment,
+) diag.Diagnostics {
+	data.WorkspaceId = types.StringValue(deployment.WorkspaceId)
+	data.Description = types.StringValue(lo.FromPtr(deployment.Description))
+	data.Type = types.StringPointerValue((*string)(deployment.Type))
+	data.CloudProvider = types.StringPointerValue((*string)(deployment.CloudProvider))
+	data.Region = types.StringPointerValue(deployment.Region)
+	data.ClusterId = types.StringPointerValue(deployment.ClusterId)
+	data.OriginalAstroRuntimeVersion = types.StringValue(deployment.AstroRuntimeVersion)
+	data.Executor = types.StringPointerValue((*string)(deployment.Executor))
+	var diags diag.Diagnostics
+	data.ContactEmails, diags = utils.StringSet(deployment.ContactEmails)
+	if diags.HasError() {
+		return diags
+	}
+	data.IsCicdEnforced = types.BoolValue(deployment.IsCicdEnforced)
+	data.IsDagDeployEnabled = types.BoolValue(deployment.IsDagDeployEnabled)
+	data.IsDevelopmentMode = types.BoolPointerValue(deployment.IsDevelopmentMode)
+	data.IsHighAvailability = types.BoolPointerValue(deployment.IsHighAvailability)
+	data.SchedulerSize = types.StringPointerValue((*string)(deployment.SchedulerSize))
+	if deployment.SchedulerAu != nil {
+		data.SchedulerAu = types.Int64Value(int64(*deployment.SchedulerAu))
+	} else {
+		data.SchedulerAu = types.Int64Null()
+	}
+	data.SchedulerReplicas = types.Int64Value(int64(deployment.SchedulerReplicas))
+	data.ResourceQuotaCpu = types.StringPointerValue(deployment.ResourceQuotaCpu)
+	data.ResourceQuotaMemory = types.StringPointerValue(deployment.ResourceQuotaMemory)
+	data.DefaultTaskPodCpu = types.StringPointerValue(deployment.DefaultTaskPodCpu)
+	data.DefaultTaskPodMemory = types.StringPointerValue(deployment.DefaultTaskPodMemory)
+	data.TaskPodNodePoolId = types.StringPointerValue(deployment.TaskPodNodePoolId)
+
+	// The API never returns the values of secret environment variables, so they are either copied without a value or skipped
+	envVars := lo.Filter(lo.FromPtr(deployment.EnvironmentVariables), func(envVar platform.DeploymentEnvironmentVariable, _ int) bool {
+		return !envVar.IsSecret || data.IncludeSecretEnvironmentVariables.ValueBool()
+	})
+	data.EnvironmentVariables, diags = utils.ObjectSet(ctx, &envVars, schemas.DeploymentEnvironmentVariableAttributeTypes(), deploymentTemplateEnvironmentVariableTypesObject)
+	if diags.HasError() {
+		return diags
+	}
+	// astro_deployment requires at least one worker queue when worker_queues is set, e.g. not for the KUBERNETES executor
+	var workerQueues *[]platform.WorkerQueue
+	if len(lo.FromPtr(deployment.WorkerQueues)) > 0 {
+		workerQueues = deployment.WorkerQueues
+	}
+	data.WorkerQueues, diags = utils.ObjectSet(ctx, workerQueues, schemas.WorkerQueueResourceAttributeTypes(), deploymentTemplateWorkerQueueTypesObject)
+	if diags.HasError() {
+		return diags
+	}
+
+	// A hibernation override only applies to the source deployment, so only its schedules are copied
+	var scalingSpec *platform.DeploymentScalingSpec
+	if deployment.ScalingSpec != nil && deployment.ScalingSpec.HibernationSpec != nil && deployment.ScalingSpec.HibernationSpec.Schedules != nil {
+		scalingSpec = &platform.DeploymentScalingSpec{
+			HibernationSpec: &platform.DeploymentHibernationSpec{
+				Schedules: deployment.ScalingSpec.HibernationSpec.Schedules,
+			},
+		}
+	}
+	data.ScalingSpec, diags = ScalingSpecTypesObject(ctx, scalingSpec)
+	if diags.HasError() {
+		return diags
+	}
+	data.RemoteExecution, diags = deploymentTemplateRemoteExecutionTypesObject(ctx, deployment.RemoteExecution)
+	if diags.HasError() {
+		return diags
+	}
+
+	return nil
+}
+
+func deploymentTemplateEnvironmentVariableTypesObject(
+	ctx context.Context,
+	envVar platform.DeploymentEnvironmentVariable,
+) (types.Object, diag.Diagnostics) {
+	obj := DeploymentEnvironmentVariable{
+		Key:       types.StringValue(envVar.Key),
+		Value:     types.StringPointerValue(envVar.Value),
+		UpdatedAt: types.StringNull(),
+		IsSecret:  types.BoolValue(envVar.IsSecret),
+	}
+
+	return types.ObjectValueFrom(ctx, schemas.DeploymentEnvironmentVariableAttributeTypes(), obj)
+}
+
+func deploymentTemplateWorkerQueueTypesObject(
+	ctx context.Context,
+	workerQueue platform.WorkerQueue,
+) (types.Object, diag.Diagnostics) {
+	obj := WorkerQueueResource{
+		Name:              types.StringValue(workerQueue.Name),
+		AstroMachine:      types.StringPointerValue(workerQueue.AstroMachine),
+		IsDefault:         types.BoolValue(workerQueue.IsDefault),
+		MaxWorkerCount:    types.Int64Value(int64(workerQueue.MaxWorkerCount)),
+		MinWorkerCount:    types.Int64Value(int64(workerQueue.MinWorkerCount)),
+		NodePoolId:        types.StringPointerValue(workerQueue.NodePoolId),
+		PodCpu:            types.StringNull(),
+		PodMemory:         types.StringNull(),
+		WorkerConcurrency: types.Int64Value(int64(workerQueue.WorkerConcurrency)),
+	}
+
+	return types.ObjectValueFrom(ctx, schemas.WorkerQueueResourceAttributeTypes(), obj)
+}
+
+func deploymentTemplateRemoteExecutionTypesObject(
+	ctx context.Context,
+	remoteExecution *platform.DeploymentRemoteExecution,
+) (types.Object, diag.Diagnostics) {
+	if remoteExecution == nil || !remoteExecution.Enabled {
+		return types.ObjectNull(schemas.RemoteExecutionAttributeTypes()), nil
+	}
+	allowedIpAddressRanges, diags := utils.StringSet(&remoteExecution.AllowedIpAddressRanges)
+	if diags.HasError() {
+		return types.ObjectNull(schemas.RemoteExecutionAttributeTypes()), diags
+	}
+	obj := RemoteExecution{
+		Enabled:                types.BoolValue(remoteExecution.Enabled),
+		AllowedIpAddressRanges: allowedIpAddressRanges,
+		RemoteApiUrl:           types.StringNull(),
+		TaskLogBucket:          types.StringPointerValue(remoteExecution.TaskLogBucket),
+		TaskLogUrlPattern:      types.StringPointerValue(remoteExecution.TaskLogUrlPattern),
+	}
+	return types.ObjectValueFrom(ctx, schemas.RemoteExecutionAttributeTypes(), obj)
+}
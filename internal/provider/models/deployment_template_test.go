@@ -0,0 +1,119 @@
+package models_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func templateSourceDeployment() *platform.Deployment {
+	return &platform.Deployment{
+		Id:                  "clx44jyu001m201m5dzsbexqr",
+		WorkspaceId:         "clx42sxw501gl01o0gjenthnh",
+		Type:                lo.ToPtr(platform.DeploymentTypeSTANDARD),
+		CloudProvider:       lo.ToPtr(platform.DeploymentCloudProviderGCP),
+		Region:              lo.ToPtr("us-east4"),
+		AstroRuntimeVersion: "12.9.0",
+		Executor:            lo.ToPtr(platform.DeploymentExecutorCELERY),
+		SchedulerSize:       lo.ToPtr(platform.DeploymentSchedulerSizeSMALL),
+		SchedulerReplicas:   1,
+		IsDevelopmentMode:   lo.ToPtr(true),
+		EnvironmentVariables: &[]platform.DeploymentEnvironmentVariable{
+			{Key: "PLAIN", Value: lo.ToPtr("value")},
+			{Key: "SECRET", IsSecret: true},
+		},
+		WorkerQueues: &[]platform.WorkerQueue{
+			{Id: "clx44jyu001m201m5dzsbexqs", Name: "default", IsDefault: true, MaxWorkerCount: 10, MinWorkerCount: 0, WorkerConcurrency: 5, PodCpu: "1", PodMemory: "2Gi", AstroMachine: lo.ToPtr("A5")},
+		},
+		ScalingSpec: &platform.DeploymentScalingSpec{
+			HibernationSpec: &platform.DeploymentHibernationSpec{
+				Override:  &platform.DeploymentHibernationOverride{IsHibernating: lo.ToPtr(true), IsActive: lo.ToPtr(true)},
+				Schedules: &[]platform.DeploymentHibernationSchedule{{HibernateAtCron: "0 20 * * *", WakeAtCron: "0 8 * * *", IsEnabled: true}},
+			},
+		},
+	}
+}
+
+func TestUnit_DeploymentTemplateReadFromResponse(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("copies the configuration of the source deployment", func(t *testing.T) {
+		var data models.DeploymentTemplateDataSource
+		diags := data.ReadFromResponse(ctx, templateSourceDeployment())
+		require.False(t, diags.HasError(), diags)
+
+		assert.Equal(t, "12.9.0", data.OriginalAstroRuntimeVersion.ValueString())
+		assert.Equal(t, "CELERY", data.Executor.ValueString())
+		assert.Equal(t, "SMALL", data.SchedulerSize.ValueString())
+		assert.True(t, data.SchedulerAu.IsNull())
+		assert.Equal(t, "", data.Description.ValueString())
+		assert.True(t, data.RemoteExecution.IsNull())
+
+		var workerQueues []models.WorkerQueueResource
+		require.False(t, data.WorkerQueues.ElementsAs(ctx, &workerQueues, false).HasError())
+		require.Len(t, workerQueues, 1)
+		assert.Equal(t, "A5", workerQueues[0].AstroMachine.ValueString())
+		assert.True(t, workerQueues[0].PodCpu.IsNull())
+		assert.True(t, workerQueues[0].PodMemory.IsNull())
+	})
+
+	t.Run("skips the secret environment variables by default", func(t *testing.T) {
+		var data models.DeploymentTemplateDataSource
+		diags := data.ReadFromResponse(ctx, templateSourceDeployment())
+		require.False(t, diags.HasError(), diags)
+
+		var envVars []models.DeploymentEnvironmentVariable
+		require.False(t, data.EnvironmentVariables.ElementsAs(ctx, &envVars, false).HasError())
+		require.Len(t, envVars, 1)
+		assert.Equal(t, "PLAIN", envVars[0].Key.ValueString())
+		assert.Equal(t, "value", envVars[0].Value.ValueString())
+		assert.True(t, envVars[0].UpdatedAt.IsNull())
+	})
+
+	t.Run("copies the secret environment variables without their value", func(t *testing.T) {
+		data := models.DeploymentTemplateDataSource{IncludeSecretEnvironmentVariables: types.BoolValue(true)}
+		diags := data.ReadFromResponse(ctx, templateSourceDeployment())
+		require.False(t, diags.HasError(), diags)
+
+		var envVars []models.DeploymentEnvironmentVariable
+		require.False(t, data.EnvironmentVariables.ElementsAs(ctx, &envVars, false).HasError())
+		require.Len(t, envVars, 2)
+		secret, ok := lo.Find(envVars, func(envVar models.DeploymentEnvironmentVariable) bool {
+			return envVar.Key.ValueString() == "SECRET"
+		})
+		require.True(t, ok)
+		assert.True(t, secret.IsSecret.ValueBool())
+		assert.True(t, secret.Value.IsNull())
+	})
+
+	t.Run("copies the hibernation schedules but not the override", func(t *testing.T) {
+		var data models.DeploymentTemplateDataSource
+		diags := data.ReadFromResponse(ctx, templateSourceDeployment())
+		require.False(t, diags.HasError(), diags)
+
+		var scalingSpec models.DeploymentScalingSpec
+		require.False(t, data.ScalingSpec.As(ctx, &scalingSpec, basetypes.ObjectAsOptions{}).HasError())
+		var hibernationSpec models.HibernationSpec
+		require.False(t, scalingSpec.HibernationSpec.As(ctx, &hibernationSpec, basetypes.ObjectAsOptions{}).HasError())
+		assert.True(t, hibernationSpec.Override.IsNull())
+		assert.Len(t, hibernationSpec.Schedules.Elements(), 1)
+	})
+
+	t.Run("leaves worker_queues null without worker queues", func(t *testing.T) {
+		deployment := templateSourceDeployment()
+		deployment.Executor = lo.ToPtr(platform.DeploymentExecutorKUBERNETES)
+		deployment.WorkerQueues = &[]platform.WorkerQueue{}
+		var data models.DeploymentTemplateDataSource
+		diags := data.ReadFromResponse(ctx, deployment)
+		require.False(t, diags.HasError(), diags)
+		assert.True(t, data.WorkerQueues.IsNull())
+	})
+}
@@ -44,3 +44,10 @@ func (data *Deployments) ReadFromResponse(
 
 	return nil
 }
+
+// DeploymentsListConfig describes the deployment list resource configuration.
+type DeploymentsListConfig struct {
+	DeploymentIds []string `tfsdk:"deployment_ids"`
+	WorkspaceIds  []string `tfsdk:"workspace_ids"`
+	Names         []string `tfsdk:"names"`
+}
@@ -10,13 +10,60 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-// EnvironmentObject describes the resource and data source data model. Type-specific
+// EnvironmentObject describes the resource data model. Type-specific
 // fields sit directly on the struct; the parent `object_type` discriminates which
 // fields are populated for any given object. Matches the flat-polymorphic shape
 // used by astro_notification_channel.
 type EnvironmentObject struct {
+	// Identity / common
+	Id                  types.String `tfsdk:"id"`
+	ObjectKey           types.String `tfsdk:"object_key"`
+	ObjectType          types.String `tfsdk:"object_type"`
+	Description         types.String `tfsdk:"description"`
+	Scope               types.String `tfsdk:"scope"`
+	ScopeEntityId       types.String `tfsdk:"scope_entity_id"`
+	SourceScope         types.String `tfsdk:"source_scope"`
+	SourceScopeEntityId types.String `tfsdk:"source_scope_entity_id"`
+	AutoLinkDeployments types.Bool   `tfsdk:"auto_link_deployments"`
+	// AIRFLOW_VARIABLE / ENVIRONMENT_VARIABLE (shared: both carry a value + is_secret pair)
+	Value    types.String `tfsdk:"value"`
+	IsSecret types.Bool   `tfsdk:"is_secret"`
+	// CONNECTION
+	Uri                types.String `tfsdk:"uri"`
+	Type               types.String `tfsdk:"type"`
+	Host               types.String `tfsdk:"host"`
+	Port               types.Int64  `tfsdk:"port"`
+	Schema             types.String `tfsdk:"schema"`
+	Login              types.String `tfsdk:"login"`
+	Extra              types.String `tfsdk:"extra"`
+	AuthTypeId         types.String `tfsdk:"auth_type_id"`
+	ConnectionAuthType types.Object `tfsdk:"connection_auth_type"`
+	// METRICS_EXPORT
+	AuthType     types.String `tfsdk:"auth_type"`
+	Endpoint     types.String `tfsdk:"endpoint"`
+	BasicToken   types.String `tfsdk:"basic_token"`
+	ExporterType types.String `tfsdk:"exporter_type"`
+	Username     types.String `tfsdk:"username"`
+	Headers      types.Map    `tfsdk:"headers"`
+	Labels       types.Map    `tfsdk:"labels"`
+	// Polymorphic (CONNECTION + METRICS_EXPORT)
+	Password types.String `tfsdk:"password"`
+	// Links
+	Links        types.Set `tfsdk:"links"`
+	ExcludeLinks types.Set `tfsdk:"exclude_links"`
+	// Metadata
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+	CreatedBy types.Object `tfsdk:"created_by"`
+	UpdatedBy types.Object `tfsdk:"updated_by"`
+}
+
+// EnvironmentObjectDataSource describes the data source data model. It has the
+// fields of EnvironmentObject except the config-only connection uri.
+type EnvironmentObjectDataSource struct {
 	// Identity / common
 	Id                  types.String `tfsdk:"id"`
 	ObjectKey           types.String `tfsdk:"object_key"`
@@ -110,6 +157,7 @@ type EnvironmentObjectOverridesInput struct {
 type EnvironmentObjectPreserve struct {
 	Password                 *string // CONNECTION password OR METRICS_EXPORT basic-auth password (object_type discriminates)
 	AuthTypeId               *string // CONNECTION
+	Uri                      *string // CONNECTION — config-only input, never returned by the API
 	Extra                    *string // CONNECTION — user's exact JSON string (avoids map round-trip drift)
 	AirflowVariableValue     *string // AIRFLOW_VARIABLE — only meaningful when is_secret=true
 	EnvironmentVariableValue *string // ENVIRONMENT_VARIABLE — same preserve rule as AIRFLOW_VARIABLE
@@ -268,6 +316,50 @@ func (data *EnvironmentObject) ReadFromResponse(ctx context.Context, obj *platfo
 	return nil
 }
 
+// ReadFromResponse populates the data source model like EnvironmentObject.ReadFromResponse without a prior state
+func (data *EnvironmentObjectDataSource) ReadFromResponse(ctx context.Context, obj *platform_v1.EnvironmentObject) diag.Diagnostics {
+	var object EnvironmentObject
+	diags := object.ReadFromResponse(ctx, obj, nil)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Id = object.Id
+	data.ObjectKey = object.ObjectKey
+	data.ObjectType = object.ObjectType
+	data.Description = object.Description
+	data.Scope = object.Scope
+	data.ScopeEntityId = object.ScopeEntityId
+	data.SourceScope = object.SourceScope
+	data.SourceScopeEntityId = object.SourceScopeEntityId
+	data.AutoLinkDeployments = object.AutoLinkDeployments
+	data.Value = object.Value
+	data.IsSecret = object.IsSecret
+	data.Type = object.Type
+	data.Host = object.Host
+	data.Port = object.Port
+	data.Schema = object.Schema
+	data.Login = object.Login
+	data.Extra = object.Extra
+	data.AuthTypeId = object.AuthTypeId
+	data.ConnectionAuthType = object.ConnectionAuthType
+	data.AuthType = object.AuthType
+	data.Endpoint = object.Endpoint
+	data.BasicToken = object.BasicToken
+	data.ExporterType = object.ExporterType
+	data.Username = object.Username
+	data.Headers = object.Headers
+	data.Labels = object.Labels
+	data.Password = object.Password
+	data.Links = object.Links
+	data.ExcludeLinks = object.ExcludeLinks
+	data.CreatedAt = object.CreatedAt
+	data.UpdatedAt = object.UpdatedAt
+	data.CreatedBy = object.CreatedBy
+	data.UpdatedBy = object.UpdatedBy
+	return diags
+}
+
 // nullAllTypeSpecific sets every type-specific field to its typed null so that
 // fields not relevant for the current object_type are explicitly absent from
 // state. populateXxx calls below overwrite the relevant subset.
@@ -276,6 +368,7 @@ func (data *EnvironmentObject) nullAllTypeSpecific() {
 	data.Value = types.StringNull()
 	data.IsSecret = types.BoolNull()
 	// CONNECTION
+	data.Uri = types.StringNull()
 	data.Type = types.StringNull()
 	data.Host = types.StringNull()
 	data.Port = types.Int64Null()
@@ -322,6 +415,14 @@ func (data *EnvironmentObject) populateConnection(ctx context.Context, conn *pla
 		data.AuthTypeId = types.StringNull()
 	}
 
+	// uri is only a config input the discrete fields are planned from; the API
+	// stores the parsed connection, so keep the user's URI as-is.
+	if preserve != nil && preserve.Uri != nil {
+		data.Uri = types.StringValue(*preserve.Uri)
+	} else {
+		data.Uri = types.StringNull()
+	}
+
 	// extra — keep the caller's exact JSON string when provided to avoid
 	// json.Marshal key reordering causing permadiffs.
 	switch {
@@ -592,3 +693,72 @@ func ifPreserve(p *EnvironmentObjectPreserve, pick func(*EnvironmentObjectPreser
 	}
 	return pick(p)
 }
+
+// ProjectEnvironmentObjectLinks converts a links set to a set whose `overrides`
+// have overridesAttributeTypes. Override fields missing from overridesAttributeTypes
+// are dropped and fields missing from the links are null. The typed environment
+// object resources use it to convert their narrower links to and from the links of
+// EnvironmentObject.
+func ProjectEnvironmentObjectLinks(ctx context.Context, links types.Set, overridesAttributeTypes map[string]attr.Type) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	linkAttrTypes := schemas.EnvironmentObjectTypedLinkAttributeTypes(overridesAttributeTypes)
+	linkObjType := types.ObjectType{AttrTypes: linkAttrTypes}
+	switch {
+	case links.IsNull():
+		return types.SetNull(linkObjType), nil
+	case links.IsUnknown():
+		return types.SetUnknown(linkObjType), nil
+	}
+
+	linkObjects := make([]attr.Value, 0, len(links.Elements()))
+	for _, element := range links.Elements() {
+		link, ok := element.(types.Object)
+		if !ok {
+			diags.AddError("Internal Error", fmt.Sprintf("Unexpected environment object link type %T", element))
+			return types.Set{}, diags
+		}
+		if link.IsNull() || link.IsUnknown() {
+			linkObjects = append(linkObjects, types.ObjectUnknown(linkAttrTypes))
+			continue
+		}
+		attributes := link.Attributes()
+
+		overrides, _ := attributes["overrides"].(types.Object)
+		var projected types.Object
+		switch {
+		case overrides.IsNull():
+			projected = types.ObjectNull(overridesAttributeTypes)
+		case overrides.IsUnknown():
+			projected = types.ObjectUnknown(overridesAttributeTypes)
+		default:
+			values := map[string]attr.Value{}
+			for name, attrType := range overridesAttributeTypes {
+				if value, ok := overrides.Attributes()[name]; ok {
+					values[name] = value
+					continue
+				}
+				null, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+				if err != nil {
+					diags.AddError("Internal Error", fmt.Sprintf("Failed to build null link override %s: %s", name, err))
+					return types.Set{}, diags
+				}
+				values[name] = null
+			}
+			projected, diags = types.ObjectValue(overridesAttributeTypes, values)
+			if diags.HasError() {
+				return types.Set{}, diags
+			}
+		}
+
+		linkObject, diags := types.ObjectValue(linkAttrTypes, map[string]attr.Value{
+			"scope":           attributes["scope"],
+			"scope_entity_id": attributes["scope_entity_id"],
+			"overrides":       projected,
+		})
+		if diags.HasError() {
+			return types.Set{}, diags
+		}
+		linkObjects = append(linkObjects, linkObject)
+	}
+	return types.SetValue(linkObjType, linkObjects)
+}
@@ -29,8 +29,8 @@ func (data *EnvironmentObjects) ReadFromResponse(ctx context.Context, objects []
 
 	values := make([]attr.Value, len(objects))
 	for i, obj := range objects {
-		var single EnvironmentObject
-		diags := single.ReadFromResponse(ctx, &obj, nil)
+		var single EnvironmentObjectDataSource
+		diags := single.ReadFromResponse(ctx, &obj)
 		if diags.HasError() {
 			return diags
 		}
@@ -46,3 +46,11 @@ func (data *EnvironmentObjects) ReadFromResponse(ctx context.Context, objects []
 	data.EnvironmentObjects, diags = types.SetValue(elemObjectType, values)
 	return diags
 }
+
+// EnvironmentObjectsListConfig describes the environment object list resource configuration.
+type EnvironmentObjectsListConfig struct {
+	WorkspaceId  types.String `tfsdk:"workspace_id"`
+	DeploymentId types.String `tfsdk:"deployment_id"`
+	ObjectType   types.String `tfsdk:"object_type"`
+	ObjectKey    types.String `tfsdk:"object_key"`
+}
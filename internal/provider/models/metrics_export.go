@@ -0,0 +1,100 @@
+package models
+
+import (
+	"context"
+
+	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MetricsExport describes the astro_metrics_export resource data model, an
+// environment object with object_type=METRICS_EXPORT. The resource converts it
+// to and from EnvironmentObject to share the environment object API calls.
+type MetricsExport struct {
+	Id                  types.String `tfsdk:"id"`
+	Key                 types.String `tfsdk:"key"`
+	Description         types.String `tfsdk:"description"`
+	Scope               types.String `tfsdk:"scope"`
+	ScopeEntityId       types.String `tfsdk:"scope_entity_id"`
+	SourceScope         types.String `tfsdk:"source_scope"`
+	SourceScopeEntityId types.String `tfsdk:"source_scope_entity_id"`
+	AutoLinkDeployments types.Bool   `tfsdk:"auto_link_deployments"`
+	Endpoint            types.String `tfsdk:"endpoint"`
+	ExporterType        types.String `tfsdk:"exporter_type"`
+	AuthType            types.String `tfsdk:"auth_type"`
+	BasicToken          types.String `tfsdk:"basic_token"`
+	Username            types.String `tfsdk:"username"`
+	Password            types.String `tfsdk:"password"`
+	Headers             types.Map    `tfsdk:"headers"`
+	Labels              types.Map    `tfsdk:"labels"`
+	Links               types.Set    `tfsdk:"links"`
+	ExcludeLinks        types.Set    `tfsdk:"exclude_links"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	UpdatedAt           types.String `tfsdk:"updated_at"`
+	CreatedBy           types.Object `tfsdk:"created_by"`
+	UpdatedBy           types.Object `tfsdk:"updated_by"`
+}
+
+// EnvironmentObject converts the metrics export to an EnvironmentObject
+func (data *MetricsExport) EnvironmentObject(ctx context.Context) (EnvironmentObject, diag.Diagnostics) {
+	obj := EnvironmentObject{
+		Id:                  data.Id,
+		ObjectKey:           data.Key,
+		ObjectType:          types.StringValue(string(platform_v1.CreateEnvironmentObjectRequestObjectTypeMETRICSEXPORT)),
+		Description:         data.Description,
+		Scope:               data.Scope,
+		ScopeEntityId:       data.ScopeEntityId,
+		SourceScope:         data.SourceScope,
+		SourceScopeEntityId: data.SourceScopeEntityId,
+		AutoLinkDeployments: data.AutoLinkDeployments,
+		ExcludeLinks:        data.ExcludeLinks,
+		CreatedAt:           data.CreatedAt,
+		UpdatedAt:           data.UpdatedAt,
+		CreatedBy:           data.CreatedBy,
+		UpdatedBy:           data.UpdatedBy,
+	}
+	obj.nullAllTypeSpecific()
+	obj.Endpoint = data.Endpoint
+	obj.ExporterType = data.ExporterType
+	obj.AuthType = data.AuthType
+	obj.BasicToken = data.BasicToken
+	obj.Username = data.Username
+	obj.Password = data.Password
+	obj.Headers = data.Headers
+	obj.Labels = data.Labels
+
+	var diags diag.Diagnostics
+	obj.Links, diags = ProjectEnvironmentObjectLinks(ctx, data.Links, schemas.EnvironmentObjectOverridesAttributeTypes())
+	return obj, diags
+}
+
+// ReadFromEnvironmentObject sets the metrics export from an EnvironmentObject with object_type=METRICS_EXPORT
+func (data *MetricsExport) ReadFromEnvironmentObject(ctx context.Context, obj *EnvironmentObject) diag.Diagnostics {
+	data.Id = obj.Id
+	data.Key = obj.ObjectKey
+	data.Description = obj.Description
+	data.Scope = obj.Scope
+	data.ScopeEntityId = obj.ScopeEntityId
+	data.SourceScope = obj.SourceScope
+	data.SourceScopeEntityId = obj.SourceScopeEntityId
+	data.AutoLinkDeployments = obj.AutoLinkDeployments
+	data.Endpoint = obj.Endpoint
+	data.ExporterType = obj.ExporterType
+	data.AuthType = obj.AuthType
+	data.BasicToken = obj.BasicToken
+	data.Username = obj.Username
+	data.Password = obj.Password
+	data.Headers = obj.Headers
+	data.Labels = obj.Labels
+	data.ExcludeLinks = obj.ExcludeLinks
+	data.CreatedAt = obj.CreatedAt
+	data.UpdatedAt = obj.UpdatedAt
+	data.CreatedBy = obj.CreatedBy
+	data.UpdatedBy = obj.UpdatedBy
+
+	var diags diag.Diagnostics
+	data.Links, diags = ProjectEnvironmentObjectLinks(ctx, obj.Links, schemas.MetricsExportOverridesAttributeTypes())
+	return diags
+}
@@ -46,3 +46,12 @@ func (data *NotificationChannels) ReadFromResponse(
 
 	return nil
 }
+
+// NotificationChannelsListConfig describes the notification channel list resource configuration.
+type NotificationChannelsListConfig struct {
+	NotificationChannelIds []string     `tfsdk:"notification_channel_ids"`
+	ChannelTypes           []string     `tfsdk:"channel_types"`
+	EntityType             types.String `tfsdk:"entity_type"`
+	DeploymentIds          []string     `tfsdk:"deployment_ids"`
+	WorkspaceIds           []string     `tfsdk:"workspace_ids"`
+}
@@ -4,7 +4,27 @@ import "github.com/hashicorp/terraform-plugin-framework/types"
 
 // AstroProviderModel describes the provider data model.
 type AstroProviderModel struct {
-	Token          types.String `tfsdk:"token"`
-	OrganizationId types.String `tfsdk:"organization_id"`
-	Host           types.String `tfsdk:"host"`
+	Token                 types.String  `tfsdk:"token"`
+	TokenFile             types.String  `tfsdk:"token_file"`
+	TokenCommand          types.List    `tfsdk:"token_command"`
+	UseAstroCliContext    types.Bool    `tfsdk:"use_astro_cli_context"`
+	AstroCliConfigFile    types.String  `tfsdk:"astro_cli_config_file"`
+	OrganizationId        types.String  `tfsdk:"organization_id"`
+	Host                  types.String  `tfsdk:"host"`
+	AllowCustomHost       types.Bool    `tfsdk:"allow_custom_host"`
+	CaCertFile            types.String  `tfsdk:"ca_cert_file"`
+	ProxyUrl              types.String  `tfsdk:"proxy_url"`
+	InsecureSkipVerify    types.Bool    `tfsdk:"insecure_skip_verify"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	DeploymentDefaults    types.Object  `tfsdk:"deployment_defaults"`
+}
+
+// DeploymentDefaults describes the provider deployment_defaults data model.
+type DeploymentDefaults struct {
+	ContactEmails      types.Set    `tfsdk:"contact_emails"`
+	IsCicdEnforced     types.Bool   `tfsdk:"is_cicd_enforced"`
+	IsDagDeployEnabled types.Bool   `tfsdk:"is_dag_deploy_enabled"`
+	Executor           types.String `tfsdk:"executor"`
+	SchedulerSize      types.String `tfsdk:"scheduler_size"`
 }
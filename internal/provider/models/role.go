@@ -7,6 +7,7 @@ import (
 	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
 )
 
 type WorkspaceRole struct {
@@ -92,3 +93,24 @@ func ApiTokenRoleTypesObject(
 	}
 	return types.ObjectValueFrom(ctx, schemas.ApiTokenRoleAttributeTypes(), obj)
 }
+
+// ApiTokenDagRoles returns the roles of an API token with a DAG or DAG_TAG entity type as DAG roles
+func ApiTokenDagRoles(roles *[]iam.ApiTokenRole) []iam.DagRole {
+	var dagRoles []iam.DagRole
+	for _, role := range lo.FromPtr(roles) {
+		dagRole := iam.DagRole{
+			DeploymentId: lo.FromPtr(role.DeploymentId),
+			Role:         role.Role,
+		}
+		switch role.EntityType {
+		case iam.ApiTokenRoleEntityTypeDAG:
+			dagRole.DagId = lo.ToPtr(role.EntityId)
+		case iam.ApiTokenRoleEntityTypeDAGTAG:
+			dagRole.DagTag = lo.ToPtr(role.EntityId)
+		default:
+			continue
+		}
+		dagRoles = append(dagRoles, dagRole)
+	}
+	return dagRoles
+}
@@ -43,3 +43,8 @@ func (data *Teams) ReadFromResponse(ctx context.Context, teams []iam.Team, teams
 
 	return nil
 }
+
+// TeamsListConfig describes the team list resource configuration.
+type TeamsListConfig struct {
+	Names []string `tfsdk:"names"`
+}
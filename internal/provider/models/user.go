@@ -2,6 +2,7 @@ package models
 
 import (
 	"context"
+	"time"
 
 	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
 	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
@@ -54,3 +55,64 @@ func (data *User) ReadFromResponse(ctx context.Context, user *iam.User) diag.Dia
 
 	return nil
 }
+
+// UserResource describes the resource data model.
+type UserResource struct {
+	Id               types.String `tfsdk:"id"`
+	Email            types.String `tfsdk:"email"`
+	Status           types.String `tfsdk:"status"`
+	OrganizationRole types.String `tfsdk:"organization_role"`
+	WorkspaceRoles   types.Set    `tfsdk:"workspace_roles"`
+	DeploymentRoles  types.Set    `tfsdk:"deployment_roles"`
+	DagRoles         types.Set    `tfsdk:"dag_roles"`
+	InviteId         types.String `tfsdk:"invite_id"`
+	InviteExpiresAt  types.String `tfsdk:"invite_expires_at"`
+	ReinviteOnExpiry types.Bool   `tfsdk:"reinvite_on_expiry"`
+}
+
+// ReadFromResponse reads the user. The roles of a pending user cannot be bound yet, so they are only read once the user
+// is active: until then, they are the roles to bind when the user accepts the invite.
+func (data *UserResource) ReadFromResponse(ctx context.Context, user *iam.User) diag.Diagnostics {
+	var diags diag.Diagnostics
+	data.Id = types.StringValue(user.Id)
+	data.Status = types.StringValue(string(user.Status))
+	if data.ReinviteOnExpiry.IsNull() {
+		data.ReinviteOnExpiry = types.BoolValue(false)
+	}
+	if user.Status == iam.PENDING {
+		return nil
+	}
+	if user.OrganizationRole != nil {
+		data.OrganizationRole = types.StringValue(string(*user.OrganizationRole))
+	}
+	data.WorkspaceRoles, diags = utils.ObjectSet(ctx, user.WorkspaceRoles, schemas.WorkspaceRoleAttributeTypes(), WorkspaceRoleTypesObject)
+	if diags.HasError() {
+		return diags
+	}
+	data.DeploymentRoles, diags = utils.ObjectSet(ctx, user.DeploymentRoles, schemas.DeploymentRoleAttributeTypes(), DeploymentRoleTypesObject)
+	if diags.HasError() {
+		return diags
+	}
+	data.DagRoles, diags = utils.ObjectSet(ctx, user.DagRoles, schemas.DagRoleAttributeTypes(), DagRoleTypesObject)
+	if diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+// ReadFromInvite reads the invite sent to the user
+func (data *UserResource) ReadFromInvite(invite *iam.Invite) {
+	data.Id = types.StringPointerValue(invite.UserId)
+	data.Status = types.StringValue(string(iam.PENDING))
+	data.InviteId = types.StringValue(invite.InviteId)
+	data.InviteExpiresAt = types.StringValue(invite.ExpiresAt.Format(time.RFC3339Nano))
+}
+
+// InviteExpired returns whether the user is still pending after the expiration date of its invite
+func (data *UserResource) InviteExpired(now time.Time) bool {
+	if data.Status.ValueString() != string(iam.PENDING) || data.InviteExpiresAt.ValueString() == "" {
+		return false
+	}
+	expiresAt, err := time.Parse(time.RFC3339Nano, data.InviteExpiresAt.ValueString())
+	return err == nil && now.After(expiresAt)
+}
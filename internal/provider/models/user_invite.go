@@ -37,3 +37,9 @@ func (data *UserInvite) ReadFromResponse(ctx context.Context, userInvite *iam.In
 	data.UserId = types.StringPointerValue(userInvite.UserId)
 	return nil
 }
+
+// UserInviteResendAction describes the user_invite_resend action data model.
+type UserInviteResendAction struct {
+	Email types.String `tfsdk:"email"`
+	Role  types.String `tfsdk:"role"`
+}
@@ -15,6 +15,8 @@ type Users struct {
 	Users        types.Set    `tfsdk:"users"`
 	WorkspaceId  types.String `tfsdk:"workspace_id"`  // query parameter
 	DeploymentId types.String `tfsdk:"deployment_id"` // query parameter
+	DagId        types.String `tfsdk:"dag_id"`        // query parameter
+	DagTags      types.Set    `tfsdk:"dag_tags"`      // query parameter
 }
 
 func (data *Users) ReadFromResponse(ctx context.Context, users []iam.User) diag.Diagnostics {
@@ -18,6 +18,8 @@ type UsersList struct {
 	Users        types.List   `tfsdk:"users"`
 	WorkspaceId  types.String `tfsdk:"workspace_id"`  // query parameter
 	DeploymentId types.String `tfsdk:"deployment_id"` // query parameter
+	DagId        types.String `tfsdk:"dag_id"`        // query parameter
+	DagTags      types.Set    `tfsdk:"dag_tags"`      // query parameter
 }
 
 func (data *UsersList) ReadFromResponse(ctx context.Context, users []iam.User) diag.Diagnostics {
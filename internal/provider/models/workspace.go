@@ -8,7 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// Workspace describes the resource and data source data model.
+// Workspace describes the data source data model.
 type Workspace struct {
 	Id                  types.String `tfsdk:"id"`
 	Name                types.String `tfsdk:"name"`
@@ -20,6 +20,44 @@ type Workspace struct {
 	UpdatedBy           types.Object `tfsdk:"updated_by"`
 }
 
+// WorkspaceResource describes the resource data model.
+type WorkspaceResource struct {
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	CicdEnforcedDefault types.Bool   `tfsdk:"cicd_enforced_default"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	UpdatedAt           types.String `tfsdk:"updated_at"`
+	CreatedBy           types.Object `tfsdk:"created_by"`
+	UpdatedBy           types.Object `tfsdk:"updated_by"`
+	DeletionProtection  types.Bool   `tfsdk:"deletion_protection"`
+}
+
+func (data *WorkspaceResource) ReadFromResponse(
+	ctx context.Context,
+	workspace *platform.Workspace,
+) diag.Diagnostics {
+	var workspaceData Workspace
+	diags := workspaceData.ReadFromResponse(ctx, workspace)
+	if diags.HasError() {
+		return diags
+	}
+	data.Id = workspaceData.Id
+	data.Name = workspaceData.Name
+	data.Description = workspaceData.Description
+	data.CicdEnforcedDefault = workspaceData.CicdEnforcedDefault
+	data.CreatedAt = workspaceData.CreatedAt
+	data.UpdatedAt = workspaceData.UpdatedAt
+	data.CreatedBy = workspaceData.CreatedBy
+	data.UpdatedBy = workspaceData.UpdatedBy
+	// DeletionProtection is a Terraform-only setting and is never returned by the API
+	if data.DeletionProtection.IsNull() || data.DeletionProtection.IsUnknown() {
+		data.DeletionProtection = types.BoolValue(false)
+	}
+
+	return nil
+}
+
 func (data *Workspace) ReadFromResponse(
 	ctx context.Context,
 	workspace *platform.Workspace,
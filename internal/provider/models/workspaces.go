@@ -43,3 +43,9 @@ func (data *Workspaces) ReadFromResponse(
 
 	return nil
 }
+
+// WorkspacesListConfig describes the workspace list resource configuration.
+type WorkspacesListConfig struct {
+	WorkspaceIds []string `tfsdk:"workspace_ids"`
+	Names        []string `tfsdk:"names"`
+}
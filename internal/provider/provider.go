@@ -2,29 +2,36 @@ package provider
 
 import (
 	"context"
-	"os"
+	"net/http"
 
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
 	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
 	"github.com/astronomer/terraform-provider-astro/internal/clients/labs"
 	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
 	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/actions"
 	"github.com/astronomer/terraform-provider-astro/internal/provider/datasources"
 	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
 	"github.com/astronomer/terraform-provider-astro/internal/provider/resources"
 	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure AstroProvider satisfies various provider interfaces.
 var _ provider.Provider = &AstroProvider{}
 var _ provider.ProviderWithFunctions = &AstroProvider{}
+var _ provider.ProviderWithListResources = &AstroProvider{}
+var _ provider.ProviderWithActions = &AstroProvider{}
 
 // AstroProvider defines the provider implementation.
 type AstroProvider struct {
@@ -71,16 +78,17 @@ func (p *AstroProvider) Configure(
 		return
 	}
 
-	// Will use Token provided in the configuration, or fallback to the ASTRO_API_TOKEN env var
-	if data.Token.IsNull() {
-		data.Token = types.StringValue(os.Getenv("ASTRO_API_TOKEN"))
+	// Will use the token source provided in the configuration, or fallback to the ASTRO_API_TOKEN env var
+	resp.Diagnostics.Append(resolveToken(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if len(data.Token.ValueString()) == 0 {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("token"),
 			"Missing Astro API Token",
-			"Astro API Token must be set in the configuration or the 'ASTRO_API_TOKEN' environment variable",
+			"Astro API Token must be set in the configuration with token, token_file, token_command or use_astro_cli_context, or in the 'ASTRO_API_TOKEN' environment variable",
 		)
 		return
 	}
@@ -89,10 +97,46 @@ func (p *AstroProvider) Configure(
 		data.Host = types.StringValue("https://api.astronomer.io")
 	}
 
+	if data.InsecureSkipVerify.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS certificate verification is disabled",
+			"The provider does not verify the TLS certificate of the Astro API host or proxy. Only use insecure_skip_verify for testing.",
+		)
+	}
+	baseTransport, err := clients.NewBaseTransport(clients.TransportConfig{
+		CaCertFile:         data.CaCertFile.ValueString(),
+		ProxyUrl:           data.ProxyUrl.ValueString(),
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+	})
+	if err != nil {
+		tflog.Error(ctx, "failed to create http transport", map[string]any{"error": err})
+		resp.Diagnostics.AddError("Failed to configure HTTP client", err.Error())
+		return
+	}
+
+	// Tests can record the API traffic of the provider to a cassette, or replay it from one
+	transport, err := clients.CassetteTransportFromEnv(baseTransport)
+	if err != nil {
+		tflog.Error(ctx, "failed to create cassette transport", map[string]any{"error": err})
+		resp.Diagnostics.AddError("Failed to configure HTTP client", err.Error())
+		return
+	}
+
+	// All the API clients share one HTTP client so the request limits apply to the provider as a whole
+	httpClient := &http.Client{
+		Transport: clients.NewRateLimitedTransport(
+			transport,
+			data.MaxConcurrentRequests.ValueInt64(),
+			data.RequestsPerSecond.ValueFloat64(),
+		),
+	}
+
 	platformClient, err := platform.NewPlatformClient(
 		data.Host.ValueString(),
 		data.Token.ValueString(),
 		p.version,
+		platform.WithHTTPClient(httpClient),
 	)
 	if err != nil {
 		tflog.Error(ctx, "failed to create platform client", map[string]any{"error": err})
@@ -102,36 +146,48 @@ func (p *AstroProvider) Configure(
 		)
 		return
 	}
-	iamClient, err := iam.NewIamClient(data.Host.ValueString(), data.Token.ValueString(), p.version)
+	iamClient, err := iam.NewIamClient(data.Host.ValueString(), data.Token.ValueString(), p.version, iam.WithHTTPClient(httpClient))
 	if err != nil {
 		tflog.Error(ctx, "failed to create iam client", map[string]any{"error": err})
 		resp.Diagnostics.AddError("Failed to create iam client", "failed to create IAM API client")
 		return
 	}
-	labsClient, err := labs.NewLabsClient(data.Host.ValueString(), data.Token.ValueString(), p.version)
+	labsClient, err := labs.NewLabsClient(data.Host.ValueString(), data.Token.ValueString(), p.version, labs.WithHTTPClient(httpClient))
 	if err != nil {
 		tflog.Error(ctx, "failed to create labs client", map[string]any{"error": err})
 		resp.Diagnostics.AddError("Failed to create labs client", "failed to create Labs API client")
 		return
 	}
-	platformV1Client, err := platform_v1.NewPlatformV1Client(data.Host.ValueString(), data.Token.ValueString(), p.version)
+	platformV1Client, err := platform_v1.NewPlatformV1Client(data.Host.ValueString(), data.Token.ValueString(), p.version, platform_v1.WithHTTPClient(httpClient))
 	if err != nil {
 		tflog.Error(ctx, "failed to create platform v1 client", map[string]any{"error": err})
 		resp.Diagnostics.AddError("Failed to create platform v1 client", "failed to create Platform v1 API client")
 		return
 	}
 
+	var deploymentDefaults models.DeploymentDefaults
+	if !data.DeploymentDefaults.IsNull() && !data.DeploymentDefaults.IsUnknown() {
+		resp.Diagnostics.Append(data.DeploymentDefaults.As(ctx, &deploymentDefaults, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	apiClientsModel := models.ApiClientsModel{
-		OrganizationId:   data.OrganizationId.ValueString(),
-		PlatformClient:   platformClient,
-		PlatformV1Client: platformV1Client,
-		IamClient:        iamClient,
-		LabsClient:       labsClient,
+		OrganizationId:     data.OrganizationId.ValueString(),
+		PlatformClient:     platformClient,
+		PlatformV1Client:   platformV1Client,
+		IamClient:          iamClient,
+		LabsClient:         labsClient,
+		HttpClient:         httpClient,
+		DeploymentDefaults: deploymentDefaults,
 	}
 
 	// Example client configuration for data sources and resources
 	resp.DataSourceData = apiClientsModel
 	resp.ResourceData = apiClientsModel
+	resp.ListResourceData = apiClientsModel
+	resp.ActionData = apiClientsModel
 }
 
 func (p *AstroProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -139,6 +195,8 @@ func (p *AstroProvider) Resources(ctx context.Context) []func() resource.Resourc
 		resources.NewWorkspaceResource,
 		resources.NewDeploymentResource,
 		resources.NewClusterResource,
+		resources.NewClusterNodePoolResource,
+		resources.NewClusterFailoverResource,
 		resources.NewTeamRolesResource,
 		resources.NewHybridClusterWorkspaceAuthorizationResource,
 		resources.NewApiTokenResource,
@@ -147,11 +205,15 @@ func (p *AstroProvider) Resources(ctx context.Context) []func() resource.Resourc
 		resources.NewTeamMembershipResource,
 		resources.NewUserRolesResource,
 		resources.NewUserInviteResource,
+		resources.NewUserResource,
 		resources.NewAlertResource,
 		resources.NewAlertsResource,
 		resources.NewNotificationChannelResource,
 		resources.NewCustomRoleResource,
 		resources.NewEnvironmentObjectResource,
+		resources.NewConnectionResource,
+		resources.NewAirflowVariableResource,
+		resources.NewMetricsExportResource,
 		resources.NewAllowedIpAddressRangesResource,
 	}
 }
@@ -167,6 +229,7 @@ func (p *AstroProvider) DataSources(ctx context.Context) []func() datasource.Dat
 		datasources.NewClustersDataSource,
 		datasources.NewClusterOptionsDataSource,
 		datasources.NewDeploymentOptionsDataSource,
+		datasources.NewDeploymentTemplateDataSource,
 		datasources.NewTeamDataSource,
 		datasources.NewTeamsDataSource,
 		datasources.NewUserDataSources,
@@ -184,6 +247,26 @@ func (p *AstroProvider) DataSources(ctx context.Context) []func() datasource.Dat
 	}
 }
 
+func (p *AstroProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		resources.NewWorkspaceListResource,
+		resources.NewDeploymentListResource,
+		resources.NewClusterListResource,
+		resources.NewTeamListResource,
+		resources.NewApiTokenListResource,
+		resources.NewAlertListResource,
+		resources.NewNotificationChannelListResource,
+		resources.NewEnvironmentObjectListResource,
+	}
+}
+
+func (p *AstroProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		actions.NewDeploymentHibernationOverrideAction,
+		actions.NewUserInviteResendAction,
+	}
+}
+
 func (p *AstroProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{}
 }
@@ -2,18 +2,26 @@ package provider_test
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
+	"time"
 
 	astronomerprovider "github.com/astronomer/terraform-provider-astro/internal/provider"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/lucsky/cuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUnit_Provider(t *testing.T) {
@@ -23,13 +31,7 @@ func TestUnit_Provider(t *testing.T) {
 		resp := provider.ConfigureResponse{}
 		req := provider.ConfigureRequest{
 			Config: tfsdk.Config{
-				Raw: tftypes.NewValue(tftypes.Object{
-					AttributeTypes: map[string]tftypes.Type{
-						"token":           tftypes.String,
-						"organization_id": tftypes.String,
-						"host":            tftypes.String,
-					},
-				}, map[string]tftypes.Value{
+				Raw: providerConfigValue(ctx, map[string]tftypes.Value{
 					"organization_id": tftypes.NewValue(tftypes.String, cuid.New()),
 					"host":            tftypes.NewValue(tftypes.String, "https://api.astronomer.io"),
 					"token":           tftypes.NewValue(tftypes.String, ""),
@@ -41,6 +43,215 @@ func TestUnit_Provider(t *testing.T) {
 		assert.True(t, resp.Diagnostics.HasError())
 		assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "Missing Astro API Token")
 	})
+
+	t.Run("passes deployment defaults to resources", func(t *testing.T) {
+		ctx := context.Background()
+		p := astronomerprovider.New("test")()
+		resp := provider.ConfigureResponse{}
+		deploymentDefaultsType := astronomerprovider.ProviderSchema().Type().TerraformType(ctx).(tftypes.Object).AttributeTypes["deployment_defaults"].(tftypes.Object)
+		req := provider.ConfigureRequest{
+			Config: tfsdk.Config{
+				Raw: providerConfigValue(ctx, map[string]tftypes.Value{
+					"organization_id": tftypes.NewValue(tftypes.String, cuid.New()),
+					"token":           tftypes.NewValue(tftypes.String, "token"),
+					"deployment_defaults": tftypes.NewValue(deploymentDefaultsType, map[string]tftypes.Value{
+						"contact_emails": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+							tftypes.NewValue(tftypes.String, "team@example.com"),
+						}),
+						"is_cicd_enforced":      tftypes.NewValue(tftypes.Bool, true),
+						"is_dag_deploy_enabled": tftypes.NewValue(tftypes.Bool, nil),
+						"executor":              tftypes.NewValue(tftypes.String, "ASTRO"),
+						"scheduler_size":        tftypes.NewValue(tftypes.String, nil),
+					}),
+				}),
+				Schema: astronomerprovider.ProviderSchema(),
+			},
+		}
+		p.Configure(ctx, req, &resp)
+		assert.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+		apiClients, ok := resp.ResourceData.(models.ApiClientsModel)
+		assert.True(t, ok)
+		assert.Equal(t, "ASTRO", apiClients.DeploymentDefaults.Executor.ValueString())
+		assert.True(t, apiClients.DeploymentDefaults.IsCicdEnforced.ValueBool())
+		assert.True(t, apiClients.DeploymentDefaults.IsDagDeployEnabled.IsNull())
+		assert.True(t, apiClients.DeploymentDefaults.SchedulerSize.IsNull())
+		assert.Len(t, apiClients.DeploymentDefaults.ContactEmails.Elements(), 1)
+		assert.Equal(t, resp.ResourceData, resp.ListResourceData)
+		assert.Equal(t, resp.ResourceData, resp.ActionData)
+	})
+}
+
+func TestUnit_ResolveToken(t *testing.T) {
+	ctx := context.Background()
+	organizationId := cuid.New()
+	newData := func() models.AstroProviderModel {
+		return models.AstroProviderModel{
+			OrganizationId:     types.StringValue(organizationId),
+			Host:               types.StringNull(),
+			Token:              types.StringNull(),
+			TokenFile:          types.StringNull(),
+			TokenCommand:       types.ListNull(types.StringType),
+			UseAstroCliContext: types.BoolNull(),
+			AstroCliConfigFile: types.StringNull(),
+		}
+	}
+	jwt := func(expiresAt time.Time) string {
+		payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, expiresAt.Unix())))
+		return "eyJhbGciOiJSUzI1NiJ9." + payload + ".signature"
+	}
+	writeCliConfig := func(t *testing.T, token, organization string) string {
+		configFile := filepath.Join(t.TempDir(), "config.yaml")
+		config := fmt.Sprintf(`context: astronomer.io
+contexts:
+  astronomer-dev_io:
+    domain: astronomer-dev.io
+    token: Bearer dev-token
+  astronomer_io:
+    domain: astronomer.io
+    organization: %s
+    token: Bearer %s
+    workspace: clxyz
+`, organization, token)
+		require.NoError(t, os.WriteFile(configFile, []byte(config), 0600))
+		return configFile
+	}
+
+	t.Run("uses the token attribute", func(t *testing.T) {
+		t.Setenv("ASTRO_API_TOKEN", "env-token")
+		data := newData()
+		data.Token = types.StringValue("token")
+
+		diags := astronomerprovider.ResolveToken(ctx, &data)
+
+		assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+		assert.Equal(t, "token", data.Token.ValueString())
+	})
+
+	t.Run("falls back to the ASTRO_API_TOKEN env var", func(t *testing.T) {
+		t.Setenv("ASTRO_API_TOKEN", "env-token")
+		data := newData()
+
+		diags := astronomerprovider.ResolveToken(ctx, &data)
+
+		assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+		assert.Equal(t, "env-token", data.Token.ValueString())
+	})
+
+	t.Run("reads the token from token_file", func(t *testing.T) {
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(tokenFile, []byte("file-token\n"), 0600))
+		data := newData()
+		data.TokenFile = types.StringValue(tokenFile)
+
+		diags := astronomerprovider.ResolveToken(ctx, &data)
+
+		assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+		assert.Equal(t, "file-token", data.Token.ValueString())
+	})
+
+	t.Run("errors if token_file does not exist", func(t *testing.T) {
+		data := newData()
+		data.TokenFile = types.StringValue(filepath.Join(t.TempDir(), "missing"))
+
+		diags := astronomerprovider.ResolveToken(ctx, &data)
+
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "Failed to read token_file", diags.Errors()[0].Summary())
+	})
+
+	t.Run("reads the token from the token_command output", func(t *testing.T) {
+		data := newData()
+		data.TokenCommand = types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("echo"),
+			types.StringValue("command-token"),
+		})
+
+		diags := astronomerprovider.ResolveToken(ctx, &data)
+
+		assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+		assert.Equal(t, "command-token", data.Token.ValueString())
+	})
+
+	t.Run("errors if token_command fails", func(t *testing.T) {
+		data := newData()
+		data.TokenCommand = types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("sh"),
+			types.StringValue("-c"),
+			types.StringValue("echo vault is sealed >&2; exit 1"),
+		})
+
+		diags := astronomerprovider.ResolveToken(ctx, &data)
+
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "Failed to run token_command", diags.Errors()[0].Summary())
+		assert.Contains(t, diags.Errors()[0].Detail(), "vault is sealed")
+	})
+
+	t.Run("uses the current Astro CLI context", func(t *testing.T) {
+		token := jwt(time.Now().Add(time.Hour))
+		data := newData()
+		data.UseAstroCliContext = types.BoolValue(true)
+		data.AstroCliConfigFile = types.StringValue(writeCliConfig(t, token, organizationId))
+
+		diags := astronomerprovider.ResolveToken(ctx, &data)
+
+		assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+		assert.Empty(t, diags.Warnings())
+		assert.Equal(t, token, data.Token.ValueString())
+		assert.Equal(t, "https://api.astronomer.io", data.Host.ValueString())
+	})
+
+	t.Run("keeps the configured host when using the Astro CLI context", func(t *testing.T) {
+		data := newData()
+		data.Host = types.StringValue("https://api.astronomer-stage.io")
+		data.UseAstroCliContext = types.BoolValue(true)
+		data.AstroCliConfigFile = types.StringValue(writeCliConfig(t, "cli-token", organizationId))
+
+		diags := astronomerprovider.ResolveToken(ctx, &data)
+
+		assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+		assert.Equal(t, "https://api.astronomer-stage.io", data.Host.ValueString())
+	})
+
+	t.Run("warns if the Astro CLI context uses a different organization", func(t *testing.T) {
+		data := newData()
+		data.UseAstroCliContext = types.BoolValue(true)
+		data.AstroCliConfigFile = types.StringValue(writeCliConfig(t, "cli-token", cuid.New()))
+
+		diags := astronomerprovider.ResolveToken(ctx, &data)
+
+		assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+		assert.Len(t, diags.Warnings(), 1)
+	})
+
+	t.Run("errors if the Astro CLI token has expired", func(t *testing.T) {
+		data := newData()
+		data.UseAstroCliContext = types.BoolValue(true)
+		data.AstroCliConfigFile = types.StringValue(writeCliConfig(t, jwt(time.Now().Add(-time.Hour)), organizationId))
+
+		diags := astronomerprovider.ResolveToken(ctx, &data)
+
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "Astro CLI token has expired", diags.Errors()[0].Summary())
+		assert.Contains(t, diags.Errors()[0].Detail(), "astro login")
+	})
+
+	t.Run("errors if the Astro CLI config file does not exist", func(t *testing.T) {
+		data := newData()
+		data.UseAstroCliContext = types.BoolValue(true)
+		data.AstroCliConfigFile = types.StringValue(filepath.Join(t.TempDir(), "config.yaml"))
+
+		diags := astronomerprovider.ResolveToken(ctx, &data)
+
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "Failed to read the Astro CLI config file", diags.Errors()[0].Summary())
+	})
+}
+
+// providerConfigValue builds a provider configuration value, leaving every attribute that is not in values null
+func providerConfigValue(ctx context.Context, values map[string]tftypes.Value) tftypes.Value {
+	return utils.TestObjectValue(ctx, astronomerprovider.ProviderSchema().Type(), values)
 }
 
 func TestAcc_Provider_config(t *testing.T) {
@@ -77,8 +288,8 @@ func explicitHostConfig() string {
 provider "astro" {
 organization_id = "%v"
 host = "%v"
-token = "%v"
-}`, os.Getenv("HOSTED_ORGANIZATION_ID"), os.Getenv("ASTRO_API_HOST"), os.Getenv("HOSTED_ORGANIZATION_API_TOKEN")) + dataSourceConfig()
+token = "%v"%v
+}`, os.Getenv("HOSTED_ORGANIZATION_ID"), os.Getenv("ASTRO_API_HOST"), os.Getenv("HOSTED_ORGANIZATION_API_TOKEN"), astronomerprovider.AllowCustomHostConfig()) + dataSourceConfig()
 }
 
 func missingOrganizationIdConfig() string {
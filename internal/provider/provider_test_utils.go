@@ -79,7 +79,17 @@ func ProviderConfig(t *testing.T, testOrganizationType TestOrganizationType) str
 provider "astro" {
 	organization_id = "%v"
 	host = "%v"
-	token = "%v"
+	token = "%v"%v
 }
-`, orgId, os.Getenv("ASTRO_API_HOST"), token)
+`, orgId, os.Getenv("ASTRO_API_HOST"), token, AllowCustomHostConfig())
+}
+
+// AllowCustomHostConfig returns the provider attribute allowing the host of the fake API used by the acceptance tests
+// when ASTRO_FAKE_API is true, and an empty string otherwise
+func AllowCustomHostConfig() string {
+	if os.Getenv("ASTRO_FAKE_API") != "true" {
+		return ""
+	}
+	return `
+	allow_custom_host = true`
 }
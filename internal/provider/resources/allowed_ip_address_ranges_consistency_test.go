@@ -92,6 +92,9 @@ func TestUnit_Create_StoresPlannedRangesNotAPIList(t *testing.T) {
 		"ip_address_ranges": tftypes.NewValue(setType, []tftypes.Value{
 			tftypes.NewValue(tftypes.String, "10.1.2.3/8"),
 		}),
+		"ip_address_range_descriptions": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		"ip_address_range_ids":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+		"allow_self_lockout":            tftypes.NewValue(tftypes.Bool, nil),
 	})
 
 	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s, Raw: planRaw}}
@@ -112,4 +115,10 @@ func TestUnit_Create_StoresPlannedRangesNotAPIList(t *testing.T) {
 	// would raise "Provider produced inconsistent result after apply".
 	assert.Equal(t, []string{"10.1.2.3/8"}, stored)
 	assert.NotContains(t, stored, "10.0.0.0/8", "state must not adopt the API-canonicalized CIDR")
+
+	// The range IDs are keyed by the planned CIDR too, matched to the canonicalized range
+	var ids map[string]string
+	out.IpAddressRangeIds.ElementsAs(ctx, &ids, false)
+	assert.Len(t, ids, 1)
+	assert.NotEmpty(t, ids["10.1.2.3/8"])
 }
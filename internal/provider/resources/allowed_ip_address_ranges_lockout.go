@@ -0,0 +1,100 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// egressIpUrl returns the public IP address the request comes from, as plain text
+	egressIpUrl     = "https://checkip.amazonaws.com"
+	egressIpTimeout = 10 * time.Second
+)
+
+// detectEgressIp returns the public IP address of the machine running Terraform, which the Astro API checks against the
+// organization's IP access list. It goes through the HTTP client of the provider, so that it reaches the internet the
+// same way as the requests to the Astro API.
+func detectEgressIp(ctx context.Context, httpClient *http.Client) (netip.Addr, error) {
+	ctx, cancel := context.WithTimeout(ctx, egressIpTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, egressIpUrl, nil)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return netip.Addr{}, fmt.Errorf("%s returned status %s", egressIpUrl, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 256))
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	ip, err := netip.ParseAddr(strings.TrimSpace(string(body)))
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("%s returned an invalid IP address: %w", egressIpUrl, err)
+	}
+	return ip.Unmap(), nil
+}
+
+// cidrsCover returns whether one of the CIDR ranges contains the IP address
+func cidrsCover(cidrs []string, ip netip.Addr) bool {
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err == nil && prefix.Masked().Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkSelfLockout fails the plan when its ranges do not cover the public IP address of the machine running Terraform,
+// unless allow_self_lockout is set. The API only refuses the first range of an empty access list that does not cover
+// the caller, so without this check an apply can remove the caller's range and lock it out of the organization.
+func (r *allowedIpAddressRangesResource) checkSelfLockout(
+	ctx context.Context,
+	plan models.AllowedIpAddressRangesResource,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.AllowSelfLockout.IsUnknown() || plan.AllowSelfLockout.ValueBool() || plan.IpAddressRanges.IsUnknown() {
+		return diags
+	}
+	cidrs, d := utils.TypesSetToStringSlice(ctx, plan.IpAddressRanges)
+	diags.Append(d...)
+	// An empty access list does not restrict access
+	if diags.HasError() || len(cidrs) == 0 {
+		return diags
+	}
+
+	ip, err := r.egressIp(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "failed to detect the egress IP address", map[string]interface{}{"error": err})
+		diags.AddWarning(
+			"Unable to detect the public IP address of the machine running Terraform",
+			fmt.Sprintf("The plan could not check that ip_address_ranges covers the machine running Terraform, make sure that it does to avoid locking it out of the Astro API: %s", err),
+		)
+		return diags
+	}
+	if !cidrsCover(cidrs, ip) {
+		diags.AddAttributeError(
+			path.Root("ip_address_ranges"),
+			"Plan would lock Terraform out of the Astro API",
+			fmt.Sprintf("ip_address_ranges does not cover %s, the public IP address of the machine running Terraform, so applying it would lock this machine out of the Astro API. Add a range covering %s, or set allow_self_lockout = true if Terraform reaches the Astro API through another IP address.", ip, ip),
+		)
+	}
+	return diags
+}
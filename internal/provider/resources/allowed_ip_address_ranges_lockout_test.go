@@ -0,0 +1,193 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+)
+
+// allowedIpAddressRangesValue builds a raw value of the resource with the given ranges, descriptions and range IDs, a
+// nil map leaving the attribute null
+func allowedIpAddressRangesValue(
+	ctx context.Context,
+	s rschema.Schema,
+	cidrs []string,
+	descriptions map[string]string,
+	ids map[string]string,
+	allowSelfLockout *bool,
+) tftypes.Value {
+	mapType := tftypes.Map{ElementType: tftypes.String}
+	mapValue := func(m map[string]string) tftypes.Value {
+		if m == nil {
+			return tftypes.NewValue(mapType, nil)
+		}
+		values := make(map[string]tftypes.Value, len(m))
+		for k, v := range m {
+			values[k] = tftypes.NewValue(tftypes.String, v)
+		}
+		return tftypes.NewValue(mapType, values)
+	}
+	ranges := make([]tftypes.Value, 0, len(cidrs))
+	for _, c := range cidrs {
+		ranges = append(ranges, tftypes.NewValue(tftypes.String, c))
+	}
+	idsValue := tftypes.NewValue(mapType, tftypes.UnknownValue)
+	if ids != nil {
+		idsValue = mapValue(ids)
+	}
+	var allowSelfLockoutValue any
+	if allowSelfLockout != nil {
+		allowSelfLockoutValue = *allowSelfLockout
+	}
+	return tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"id":                            tftypes.NewValue(tftypes.String, "org"),
+		"ip_address_ranges":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, ranges),
+		"ip_address_range_descriptions": mapValue(descriptions),
+		"ip_address_range_ids":          idsValue,
+		"allow_self_lockout":            tftypes.NewValue(tftypes.Bool, allowSelfLockoutValue),
+	})
+}
+
+func TestUnit_AllowedIpAddressRangesSelfLockout(t *testing.T) {
+	ctx := context.Background()
+	s := rschema.Schema{Attributes: schemas.AllowedIpAddressRangesResourceSchemaAttributes()}
+	egressIp := func(context.Context) (netip.Addr, error) { return netip.MustParseAddr("203.0.113.7"), nil }
+	allow := true
+
+	modifyPlan := func(r *allowedIpAddressRangesResource, plan, state tftypes.Value) *resource.ModifyPlanResponse {
+		resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: s, Raw: plan}}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+			Plan:  tfsdk.Plan{Schema: s, Raw: plan},
+			State: tfsdk.State{Schema: s, Raw: state},
+		}, resp)
+		return resp
+	}
+	noState := tftypes.NewValue(s.Type().TerraformType(ctx), nil)
+
+	t.Run("accepts ranges covering the egress IP", func(t *testing.T) {
+		r := &allowedIpAddressRangesResource{egressIp: egressIp}
+		resp := modifyPlan(r, allowedIpAddressRangesValue(ctx, s, []string{"198.51.100.0/24", "203.0.113.0/24"}, nil, nil, nil), noState)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	})
+
+	t.Run("refuses ranges excluding the egress IP", func(t *testing.T) {
+		r := &allowedIpAddressRangesResource{egressIp: egressIp}
+		resp := modifyPlan(r, allowedIpAddressRangesValue(ctx, s, []string{"198.51.100.0/24"}, nil, nil, nil), noState)
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "203.0.113.7")
+	})
+
+	t.Run("accepts ranges excluding the egress IP with allow_self_lockout", func(t *testing.T) {
+		r := &allowedIpAddressRangesResource{egressIp: egressIp}
+		resp := modifyPlan(r, allowedIpAddressRangesValue(ctx, s, []string{"198.51.100.0/24"}, nil, nil, &allow), noState)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	})
+
+	t.Run("accepts an empty access list", func(t *testing.T) {
+		r := &allowedIpAddressRangesResource{egressIp: egressIp}
+		resp := modifyPlan(r, allowedIpAddressRangesValue(ctx, s, nil, nil, nil, nil), noState)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	})
+
+	t.Run("warns when the egress IP cannot be detected", func(t *testing.T) {
+		r := &allowedIpAddressRangesResource{egressIp: func(context.Context) (netip.Addr, error) {
+			return netip.Addr{}, errors.New("connection refused")
+		}}
+		resp := modifyPlan(r, allowedIpAddressRangesValue(ctx, s, []string{"198.51.100.0/24"}, nil, nil, nil), noState)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		require.Len(t, resp.Diagnostics.Warnings(), 1)
+		assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), "connection refused")
+	})
+
+	t.Run("keeps the range IDs and skips the check when the ranges are unchanged", func(t *testing.T) {
+		r := &allowedIpAddressRangesResource{egressIp: func(context.Context) (netip.Addr, error) {
+			t.Fatal("the egress IP must not be detected")
+			return netip.Addr{}, nil
+		}}
+		ids := map[string]string{"198.51.100.0/24": "id-1"}
+		state := allowedIpAddressRangesValue(ctx, s, []string{"198.51.100.0/24"}, nil, ids, nil)
+		plan := allowedIpAddressRangesValue(ctx, s, []string{"198.51.100.0/24"}, map[string]string{"198.51.100.0/24": "office"}, nil, nil)
+		resp := modifyPlan(r, plan, state)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var out models.AllowedIpAddressRangesResource
+		require.False(t, resp.Plan.Get(ctx, &out).HasError())
+		var planIds map[string]string
+		out.IpAddressRangeIds.ElementsAs(ctx, &planIds, false)
+		assert.Equal(t, ids, planIds)
+	})
+
+	t.Run("matches ranges with host bits set", func(t *testing.T) {
+		assert.True(t, cidrsCover([]string{"203.0.113.200/24"}, netip.MustParseAddr("203.0.113.7")))
+		assert.False(t, cidrsCover([]string{"2001:db8::/32"}, netip.MustParseAddr("203.0.113.7")))
+	})
+}
+
+// roundTripFunc is an http.RoundTripper calling a function
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestUnit_DetectEgressIp(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("goes through the HTTP client of the provider", func(t *testing.T) {
+		var urls []string
+		httpClient := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			urls = append(urls, req.URL.String())
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("203.0.113.7\n"))}, nil
+		})}
+		ip, err := detectEgressIp(ctx, httpClient)
+		require.NoError(t, err)
+		assert.Equal(t, netip.MustParseAddr("203.0.113.7"), ip)
+		assert.Equal(t, []string{egressIpUrl}, urls)
+	})
+
+	t.Run("fails on an error status", func(t *testing.T) {
+		httpClient := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusForbidden, Status: "403 Forbidden", Body: io.NopCloser(strings.NewReader(""))}, nil
+		})}
+		_, err := detectEgressIp(ctx, httpClient)
+		assert.ErrorContains(t, err, "403 Forbidden")
+	})
+}
+
+func TestUnit_AllowedIpAddressRangesValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	s := rschema.Schema{Attributes: schemas.AllowedIpAddressRangesResourceSchemaAttributes()}
+	r := &allowedIpAddressRangesResource{}
+
+	validate := func(descriptions map[string]string) *resource.ValidateConfigResponse {
+		resp := &resource.ValidateConfigResponse{}
+		config := allowedIpAddressRangesValue(ctx, s, []string{"198.51.100.0/24"}, descriptions, map[string]string{}, nil)
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: s, Raw: config}}, resp)
+		return resp
+	}
+
+	t.Run("accepts descriptions of the ranges", func(t *testing.T) {
+		resp := validate(map[string]string{"198.51.100.0/24": "office"})
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	})
+
+	t.Run("refuses descriptions of other ranges", func(t *testing.T) {
+		resp := validate(map[string]string{"203.0.113.0/24": "office"})
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "203.0.113.0/24")
+	})
+}
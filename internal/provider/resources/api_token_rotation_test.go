@@ -0,0 +1,66 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/astronomer/terraform-provider-astro/internal/fakeapi"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUnit_ApiTokenRotationTrigger(t *testing.T) {
+	ctx := context.Background()
+	server := fakeapi.NewTestServer(t)
+	r := &ApiTokenResource{IamClient: server.IamClient, PlatformClient: server.PlatformClient, OrganizationId: server.OrganizationId}
+
+	// Read the seeded token like after an import, with the value it was created with
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: utils.TestObjectValue(ctx, schemaResp.Schema.Type(), map[string]tftypes.Value{
+		"id":    tftypes.NewValue(tftypes.String, server.Env["HOSTED_API_TOKEN_ID"]),
+		"token": tftypes.NewValue(tftypes.String, "created-token"),
+	})}
+	readResp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	state = readResp.State
+
+	// update applies the prior state with rotation_trigger set to trigger
+	update := func(state tfsdk.State, trigger string) models.ApiTokenResource {
+		t.Helper()
+		plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()}
+		diags := plan.SetAttribute(ctx, path.Root("rotation_trigger"), types.StringValue(trigger))
+		require.False(t, diags.HasError(), diags)
+		updateResp := resource.UpdateResponse{State: state}
+		r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &updateResp)
+		require.False(t, updateResp.Diagnostics.HasError(), updateResp.Diagnostics)
+		var data models.ApiTokenResource
+		diags = updateResp.State.Get(ctx, &data)
+		require.False(t, diags.HasError(), diags)
+		return data
+	}
+
+	rotated := update(state, "2026-10-19")
+	assert.NotEqual(t, "created-token", rotated.Token.ValueString())
+	assert.NotEmpty(t, rotated.Token.ValueString())
+	assert.True(t, strings.HasSuffix(rotated.Token.ValueString(), rotated.ShortToken.ValueString()))
+
+	// Applying the same trigger again keeps the rotated token
+	var rotatedState tfsdk.State
+	rotatedState.Schema = state.Schema
+	diags := rotatedState.Set(ctx, &rotated)
+	require.False(t, diags.HasError(), diags)
+	unchanged := update(rotatedState, "2026-10-19")
+	assert.Equal(t, rotated.Token.ValueString(), unchanged.Token.ValueString())
+	assert.Equal(t, rotated.ShortToken.ValueString(), unchanged.ShortToken.ValueString())
+}
@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RequiresReplaceFunc returns whether a plan replaces the resource. The framework only adds the paths of the attribute
+// RequiresReplace plan modifiers to the plan after the resource ModifyPlan returns, so ModifyPlan has to compare the
+// plan and the state of the replace-triggering attributes itself.
+type RequiresReplaceFunc func(ctx context.Context, req resource.ModifyPlanRequest) (bool, diag.Diagnostics)
+
+// RequiresReplaceIfConfigured returns a RequiresReplaceFunc matching the RequiresReplaceIfConfigured plan modifier of
+// the attributes at paths: the resource is replaced when one of them is configured and its planned value differs from
+// its state.
+func RequiresReplaceIfConfigured(paths ...path.Path) RequiresReplaceFunc {
+	return func(ctx context.Context, req resource.ModifyPlanRequest) (bool, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		for _, p := range paths {
+			var configValue, planValue, stateValue attr.Value
+			diags.Append(req.Config.GetAttribute(ctx, p, &configValue)...)
+			diags.Append(req.Plan.GetAttribute(ctx, p, &planValue)...)
+			diags.Append(req.State.GetAttribute(ctx, p, &stateValue)...)
+			if diags.HasError() {
+				return false, diags
+			}
+			if !configValue.IsNull() && !planValue.Equal(stateValue) {
+				return true, diags
+			}
+		}
+		return false, diags
+	}
+}
+
+// ModifyPlanDeletionProtection fails destroy and replace plans for a resource whose prior state has
+// deletion_protection set to true. The prior state is used rather than the config so that removing the
+// resource block (or renaming/moving it) is still caught, and so that the flag must be turned off in a
+// separate apply before the resource can be destroyed. requiresReplace is nil for resources that are never
+// replaced.
+func ModifyPlanDeletionProtection(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+	resourceType string,
+	requiresReplace RequiresReplaceFunc,
+) {
+	// Resource is being created — there is nothing to protect yet.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var deletionProtection types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+	if resp.Diagnostics.HasError() || !deletionProtection.ValueBool() {
+		return
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(DeletionProtectionDiagnostic(resourceType, id.ValueString(), "destroy"))
+		return
+	}
+
+	// Resource is being replaced
+	if requiresReplace == nil {
+		return
+	}
+	replace, diags := requiresReplace(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if replace {
+		resp.Diagnostics.Append(DeletionProtectionDiagnostic(resourceType, id.ValueString(), "replace"))
+	}
+}
+
+// DeletionProtectionDiagnostic returns the error diagnostic used when an operation is blocked by deletion_protection
+func DeletionProtectionDiagnostic(resourceType, id, operation string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		fmt.Sprintf("Cannot %s %s with deletion_protection enabled", operation, resourceType),
+		fmt.Sprintf(
+			"%s '%s' has deletion_protection set to true. To %s it, first set deletion_protection = false and apply that change, then run the %s again.",
+			resourceType,
+			id,
+			operation,
+			operation,
+		),
+	)
+}
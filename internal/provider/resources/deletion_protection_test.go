@@ -0,0 +1,200 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// workspaceStateValue builds a workspace resource value with only id and deletion_protection populated
+func workspaceStateValue(ctx context.Context, s rschema.Schema, deletionProtection bool) tftypes.Value {
+	return utils.TestObjectValue(ctx, s.Type(), map[string]tftypes.Value{
+		"id":                  tftypes.NewValue(tftypes.String, "workspace-id"),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, deletionProtection),
+	})
+}
+
+func TestUnit_ModifyPlanDeletionProtection(t *testing.T) {
+	ctx := context.Background()
+	s := rschema.Schema{Attributes: schemas.WorkspaceResourceSchemaAttributes()}
+	objType := s.Type().TerraformType(ctx).(tftypes.Object)
+	nullValue := tftypes.NewValue(objType, nil)
+
+	tests := []struct {
+		name               string
+		state              tftypes.Value
+		plan               tftypes.Value
+		expectedErrSummary string
+	}{
+		{
+			name:  "create is allowed",
+			state: nullValue,
+			plan:  workspaceStateValue(ctx, s, true),
+		},
+		{
+			name:  "destroy is allowed when deletion_protection is false",
+			state: workspaceStateValue(ctx, s, false),
+			plan:  nullValue,
+		},
+		{
+			name:               "destroy is blocked when deletion_protection is true",
+			state:              workspaceStateValue(ctx, s, true),
+			plan:               nullValue,
+			expectedErrSummary: "Cannot destroy Workspace with deletion_protection enabled",
+		},
+		{
+			name:  "update is allowed when deletion_protection is true",
+			state: workspaceStateValue(ctx, s, true),
+			plan:  workspaceStateValue(ctx, s, false),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: s, Raw: tt.state},
+				Plan:  tfsdk.Plan{Schema: s, Raw: tt.plan},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			ModifyPlanDeletionProtection(ctx, req, resp, "Workspace", nil)
+
+			if tt.expectedErrSummary == "" {
+				assert.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+				return
+			}
+			assert.True(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.expectedErrSummary, resp.Diagnostics.Errors()[0].Summary())
+			assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "workspace-id")
+		})
+	}
+}
+
+func TestUnit_WorkspaceDelete_DeletionProtection(t *testing.T) {
+	ctx := context.Background()
+	s := rschema.Schema{Attributes: schemas.WorkspaceResourceSchemaAttributes()}
+
+	// No platform client is configured, so any API call would panic
+	r := &workspaceResource{organizationId: "org"}
+	req := resource.DeleteRequest{State: tfsdk.State{Schema: s, Raw: workspaceStateValue(ctx, s, true)}}
+	resp := &resource.DeleteResponse{State: req.State}
+
+	r.Delete(ctx, req, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Cannot destroy Workspace with deletion_protection enabled", resp.Diagnostics.Errors()[0].Summary())
+}
+
+// planTestProvider serves a single resource, so that its plans go through the framework like in Terraform
+type planTestProvider struct {
+	newResource func() resource.Resource
+}
+
+func (p *planTestProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "astro"
+}
+
+func (p *planTestProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+}
+
+func (p *planTestProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+}
+
+func (p *planTestProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{p.newResource}
+}
+
+func (p *planTestProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+// clusterValue builds a DEDICATED cluster resource value with the given region and deletion_protection, and id set
+// unless it is a configuration
+func clusterValue(ctx context.Context, s rschema.Schema, region string, deletionProtection bool, isConfig bool) tftypes.Value {
+	values := map[string]tftypes.Value{
+		"name":                tftypes.NewValue(tftypes.String, "cluster"),
+		"type":                tftypes.NewValue(tftypes.String, "DEDICATED"),
+		"cloud_provider":      tftypes.NewValue(tftypes.String, "AWS"),
+		"region":              tftypes.NewValue(tftypes.String, region),
+		"vpc_subnet_range":    tftypes.NewValue(tftypes.String, "172.20.0.0/20"),
+		"workspace_ids":       tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{}),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, deletionProtection),
+	}
+	if !isConfig {
+		values["id"] = tftypes.NewValue(tftypes.String, "cluster-id")
+	}
+	return utils.TestObjectValue(ctx, s.Type(), values)
+}
+
+func TestUnit_DeletionProtectionPlan(t *testing.T) {
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(&planTestProvider{newResource: NewClusterResource})()
+	require.NoError(t, err)
+
+	var schemaResp resource.SchemaResponse
+	NewClusterResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+	objType := s.Type().TerraformType(ctx)
+	dynamicValue := func(value tftypes.Value) *tfprotov6.DynamicValue {
+		dv, err := tfprotov6.NewDynamicValue(objType, value)
+		require.NoError(t, err)
+		return &dv
+	}
+
+	tests := []struct {
+		name               string
+		deletionProtection bool
+		region             string
+		destroy            bool
+		expectedErrSummary string
+		expectedReplace    bool
+	}{
+		{name: "update is allowed", deletionProtection: true, region: "us-east-1"},
+		{name: "replace is blocked", deletionProtection: true, region: "us-west-2", expectedErrSummary: "Cannot replace Cluster with deletion_protection enabled", expectedReplace: true},
+		{name: "replace is allowed without deletion_protection", region: "us-west-2", expectedReplace: true},
+		{name: "destroy is blocked", deletionProtection: true, destroy: true, expectedErrSummary: "Cannot destroy Cluster with deletion_protection enabled"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &tfprotov6.PlanResourceChangeRequest{
+				TypeName:   "astro_cluster",
+				PriorState: dynamicValue(clusterValue(ctx, s, "us-east-1", tt.deletionProtection, false)),
+			}
+			if tt.destroy {
+				req.Config = dynamicValue(tftypes.NewValue(objType, nil))
+				req.ProposedNewState = dynamicValue(tftypes.NewValue(objType, nil))
+			} else {
+				req.Config = dynamicValue(clusterValue(ctx, s, tt.region, tt.deletionProtection, true))
+				req.ProposedNewState = dynamicValue(clusterValue(ctx, s, tt.region, tt.deletionProtection, false))
+			}
+
+			resp, err := server.PlanResourceChange(ctx, req)
+			require.NoError(t, err)
+
+			var errSummaries []string
+			for _, d := range resp.Diagnostics {
+				if d.Severity == tfprotov6.DiagnosticSeverityError {
+					errSummaries = append(errSummaries, d.Summary)
+				}
+			}
+			if tt.expectedErrSummary == "" {
+				assert.Empty(t, errSummaries)
+			} else {
+				assert.Contains(t, errSummaries, tt.expectedErrSummary)
+			}
+			assert.Equal(t, tt.expectedReplace, len(resp.RequiresReplace) > 0)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ModifyPlanDeploymentDefaults sets every defaultable attribute that is not set in the configuration to the matching
+// provider deployment_defaults value. Values set on the resource always win. The names of the attributes that were
+// filled in are recorded in provider_default_attributes so the plan shows where each value came from.
+func ModifyPlanDeploymentDefaults(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+	defaults models.DeploymentDefaults,
+) {
+	var config, plan models.DeploymentResource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(applyDeploymentDefaults(&config, defaults)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(config.ProviderDefaultAttributes.Elements()) > 0 {
+		tflog.Debug(ctx, "applied provider deployment_defaults", map[string]interface{}{"attributes": config.ProviderDefaultAttributes.String()})
+
+		// The merged configuration could not be validated in ValidateConfig because the provider defaults are unknown there
+		resp.Diagnostics.Append(validateDeploymentConfig(ctx, &config)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.ContactEmails = config.ContactEmails
+	plan.IsCicdEnforced = config.IsCicdEnforced
+	plan.IsDagDeployEnabled = config.IsDagDeployEnabled
+	plan.Executor = config.Executor
+	// scheduler_size is left to be computed while the deployment type is unknown
+	if !config.Type.IsUnknown() {
+		plan.SchedulerSize = config.SchedulerSize
+	}
+	plan.ProviderDefaultAttributes = config.ProviderDefaultAttributes
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// applyDeploymentDefaults fills the attributes of data that are null with the provider defaults and sets
+// data.ProviderDefaultAttributes to the names of the attributes that were filled in. An error is returned for each
+// required attribute that is set neither on the resource nor in the provider defaults.
+func applyDeploymentDefaults(data *models.DeploymentResource, defaults models.DeploymentDefaults) diag.Diagnostics {
+	diags := make(diag.Diagnostics, 0)
+	var applied []attr.Value
+
+	if data.ContactEmails.IsNull() {
+		if defaults.ContactEmails.IsNull() {
+			diags.Append(missingDeploymentDefaultDiagnostic("contact_emails"))
+		} else {
+			data.ContactEmails = defaults.ContactEmails
+			applied = append(applied, types.StringValue("contact_emails"))
+		}
+	}
+	if data.Executor.IsNull() {
+		if defaults.Executor.IsNull() {
+			diags.Append(missingDeploymentDefaultDiagnostic("executor"))
+		} else {
+			data.Executor = defaults.Executor
+			applied = append(applied, types.StringValue("executor"))
+		}
+	}
+	if data.IsCicdEnforced.IsNull() {
+		if defaults.IsCicdEnforced.IsNull() {
+			diags.Append(missingDeploymentDefaultDiagnostic("is_cicd_enforced"))
+		} else {
+			data.IsCicdEnforced = defaults.IsCicdEnforced
+			applied = append(applied, types.StringValue("is_cicd_enforced"))
+		}
+	}
+	if data.IsDagDeployEnabled.IsNull() {
+		if defaults.IsDagDeployEnabled.IsNull() {
+			diags.Append(missingDeploymentDefaultDiagnostic("is_dag_deploy_enabled"))
+		} else {
+			data.IsDagDeployEnabled = defaults.IsDagDeployEnabled
+			applied = append(applied, types.StringValue("is_dag_deploy_enabled"))
+		}
+	}
+
+	// scheduler_size is only used by 'STANDARD' and 'DEDICATED' deployments
+	switch platform.DeploymentType(data.Type.ValueString()) {
+	case platform.DeploymentTypeSTANDARD, platform.DeploymentTypeDEDICATED:
+		if data.SchedulerSize.IsNull() {
+			if defaults.SchedulerSize.IsNull() {
+				diags.AddAttributeError(
+					path.Root("scheduler_size"),
+					"scheduler_size is required for 'STANDARD' and 'DEDICATED' deployment",
+					"Please provide a scheduler_size on the resource or in the provider deployment_defaults",
+				)
+			} else {
+				data.SchedulerSize = defaults.SchedulerSize
+				applied = append(applied, types.StringValue("scheduler_size"))
+			}
+		}
+	}
+
+	data.ProviderDefaultAttributes = types.SetValueMust(types.StringType, applied)
+	return diags
+}
+
+func missingDeploymentDefaultDiagnostic(attribute string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path.Root(attribute),
+		fmt.Sprintf("%s is required", attribute),
+		fmt.Sprintf("Please provide %s on the resource or in the provider deployment_defaults", attribute),
+	)
+}
@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnit_ApplyDeploymentDefaults(t *testing.T) {
+	contactEmails := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("team@example.com")})
+	defaults := models.DeploymentDefaults{
+		ContactEmails:      contactEmails,
+		IsCicdEnforced:     types.BoolValue(true),
+		IsDagDeployEnabled: types.BoolValue(true),
+		Executor:           types.StringValue(string(platform.DeploymentExecutorASTRO)),
+		SchedulerSize:      types.StringValue(string(platform.SchedulerMachineNameSMALL)),
+	}
+
+	t.Run("fills unset attributes from the provider defaults", func(t *testing.T) {
+		data := models.DeploymentResource{Type: types.StringValue(string(platform.DeploymentTypeSTANDARD))}
+
+		diags := applyDeploymentDefaults(&data, defaults)
+
+		assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+		assert.Equal(t, contactEmails, data.ContactEmails)
+		assert.Equal(t, types.BoolValue(true), data.IsCicdEnforced)
+		assert.Equal(t, types.BoolValue(true), data.IsDagDeployEnabled)
+		assert.Equal(t, types.StringValue("ASTRO"), data.Executor)
+		assert.Equal(t, types.StringValue("SMALL"), data.SchedulerSize)
+		assert.ElementsMatch(t, []attr.Value{
+			types.StringValue("contact_emails"),
+			types.StringValue("executor"),
+			types.StringValue("is_cicd_enforced"),
+			types.StringValue("is_dag_deploy_enabled"),
+			types.StringValue("scheduler_size"),
+		}, data.ProviderDefaultAttributes.Elements())
+	})
+
+	t.Run("values set on the resource win", func(t *testing.T) {
+		data := models.DeploymentResource{
+			Type:               types.StringValue(string(platform.DeploymentTypeDEDICATED)),
+			ContactEmails:      types.SetValueMust(types.StringType, []attr.Value{}),
+			IsCicdEnforced:     types.BoolValue(false),
+			IsDagDeployEnabled: types.BoolValue(false),
+			Executor:           types.StringValue(string(platform.DeploymentExecutorCELERY)),
+			SchedulerSize:      types.StringValue(string(platform.SchedulerMachineNameLARGE)),
+		}
+
+		diags := applyDeploymentDefaults(&data, defaults)
+
+		assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+		assert.Empty(t, data.ContactEmails.Elements())
+		assert.Equal(t, types.BoolValue(false), data.IsCicdEnforced)
+		assert.Equal(t, types.BoolValue(false), data.IsDagDeployEnabled)
+		assert.Equal(t, types.StringValue("CELERY"), data.Executor)
+		assert.Equal(t, types.StringValue("LARGE"), data.SchedulerSize)
+		assert.Empty(t, data.ProviderDefaultAttributes.Elements())
+	})
+
+	t.Run("scheduler_size default is not applied to hybrid deployments", func(t *testing.T) {
+		data := models.DeploymentResource{Type: types.StringValue(string(platform.DeploymentTypeHYBRID))}
+
+		diags := applyDeploymentDefaults(&data, defaults)
+
+		assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+		assert.True(t, data.SchedulerSize.IsNull())
+		assert.NotContains(t, data.ProviderDefaultAttributes.Elements(), types.StringValue("scheduler_size"))
+	})
+
+	t.Run("errors when a required attribute is set neither on the resource nor in the defaults", func(t *testing.T) {
+		data := models.DeploymentResource{Type: types.StringValue(string(platform.DeploymentTypeSTANDARD))}
+
+		diags := applyDeploymentDefaults(&data, models.DeploymentDefaults{})
+
+		assert.True(t, diags.HasError())
+		summaries := make([]string, 0, len(diags.Errors()))
+		for _, d := range diags.Errors() {
+			summaries = append(summaries, d.Summary())
+		}
+		assert.ElementsMatch(t, []string{
+			"contact_emails is required",
+			"executor is required",
+			"is_cicd_enforced is required",
+			"is_dag_deploy_enabled is required",
+			"scheduler_size is required for 'STANDARD' and 'DEDICATED' deployment",
+		}, summaries)
+	})
+}
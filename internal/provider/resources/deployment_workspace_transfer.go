@@ -0,0 +1,267 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/common"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+// deploymentWorkspaceTransfer holds the clients used to check a deployment moving to another workspace
+type deploymentWorkspaceTransfer struct {
+	platformClient   *platform.ClientWithResponses
+	platformV1Client *platform_v1.ClientWithResponses
+	iamClient        *iam.ClientWithResponses
+	organizationId   string
+}
+
+// ModifyPlan checks a planned change of workspace_id, which moves the deployment in place through the update
+// endpoint. The target workspace must be authorized to use the cluster of a dedicated or hybrid deployment. A warning
+// lists the environment objects and role bindings of the current workspace that no longer apply once it is moved.
+func (t deploymentWorkspaceTransfer) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Resource is being created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state models.DeploymentResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.WorkspaceId.Equal(state.WorkspaceId) {
+		return
+	}
+
+	deploymentId := state.Id.ValueString()
+	sourceWorkspaceId := state.WorkspaceId.ValueString()
+	targetWorkspace := "a Workspace that is not known until apply"
+	// The target workspace can only be checked against the cluster once it is known, the update fails otherwise
+	if !plan.WorkspaceId.IsUnknown() {
+		targetWorkspace = fmt.Sprintf("Workspace '%s'", plan.WorkspaceId.ValueString())
+		// STANDARD deployments also have the ID of the shared cluster they run on, which is not authorized per workspace
+		if clusterId := state.ClusterId.ValueString(); clusterId != "" && state.Type.ValueString() != string(platform.DeploymentTypeSTANDARD) {
+			resp.Diagnostics.Append(t.validateClusterAuthorization(ctx, clusterId, plan.WorkspaceId.ValueString())...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	lostObjects, diags := t.workspaceEnvironmentObjects(ctx, deploymentId)
+	resp.Diagnostics.Append(diags...)
+	lostBindings, diags := t.workspaceRoleBindings(ctx, sourceWorkspaceId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "planned deployment workspace transfer", map[string]interface{}{
+		"deploymentId":      deploymentId,
+		"sourceWorkspaceId": sourceWorkspaceId,
+		"targetWorkspaceId": plan.WorkspaceId.String(),
+	})
+	if len(lostObjects) == 0 && len(lostBindings) == 0 {
+		return
+	}
+	details := []string{fmt.Sprintf(
+		"Deployment '%s' is moved in place from Workspace '%s' to %s. Its deployment-scoped environment objects and role bindings are kept, but the following no longer apply to it:",
+		deploymentId, sourceWorkspaceId, targetWorkspace,
+	)}
+	if len(lostObjects) > 0 {
+		details = append(details, "- environment objects linked from the current Workspace: "+strings.Join(lostObjects, ", "))
+	}
+	details = append(details, lo.Map(lostBindings, func(binding string, _ int) string {
+		return "- roles in the current Workspace: " + binding
+	})...)
+	resp.Diagnostics.AddAttributeWarning(path.Root("workspace_id"), "Deployment workspace transfer", strings.Join(details, "\n"))
+}
+
+// validateClusterAuthorization returns an error if the cluster is restricted to workspaces other than workspaceId
+func (t deploymentWorkspaceTransfer) validateClusterAuthorization(
+	ctx context.Context,
+	clusterId string,
+	workspaceId string,
+) diag.Diagnostics {
+	diags := make(diag.Diagnostics, 0)
+	cluster, err := t.platformClient.GetClusterWithResponse(ctx, t.organizationId, clusterId)
+	if err != nil {
+		tflog.Error(ctx, "failed to get cluster", map[string]interface{}{"error": err})
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read cluster, got error: %s", err),
+		)
+		return diags
+	}
+	_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, cluster.HTTPResponse, cluster.Body, cluster.JSON200, "read cluster")
+	if diagnostic != nil {
+		diags.Append(diagnostic)
+		return diags
+	}
+
+	// A cluster without authorized workspaces can be used by every workspace of the organization
+	authorizedWorkspaceIds := lo.FromPtr(cluster.JSON200.WorkspaceIds)
+	if len(authorizedWorkspaceIds) > 0 && !lo.Contains(authorizedWorkspaceIds, workspaceId) {
+		diags.AddAttributeError(
+			path.Root("workspace_id"),
+			"Workspace not authorized for cluster",
+			fmt.Sprintf(
+				"The Deployment cannot be moved to Workspace '%s' because it is not authorized to use cluster '%s' (authorized Workspaces: %s). Authorize the Workspace for the cluster first.",
+				workspaceId, clusterId, strings.Join(authorizedWorkspaceIds, ", "),
+			),
+		)
+	}
+	return diags
+}
+
+// workspaceEnvironmentObjects returns the workspace-scoped environment objects that apply to the deployment, formatted
+// as "<object type> <object key>"
+func (t deploymentWorkspaceTransfer) workspaceEnvironmentObjects(ctx context.Context, deploymentId string) ([]string, diag.Diagnostics) {
+	environmentObjects, diags := listAllPages(func(offset int) ([]platform_v1.EnvironmentObject, int, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		environmentObjectsResp, err := t.platformV1Client.ListEnvironmentObjectsWithResponse(ctx, t.organizationId, &platform_v1.ListEnvironmentObjectsParams{
+			DeploymentId:  &deploymentId,
+			ResolveLinked: lo.ToPtr(true),
+			Offset:        lo.ToPtr(offset),
+			Limit:         lo.ToPtr(common.NameLookupLimit),
+		})
+		if err != nil {
+			tflog.Error(ctx, "failed to list environment objects", map[string]interface{}{"error": err})
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to read environment objects, got error: %s", err),
+			)
+			return nil, 0, diags
+		}
+		_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, environmentObjectsResp.HTTPResponse, environmentObjectsResp.Body, environmentObjectsResp.JSON200, "read environment objects")
+		if diagnostic != nil {
+			diags.Append(diagnostic)
+			return nil, 0, diags
+		}
+		return environmentObjectsResp.JSON200.EnvironmentObjects, environmentObjectsResp.JSON200.TotalCount, diags
+	})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return lo.FilterMap(environmentObjects, func(object platform_v1.EnvironmentObject, _ int) (string, bool) {
+		return fmt.Sprintf("%s %s", object.ObjectType, object.ObjectKey), lo.FromPtr(object.SourceScope) == platform_v1.EnvironmentObjectSourceScopeWORKSPACE
+	}), diags
+}
+
+// workspaceRoleBindings returns the users, teams and API tokens with a role in the workspace, one entry per kind of
+// subject
+func (t deploymentWorkspaceTransfer) workspaceRoleBindings(ctx context.Context, workspaceId string) ([]string, diag.Diagnostics) {
+	var bindings []string
+
+	users, diags := listAllPages(func(offset int) ([]iam.User, int, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		usersResp, err := t.iamClient.ListUsersWithResponse(ctx, t.organizationId, &iam.ListUsersParams{
+			WorkspaceId: &workspaceId,
+			Offset:      lo.ToPtr(offset),
+			Limit:       lo.ToPtr(common.NameLookupLimit),
+		})
+		if err != nil {
+			tflog.Error(ctx, "failed to list users", map[string]interface{}{"error": err})
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to read users, got error: %s", err),
+			)
+			return nil, 0, diags
+		}
+		_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, usersResp.HTTPResponse, usersResp.Body, usersResp.JSON200, "read users")
+		if diagnostic != nil {
+			diags.Append(diagnostic)
+			return nil, 0, diags
+		}
+		return usersResp.JSON200.Users, usersResp.JSON200.TotalCount, diags
+	})
+	if diags.HasError() {
+		return nil, diags
+	}
+	if len(users) > 0 {
+		bindings = append(bindings, "users "+strings.Join(lo.Map(users, func(user iam.User, _ int) string {
+			return user.Username
+		}), ", "))
+	}
+
+	// Teams cannot be filtered by workspace
+	teams, diags := listAllPages(func(offset int) ([]iam.Team, int, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		teamsResp, err := t.iamClient.ListTeamsWithResponse(ctx, t.organizationId, &iam.ListTeamsParams{
+			Offset: lo.ToPtr(offset),
+			Limit:  lo.ToPtr(common.NameLookupLimit),
+		})
+		if err != nil {
+			tflog.Error(ctx, "failed to list teams", map[string]interface{}{"error": err})
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to read teams, got error: %s", err),
+			)
+			return nil, 0, diags
+		}
+		_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, teamsResp.HTTPResponse, teamsResp.Body, teamsResp.JSON200, "read teams")
+		if diagnostic != nil {
+			diags.Append(diagnostic)
+			return nil, 0, diags
+		}
+		return teamsResp.JSON200.Teams, teamsResp.JSON200.TotalCount, diags
+	})
+	if diags.HasError() {
+		return nil, diags
+	}
+	workspaceTeams := lo.FilterMap(teams, func(team iam.Team, _ int) (string, bool) {
+		return team.Name, lo.ContainsBy(lo.FromPtr(team.WorkspaceRoles), func(role iam.WorkspaceRole) bool {
+			return role.WorkspaceId == workspaceId
+		})
+	})
+	if len(workspaceTeams) > 0 {
+		bindings = append(bindings, "teams "+strings.Join(workspaceTeams, ", "))
+	}
+
+	apiTokens, diags := listAllPages(func(offset int) ([]iam.ApiToken, int, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		apiTokensResp, err := t.iamClient.ListApiTokensWithResponse(ctx, t.organizationId, &iam.ListApiTokensParams{
+			WorkspaceId: &workspaceId,
+			Offset:      lo.ToPtr(offset),
+			Limit:       lo.ToPtr(common.NameLookupLimit),
+		})
+		if err != nil {
+			tflog.Error(ctx, "failed to list api tokens", map[string]interface{}{"error": err})
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to read api tokens, got error: %s", err),
+			)
+			return nil, 0, diags
+		}
+		_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, apiTokensResp.HTTPResponse, apiTokensResp.Body, apiTokensResp.JSON200, "read api tokens")
+		if diagnostic != nil {
+			diags.Append(diagnostic)
+			return nil, 0, diags
+		}
+		return apiTokensResp.JSON200.Tokens, apiTokensResp.JSON200.TotalCount, diags
+	})
+	if diags.HasError() {
+		return nil, diags
+	}
+	if len(apiTokens) > 0 {
+		bindings = append(bindings, "API tokens "+strings.Join(lo.Map(apiTokens, func(token iam.ApiToken, _ int) string {
+			return token.Name
+		}), ", "))
+	}
+
+	return bindings, diags
+}
@@ -0,0 +1,122 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+	"github.com/astronomer/terraform-provider-astro/internal/fakeapi"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/common"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+)
+
+func TestUnit_DeploymentWorkspaceTransfer(t *testing.T) {
+	ctx := context.Background()
+	server := fakeapi.NewTestServer(t)
+	env, orgId := server.Env, server.OrganizationId
+	platformV1Client, iamClient := server.PlatformV1Client, server.IamClient
+	transfer := deploymentWorkspaceTransfer{
+		platformClient:   server.PlatformClient,
+		platformV1Client: platformV1Client,
+		iamClient:        iamClient,
+		organizationId:   orgId,
+	}
+
+	t.Run("rejects a workspace that is not authorized for the cluster", func(t *testing.T) {
+		diags := transfer.validateClusterAuthorization(ctx, env["HOSTED_DEDICATED_CLUSTER_ID"], "clx42sxw501gl01o0gjenthnh")
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Workspace not authorized for cluster", diags[0].Summary())
+		assert.Contains(t, diags[0].Detail(), env["HOSTED_WORKSPACE_ID"])
+
+		diags = transfer.validateClusterAuthorization(ctx, env["HOSTED_DEDICATED_CLUSTER_ID"], env["HOSTED_WORKSPACE_ID"])
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("only checks the cluster of dedicated and hybrid deployments", func(t *testing.T) {
+		s := rschema.Schema{Attributes: schemas.DeploymentResourceSchemaAttributes()}
+		deploymentValue := func(deploymentType, workspaceId string) tftypes.Value {
+			return utils.TestObjectValue(ctx, s.Type(), map[string]tftypes.Value{
+				"id":           tftypes.NewValue(tftypes.String, env["HOSTED_STANDARD_DEPLOYMENT_ID"]),
+				"type":         tftypes.NewValue(tftypes.String, deploymentType),
+				"workspace_id": tftypes.NewValue(tftypes.String, workspaceId),
+				"cluster_id":   tftypes.NewValue(tftypes.String, env["HOSTED_DEDICATED_CLUSTER_ID"]),
+			})
+		}
+		modifyPlan := func(deploymentType string) *resource.ModifyPlanResponse {
+			plan := tfsdk.Plan{Schema: s, Raw: deploymentValue(deploymentType, "clx42sxw501gl01o0gjenthnh")}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			transfer.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Plan:  plan,
+				State: tfsdk.State{Schema: s, Raw: deploymentValue(deploymentType, env["HOSTED_WORKSPACE_ID"])},
+			}, resp)
+			return resp
+		}
+
+		resp := modifyPlan(string(platform.DeploymentTypeSTANDARD))
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		resp = modifyPlan(string(platform.DeploymentTypeDEDICATED))
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Workspace not authorized for cluster", resp.Diagnostics.Errors()[0].Summary())
+	})
+
+	t.Run("lists the workspace environment objects linked to the deployment", func(t *testing.T) {
+		created, err := platformV1Client.CreateEnvironmentObjectWithResponse(ctx, orgId, platform_v1.CreateEnvironmentObjectRequest{
+			ObjectKey:           "transfer_variable",
+			ObjectType:          platform_v1.CreateEnvironmentObjectRequestObjectTypeAIRFLOWVARIABLE,
+			Scope:               platform_v1.CreateEnvironmentObjectRequestScopeWORKSPACE,
+			ScopeEntityId:       env["HOSTED_WORKSPACE_ID"],
+			AutoLinkDeployments: lo.ToPtr(true),
+			AirflowVariable:     &platform_v1.CreateEnvironmentObjectAirflowVariableRequest{Value: lo.ToPtr("value")},
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, created.StatusCode(), string(created.Body))
+
+		objects, diags := transfer.workspaceEnvironmentObjects(ctx, env["HOSTED_STANDARD_DEPLOYMENT_ID"])
+		require.False(t, diags.HasError(), diags)
+		assert.Contains(t, objects, "AIRFLOW_VARIABLE transfer_variable")
+	})
+
+	t.Run("lists the role bindings of the workspace", func(t *testing.T) {
+		bindings, diags := transfer.workspaceRoleBindings(ctx, env["HOSTED_WORKSPACE_ID"])
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, []string{
+			"users owner@astronomer.test",
+			"teams acceptance-tests",
+			"API tokens acceptance-tests-workspace",
+		}, bindings)
+	})
+
+	t.Run("lists the role bindings over several pages", func(t *testing.T) {
+		for i := 0; i < common.NameLookupLimit; i++ {
+			created, err := iamClient.CreateApiTokenWithResponse(ctx, orgId, iam.CreateApiTokenRequest{
+				Name:     fmt.Sprintf("transfer-token-%d", i),
+				Role:     "WORKSPACE_MEMBER",
+				Type:     iam.CreateApiTokenRequestTypeWORKSPACE,
+				EntityId: lo.ToPtr(env["HOSTED_WORKSPACE_ID"]),
+			})
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, created.StatusCode(), string(created.Body))
+		}
+
+		bindings, diags := transfer.workspaceRoleBindings(ctx, env["HOSTED_WORKSPACE_ID"])
+		require.False(t, diags.HasError(), diags)
+		require.Len(t, bindings, 3)
+		assert.Len(t, strings.Split(bindings[2], ", "), common.NameLookupLimit+1)
+		assert.Contains(t, bindings[2], fmt.Sprintf("transfer-token-%d", common.NameLookupLimit-1))
+	})
+}
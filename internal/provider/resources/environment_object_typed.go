@@ -0,0 +1,71 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// The typed environment object resources (astro_connection, astro_airflow_variable and astro_metrics_export) manage
+// a single object_type each. They convert their models to models.EnvironmentObject and share the API calls, request
+// builders and preserve logic of astro_environment_object, so both resources read the same state from an object.
+
+// validateEnvironmentObjectType returns an error when an environment object read from the API, e.g. on import, is
+// not of the objectType managed by the typed resource typeName
+func validateEnvironmentObjectType(data *models.EnvironmentObject, objectType platform_v1.CreateEnvironmentObjectRequestObjectType, typeName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if data.ObjectType.ValueString() != string(objectType) {
+		diags.AddAttributeError(path.Root("id"), "Unexpected environment object type",
+			fmt.Sprintf("Environment object %s is a %s, %s only manages environment objects with object_type=%s. Use astro_environment_object or the resource of its type instead.",
+				data.Id.ValueString(), data.ObjectType.ValueString(), typeName, objectType))
+	}
+	return diags
+}
+
+// environmentObjectStateMover moves the state of an astro_environment_object with objectType to a typed environment
+// object resource with a `moved` block. read sets the state of the typed resource from the environment object, so
+// write-only values kept in state, e.g. passwords, move with it.
+func environmentObjectStateMover(
+	objectType platform_v1.CreateEnvironmentObjectRequestObjectType,
+	read func(ctx context.Context, data *models.EnvironmentObject, state *tfsdk.State) diag.Diagnostics,
+) resource.StateMover {
+	return stateMover("astro_environment_object", schemas.EnvironmentObjectResourceSchemaAttributes(), func(ctx context.Context, source *tfsdk.State, target *tfsdk.State) diag.Diagnostics {
+		var data models.EnvironmentObject
+		diags := source.Get(ctx, &data)
+		if diags.HasError() {
+			return diags
+		}
+		if data.ObjectType.ValueString() != string(objectType) {
+			diags.AddError("Unexpected environment object type",
+				fmt.Sprintf("Environment object %s is a %s and cannot be moved to a resource managing object_type=%s",
+					data.Id.ValueString(), data.ObjectType.ValueString(), objectType))
+			return diags
+		}
+		return read(ctx, &data, target)
+	})
+}
+
+// typedEnvironmentObjectStateMover moves a typed environment object resource, e.g. astro_connection, to an
+// astro_environment_object. environmentObject converts the state of the typed resource, so write-only values kept
+// in state, e.g. passwords, move with it.
+func typedEnvironmentObjectStateMover(
+	sourceTypeName string,
+	sourceAttributes map[string]schema.Attribute,
+	environmentObject func(ctx context.Context, source *tfsdk.State) (models.EnvironmentObject, diag.Diagnostics),
+) resource.StateMover {
+	return stateMover(sourceTypeName, sourceAttributes, func(ctx context.Context, source *tfsdk.State, target *tfsdk.State) diag.Diagnostics {
+		data, diags := environmentObject(ctx, source)
+		if diags.HasError() {
+			return diags
+		}
+		return target.Set(ctx, &data)
+	})
+}
@@ -0,0 +1,127 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// environmentObjectSourceState builds the state of an astro_environment_object read from obj, with password kept
+// from the prior state since the API does not return it
+func environmentObjectSourceState(t *testing.T, ctx context.Context, obj *platform_v1.EnvironmentObject, password string) *tfsdk.State {
+	t.Helper()
+	s := rschema.Schema{Attributes: schemas.EnvironmentObjectResourceSchemaAttributes()}
+	var data models.EnvironmentObject
+	diags := data.ReadFromResponse(ctx, obj, &models.EnvironmentObjectPreserve{
+		Password: &password,
+		LinkOverrides: map[string]*models.EnvironmentObjectLinkOverridePreserve{
+			models.LinkPreserveKey("DEPLOYMENT", "clx44jyu001m201m5dzsbexqr"): {Password: lo.ToPtr("staging_password")},
+		},
+	})
+	require.False(t, diags.HasError(), diags)
+	state := &tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	diags = state.Set(ctx, &data)
+	require.False(t, diags.HasError(), diags)
+	return state
+}
+
+func TestUnit_EnvironmentObjectStateMover(t *testing.T) {
+	ctx := context.Background()
+	connection := &platform_v1.EnvironmentObject{
+		Id:            lo.ToPtr("clx46acvv000001mh9k6k9t9h"),
+		ObjectKey:     "warehouse_postgres",
+		ObjectType:    platform_v1.EnvironmentObjectObjectTypeCONNECTION,
+		Scope:         platform_v1.EnvironmentObjectScopeWORKSPACE,
+		ScopeEntityId: "clx42sxw501gl01o0gjenthnh",
+		Connection: &platform_v1.EnvironmentObjectConnection{
+			Type:  "postgres",
+			Host:  lo.ToPtr("warehouse.example.com"),
+			Port:  lo.ToPtr(5432),
+			Login: lo.ToPtr("airflow"),
+		},
+		Links: &[]platform_v1.EnvironmentObjectLink{{
+			Scope:         platform_v1.EnvironmentObjectLinkScopeDEPLOYMENT,
+			ScopeEntityId: "clx44jyu001m201m5dzsbexqr",
+			ConnectionOverrides: &platform_v1.EnvironmentObjectConnectionOverrides{
+				Host: lo.ToPtr("warehouse-staging.example.com"),
+			},
+		}},
+	}
+
+	connectionResource := &connectionResource{}
+	var schemaResp resource.SchemaResponse
+	connectionResource.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	mover := connectionResource.MoveState(ctx)[0]
+
+	move := func(req resource.MoveStateRequest) *resource.MoveStateResponse {
+		resp := &resource.MoveStateResponse{
+			TargetState: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+		}
+		mover.StateMover(ctx, req, resp)
+		return resp
+	}
+
+	t.Run("moves an astro_environment_object of the same type", func(t *testing.T) {
+		resp := move(resource.MoveStateRequest{
+			SourceTypeName:        "astro_environment_object",
+			SourceProviderAddress: providerAddress,
+			SourceState:           environmentObjectSourceState(t, ctx, connection, "prod_password"),
+		})
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var data models.Connection
+		diags := resp.TargetState.Get(ctx, &data)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, "clx46acvv000001mh9k6k9t9h", data.Id.ValueString())
+		assert.Equal(t, "warehouse_postgres", data.Key.ValueString())
+		assert.Equal(t, "postgres", data.Type.ValueString())
+		assert.Equal(t, int64(5432), data.Port.ValueInt64())
+		assert.Equal(t, "prod_password", data.Password.ValueString())
+
+		var links []models.EnvironmentObjectLinkInput
+		diags = data.Links.ElementsAs(ctx, &links, false)
+		require.False(t, diags.HasError(), diags)
+		require.Len(t, links, 1)
+		overrides := links[0].Overrides.Attributes()
+		assert.Len(t, overrides, len(schemas.ConnectionOverridesAttributeTypes()))
+		assert.Equal(t, `"warehouse-staging.example.com"`, overrides["host"].String())
+		assert.Equal(t, `"staging_password"`, overrides["password"].String())
+	})
+
+	t.Run("rejects an astro_environment_object of another type", func(t *testing.T) {
+		variable := &platform_v1.EnvironmentObject{
+			Id:              lo.ToPtr("clx46acvv000001mh9k6k9t9h"),
+			ObjectKey:       "etl_default_region",
+			ObjectType:      platform_v1.EnvironmentObjectObjectTypeAIRFLOWVARIABLE,
+			Scope:           platform_v1.EnvironmentObjectScopeDEPLOYMENT,
+			ScopeEntityId:   "clx44jyu001m201m5dzsbexqr",
+			AirflowVariable: &platform_v1.EnvironmentObjectAirflowVariable{Value: "us-east-1"},
+		}
+		resp := move(resource.MoveStateRequest{
+			SourceTypeName:        "astro_environment_object",
+			SourceProviderAddress: providerAddress,
+			SourceState:           environmentObjectSourceState(t, ctx, variable, ""),
+		})
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "is a AIRFLOW_VARIABLE and cannot be moved")
+	})
+
+	t.Run("ignores other resource types", func(t *testing.T) {
+		resp := move(resource.MoveStateRequest{
+			SourceTypeName:        "astro_deployment",
+			SourceProviderAddress: providerAddress,
+		})
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.True(t, resp.TargetState.Raw.IsNull())
+	})
+}
@@ -0,0 +1,398 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Most resources can also be imported by name, since their IDs are hard to look up:
+//
+//	workspace:<workspace_name>
+//	deployment:<deployment_name> or <workspace_name>/<deployment_name>
+//	cluster:<cluster_name>
+//	team:<team_name>
+//	alert:<alert_name>
+//	notification_channel:<notification_channel_name>
+//	api_token:<api_token_name>
+//	<kind>:<workspace_name>/<object_key> or <kind>:<workspace_name>/<deployment_name>/<object_key>
+//
+// where <kind> is environment_object, airflow_variable, connection or metrics_export for the environment objects. The
+// names are resolved to IDs with the names filter of the list APIs, or by listing all the objects when the API has no
+// such filter, and the import fails unless exactly one object has the name.
+
+// importIdName returns the name of the import ID when it has the form <kind>:<name>
+func importIdName(id, kind string) (string, bool) {
+	name, ok := strings.CutPrefix(id, kind+":")
+	return name, ok && name != ""
+}
+
+// importStateByName sets the state attribute at attrPath to the import ID of the import request like
+// resourceIdentity.ImportStatePassthrough, after resolving a name-based import ID to an ID with resolve. resolve
+// returns the import ID unchanged when it is not name-based.
+func importStateByName(
+	ctx context.Context,
+	identity resourceIdentity,
+	organizationId string,
+	attrPath path.Path,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+	resolve func(ctx context.Context, id string) (string, diag.Diagnostics),
+) {
+	id, diags := identity.ImportID(ctx, organizationId, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id, diags = resolve(ctx, id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, id)...)
+}
+
+// resolveWorkspaceImportId resolves an import ID of the form workspace:<workspace_name> to the ID of the workspace
+func resolveWorkspaceImportId(
+	ctx context.Context,
+	platformClient *platform.ClientWithResponses,
+	organizationId string,
+	id string,
+) (string, diag.Diagnostics) {
+	name, ok := importIdName(id, "workspace")
+	if !ok {
+		return id, nil
+	}
+	return common.FindWorkspaceIdByName(ctx, platformClient, organizationId, name)
+}
+
+// resolveDeploymentImportId resolves an import ID of the form deployment:<deployment_name> or
+// <workspace_name>/<deployment_name> to the ID of the deployment
+func resolveDeploymentImportId(
+	ctx context.Context,
+	platformClient *platform.ClientWithResponses,
+	organizationId string,
+	id string,
+) (string, diag.Diagnostics) {
+	if name, ok := importIdName(id, "deployment"); ok {
+		return common.FindDeploymentIdByName(ctx, platformClient, organizationId, "", name)
+	}
+	workspaceName, name, ok := strings.Cut(id, "/")
+	if !ok {
+		return id, nil
+	}
+	if workspaceName == "" || name == "" {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form <workspace_name>/<deployment_name>, got: %s", id),
+		)
+		return "", diags
+	}
+	workspaceId, diags := common.FindWorkspaceIdByName(ctx, platformClient, organizationId, workspaceName)
+	if diags.HasError() {
+		return "", diags
+	}
+	return common.FindDeploymentIdByName(ctx, platformClient, organizationId, workspaceId, name)
+}
+
+// resolveClusterImportId resolves an import ID of the form cluster:<cluster_name> to the ID of the cluster
+func resolveClusterImportId(
+	ctx context.Context,
+	platformClient *platform.ClientWithResponses,
+	organizationId string,
+	id string,
+) (string, diag.Diagnostics) {
+	name, ok := importIdName(id, "cluster")
+	if !ok {
+		return id, nil
+	}
+	var diags diag.Diagnostics
+	clustersResp, err := platformClient.ListClustersWithResponse(ctx, organizationId, &platform.ListClustersParams{
+		Names: &[]string{name},
+		Limit: lo.ToPtr(common.NameLookupLimit),
+	})
+	if err != nil {
+		tflog.Error(ctx, "failed to list clusters", map[string]interface{}{"error": err})
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list clusters, got error: %s", err),
+		)
+		return "", diags
+	}
+	_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, clustersResp.HTTPResponse, clustersResp.Body, clustersResp.JSON200, "list clusters")
+	if diagnostic != nil {
+		diags.Append(diagnostic)
+		return "", diags
+	}
+	cluster, diags := common.UniqueByName("Cluster", name, "the Organization", clustersResp.JSON200.Clusters,
+		func(cluster platform.Cluster) string { return cluster.Name },
+		func(cluster platform.Cluster) string { return cluster.Id },
+	)
+	return cluster.Id, diags
+}
+
+// resolveTeamImportId resolves an import ID of the form team:<team_name> to the ID of the team
+func resolveTeamImportId(
+	ctx context.Context,
+	iamClient *iam.ClientWithResponses,
+	organizationId string,
+	id string,
+) (string, diag.Diagnostics) {
+	name, ok := importIdName(id, "team")
+	if !ok {
+		return id, nil
+	}
+	var diags diag.Diagnostics
+	teamsResp, err := iamClient.ListTeamsWithResponse(ctx, organizationId, &iam.ListTeamsParams{
+		Names: &[]string{name},
+		Limit: lo.ToPtr(common.NameLookupLimit),
+	})
+	if err != nil {
+		tflog.Error(ctx, "failed to list teams", map[string]interface{}{"error": err})
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list teams, got error: %s", err),
+		)
+		return "", diags
+	}
+	_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, teamsResp.HTTPResponse, teamsResp.Body, teamsResp.JSON200, "list teams")
+	if diagnostic != nil {
+		diags.Append(diagnostic)
+		return "", diags
+	}
+	team, diags := common.UniqueByName("Team", name, "the Organization", teamsResp.JSON200.Teams,
+		func(team iam.Team) string { return team.Name },
+		func(team iam.Team) string { return team.Id },
+	)
+	return team.Id, diags
+}
+
+// listAllPages returns the objects of all the pages of a list API without a names filter. listPage returns the objects
+// of the page starting at offset and the total number of objects.
+func listAllPages[T any](listPage func(offset int) ([]T, int, diag.Diagnostics)) ([]T, diag.Diagnostics) {
+	var objects []T
+	for {
+		page, totalCount, diags := listPage(len(objects))
+		if diags.HasError() {
+			return nil, diags
+		}
+		objects = append(objects, page...)
+		if len(page) == 0 || len(objects) >= totalCount {
+			return objects, nil
+		}
+	}
+}
+
+// resolveAlertImportId resolves an import ID of the form alert:<alert_name> to the ID of the alert
+func resolveAlertImportId(
+	ctx context.Context,
+	platformClient *platform.ClientWithResponses,
+	organizationId string,
+	id string,
+) (string, diag.Diagnostics) {
+	name, ok := importIdName(id, "alert")
+	if !ok {
+		return id, nil
+	}
+	alerts, diags := listAllPages(func(offset int) ([]platform.Alert, int, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		alertsResp, err := platformClient.ListAlertsWithResponse(ctx, organizationId, &platform.ListAlertsParams{
+			Offset: lo.ToPtr(offset),
+			Limit:  lo.ToPtr(common.NameLookupLimit),
+		})
+		if err != nil {
+			tflog.Error(ctx, "failed to list alerts", map[string]interface{}{"error": err})
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to list alerts, got error: %s", err),
+			)
+			return nil, 0, diags
+		}
+		_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, alertsResp.HTTPResponse, alertsResp.Body, alertsResp.JSON200, "list alerts")
+		if diagnostic != nil {
+			diags.Append(diagnostic)
+			return nil, 0, diags
+		}
+		return alertsResp.JSON200.Alerts, alertsResp.JSON200.TotalCount, diags
+	})
+	if diags.HasError() {
+		return "", diags
+	}
+	alert, diags := common.UniqueByName("Alert", name, "the Organization", alerts,
+		func(alert platform.Alert) string { return alert.Name },
+		func(alert platform.Alert) string { return alert.Id },
+	)
+	return alert.Id, diags
+}
+
+// resolveNotificationChannelImportId resolves an import ID of the form notification_channel:<notification_channel_name>
+// to the ID of the notification channel
+func resolveNotificationChannelImportId(
+	ctx context.Context,
+	platformClient *platform.ClientWithResponses,
+	organizationId string,
+	id string,
+) (string, diag.Diagnostics) {
+	name, ok := importIdName(id, "notification_channel")
+	if !ok {
+		return id, nil
+	}
+	notificationChannels, diags := listAllPages(func(offset int) ([]platform.NotificationChannel, int, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		notificationChannelsResp, err := platformClient.ListNotificationChannelsWithResponse(ctx, organizationId, &platform.ListNotificationChannelsParams{
+			Offset: lo.ToPtr(offset),
+			Limit:  lo.ToPtr(common.NameLookupLimit),
+		})
+		if err != nil {
+			tflog.Error(ctx, "failed to list notification channels", map[string]interface{}{"error": err})
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to list notification channels, got error: %s", err),
+			)
+			return nil, 0, diags
+		}
+		_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, notificationChannelsResp.HTTPResponse, notificationChannelsResp.Body, notificationChannelsResp.JSON200, "list notification channels")
+		if diagnostic != nil {
+			diags.Append(diagnostic)
+			return nil, 0, diags
+		}
+		return notificationChannelsResp.JSON200.NotificationChannels, notificationChannelsResp.JSON200.TotalCount, diags
+	})
+	if diags.HasError() {
+		return "", diags
+	}
+	notificationChannel, diags := common.UniqueByName("Notification Channel", name, "the Organization", notificationChannels,
+		func(notificationChannel platform.NotificationChannel) string { return notificationChannel.Name },
+		func(notificationChannel platform.NotificationChannel) string { return notificationChannel.Id },
+	)
+	return notificationChannel.Id, diags
+}
+
+// resolveApiTokenImportId resolves an import ID of the form api_token:<api_token_name> to the ID of the API token
+func resolveApiTokenImportId(
+	ctx context.Context,
+	iamClient *iam.ClientWithResponses,
+	organizationId string,
+	id string,
+) (string, diag.Diagnostics) {
+	name, ok := importIdName(id, "api_token")
+	if !ok {
+		return id, nil
+	}
+	apiTokens, diags := listAllPages(func(offset int) ([]iam.ApiToken, int, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		apiTokensResp, err := iamClient.ListApiTokensWithResponse(ctx, organizationId, &iam.ListApiTokensParams{
+			Offset: lo.ToPtr(offset),
+			Limit:  lo.ToPtr(common.NameLookupLimit),
+		})
+		if err != nil {
+			tflog.Error(ctx, "failed to list API tokens", map[string]interface{}{"error": err})
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to list API tokens, got error: %s", err),
+			)
+			return nil, 0, diags
+		}
+		_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, apiTokensResp.HTTPResponse, apiTokensResp.Body, apiTokensResp.JSON200, "list API tokens")
+		if diagnostic != nil {
+			diags.Append(diagnostic)
+			return nil, 0, diags
+		}
+		return apiTokensResp.JSON200.Tokens, apiTokensResp.JSON200.TotalCount, diags
+	})
+	if diags.HasError() {
+		return "", diags
+	}
+	apiToken, diags := common.UniqueByName("API Token", name, "the Organization", apiTokens,
+		func(apiToken iam.ApiToken) string { return apiToken.Name },
+		func(apiToken iam.ApiToken) string { return apiToken.Id },
+	)
+	return apiToken.Id, diags
+}
+
+// resolveEnvironmentObjectImportId resolves an import ID of the form <kind>:<workspace_name>/<object_key> or
+// <kind>:<workspace_name>/<deployment_name>/<object_key> to the ID of the environment object with the key in the
+// workspace or deployment. objectType restricts the objects to one type when it is set.
+func resolveEnvironmentObjectImportId(
+	ctx context.Context,
+	platformClient *platform.ClientWithResponses,
+	platformV1Client *platform_v1.ClientWithResponses,
+	organizationId string,
+	id string,
+	kind string,
+	objectType *platform_v1.ListEnvironmentObjectsParamsObjectType,
+) (string, diag.Diagnostics) {
+	name, ok := importIdName(id, kind)
+	if !ok {
+		return id, nil
+	}
+	var diags diag.Diagnostics
+	parts := strings.Split(name, "/")
+	if len(parts) < 2 || len(parts) > 3 || lo.Contains(parts, "") {
+		diags.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form %s:<workspace_name>/<object_key> or %s:<workspace_name>/<deployment_name>/<object_key>, got: %s", kind, kind, id),
+		)
+		return "", diags
+	}
+	workspaceId, diags := common.FindWorkspaceIdByName(ctx, platformClient, organizationId, parts[0])
+	if diags.HasError() {
+		return "", diags
+	}
+	objectKey := parts[len(parts)-1]
+	params := &platform_v1.ListEnvironmentObjectsParams{
+		ObjectKey:  &objectKey,
+		ObjectType: objectType,
+		Limit:      lo.ToPtr(common.NameLookupLimit),
+	}
+	scope, scopeEntityId := platform_v1.EnvironmentObjectScopeWORKSPACE, workspaceId
+	location := fmt.Sprintf("Workspace '%s'", workspaceId)
+	if len(parts) == 3 {
+		deploymentId, diags := common.FindDeploymentIdByName(ctx, platformClient, organizationId, workspaceId, parts[1])
+		if diags.HasError() {
+			return "", diags
+		}
+		params.DeploymentId = &deploymentId
+		scope, scopeEntityId = platform_v1.EnvironmentObjectScopeDEPLOYMENT, deploymentId
+		location = fmt.Sprintf("Deployment '%s'", deploymentId)
+	} else {
+		params.WorkspaceId = &workspaceId
+	}
+
+	environmentObjectsResp, err := platformV1Client.ListEnvironmentObjectsWithResponse(ctx, organizationId, params)
+	if err != nil {
+		tflog.Error(ctx, "failed to list environment objects", map[string]interface{}{"error": err})
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list environment objects, got error: %s", err),
+		)
+		return "", diags
+	}
+	_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, environmentObjectsResp.HTTPResponse, environmentObjectsResp.Body, environmentObjectsResp.JSON200, "list environment objects")
+	if diagnostic != nil {
+		diags.Append(diagnostic)
+		return "", diags
+	}
+	// Only keep the objects defined in the workspace or deployment, not the workspace objects linked to the deployment
+	environmentObjects := lo.Filter(environmentObjectsResp.JSON200.EnvironmentObjects, func(environmentObject platform_v1.EnvironmentObject, _ int) bool {
+		return environmentObject.Scope == scope && environmentObject.ScopeEntityId == scopeEntityId
+	})
+	environmentObject, diags := common.UniqueByName("Environment Object", objectKey, location, environmentObjects,
+		func(environmentObject platform_v1.EnvironmentObject) string { return environmentObject.ObjectKey },
+		func(environmentObject platform_v1.EnvironmentObject) string { return lo.FromPtr(environmentObject.Id) },
+	)
+	return lo.FromPtr(environmentObject.Id), diags
+}
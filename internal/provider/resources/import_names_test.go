@@ -0,0 +1,136 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/samber/lo"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+	"github.com/astronomer/terraform-provider-astro/internal/fakeapi"
+)
+
+func TestUnit_ImportByName(t *testing.T) {
+	ctx := context.Background()
+	server := fakeapi.NewTestServer(t)
+	env, orgId := server.Env, server.OrganizationId
+	platformClient, iamClient, platformV1Client := server.PlatformClient, server.IamClient, server.PlatformV1Client
+
+	workspace, err := platformClient.CreateWorkspaceWithResponse(ctx, orgId, platform.CreateWorkspaceRequest{Name: "import-tests"})
+	require.NoError(t, err)
+	require.NotNil(t, workspace.JSON200)
+	variable, err := platformV1Client.CreateEnvironmentObjectWithResponse(ctx, orgId, platform_v1.CreateEnvironmentObjectRequest{
+		ObjectKey:       "import_variable",
+		ObjectType:      platform_v1.CreateEnvironmentObjectRequestObjectTypeAIRFLOWVARIABLE,
+		Scope:           platform_v1.CreateEnvironmentObjectRequestScopeDEPLOYMENT,
+		ScopeEntityId:   env["HOSTED_STANDARD_DEPLOYMENT_ID"],
+		AirflowVariable: &platform_v1.CreateEnvironmentObjectAirflowVariableRequest{Value: lo.ToPtr("value")},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, variable.JSON200)
+
+	t.Run("keeps the IDs", func(t *testing.T) {
+		id, diags := resolveWorkspaceImportId(ctx, platformClient, orgId, env["HOSTED_WORKSPACE_ID"])
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, env["HOSTED_WORKSPACE_ID"], id)
+
+		id, diags = resolveDeploymentImportId(ctx, platformClient, orgId, env["HOSTED_DEPLOYMENT_ID"])
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, env["HOSTED_DEPLOYMENT_ID"], id)
+
+		id, diags = resolveClusterImportId(ctx, platformClient, orgId, env["HOSTED_DEDICATED_CLUSTER_ID"])
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, env["HOSTED_DEDICATED_CLUSTER_ID"], id)
+
+		id, diags = resolveTeamImportId(ctx, iamClient, orgId, env["HOSTED_TEAM_ID"])
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, env["HOSTED_TEAM_ID"], id)
+	})
+
+	t.Run("resolves the names", func(t *testing.T) {
+		id, diags := resolveWorkspaceImportId(ctx, platformClient, orgId, "workspace:Acceptance Tests")
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, env["HOSTED_WORKSPACE_ID"], id)
+
+		id, diags = resolveDeploymentImportId(ctx, platformClient, orgId, "deployment:acceptance-tests-standard")
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, env["HOSTED_STANDARD_DEPLOYMENT_ID"], id)
+
+		id, diags = resolveDeploymentImportId(ctx, platformClient, orgId, "Acceptance Tests/acceptance-tests-standard")
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, env["HOSTED_STANDARD_DEPLOYMENT_ID"], id)
+
+		id, diags = resolveClusterImportId(ctx, platformClient, orgId, "cluster:acceptance-tests-dedicated")
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, env["HOSTED_DEDICATED_CLUSTER_ID"], id)
+
+		id, diags = resolveTeamImportId(ctx, iamClient, orgId, "team:acceptance-tests")
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, env["HOSTED_TEAM_ID"], id)
+
+		id, diags = resolveAlertImportId(ctx, platformClient, orgId, "alert:acceptance-tests-dag-failure")
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, env["HOSTED_ALERT_ID"], id)
+
+		id, diags = resolveNotificationChannelImportId(ctx, platformClient, orgId, "notification_channel:acceptance-tests-email")
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, env["HOSTED_NOTIFICATION_CHANNEL_ID"], id)
+
+		id, diags = resolveApiTokenImportId(ctx, iamClient, orgId, "api_token:acceptance-tests-workspace")
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, env["HOSTED_API_TOKEN_ID"], id)
+
+		id, diags = resolveEnvironmentObjectImportId(ctx, platformClient, platformV1Client, orgId,
+			"airflow_variable:Acceptance Tests/acceptance-tests-standard/import_variable", "airflow_variable", lo.ToPtr(platform_v1.AIRFLOWVARIABLE))
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, variable.JSON200.Id, id)
+	})
+
+	t.Run("fails when no object has the name", func(t *testing.T) {
+		_, diags := resolveWorkspaceImportId(ctx, platformClient, orgId, "workspace:Acceptance")
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Workspace not found", diags.Errors()[0].Summary())
+
+		_, diags = resolveDeploymentImportId(ctx, platformClient, orgId, "import-tests/acceptance-tests-standard")
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Deployment not found", diags.Errors()[0].Summary())
+		assert.Contains(t, diags.Errors()[0].Detail(), workspace.JSON200.Id)
+
+		_, diags = resolveDeploymentImportId(ctx, platformClient, orgId, "/acceptance-tests-standard")
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Invalid Import ID", diags.Errors()[0].Summary())
+
+		// The workspace objects are not the objects of its deployments
+		_, diags = resolveEnvironmentObjectImportId(ctx, platformClient, platformV1Client, orgId,
+			"environment_object:Acceptance Tests/import_variable", "environment_object", nil)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Environment Object not found", diags.Errors()[0].Summary())
+
+		_, diags = resolveEnvironmentObjectImportId(ctx, platformClient, platformV1Client, orgId,
+			"connection:Acceptance Tests/acceptance-tests-standard/import_variable", "connection", lo.ToPtr(platform_v1.CONNECTION))
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Environment Object not found", diags.Errors()[0].Summary())
+
+		_, diags = resolveEnvironmentObjectImportId(ctx, platformClient, platformV1Client, orgId,
+			"environment_object:import_variable", "environment_object", nil)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Invalid Import ID", diags.Errors()[0].Summary())
+	})
+
+	t.Run("fails when several objects have the name", func(t *testing.T) {
+		team, err := iamClient.CreateTeamWithResponse(ctx, orgId, iam.CreateTeamRequest{Name: "acceptance-tests"})
+		require.NoError(t, err)
+		require.NotNil(t, team.JSON200)
+
+		_, diags := resolveTeamImportId(ctx, iamClient, orgId, "team:acceptance-tests")
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Multiple Teams found", diags.Errors()[0].Summary())
+		assert.Contains(t, diags.Errors()[0].Detail(), env["HOSTED_TEAM_ID"])
+		assert.Contains(t, diags.Errors()[0].Detail(), team.JSON200.Id)
+	})
+}
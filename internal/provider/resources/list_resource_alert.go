@@ -0,0 +1,141 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &alertListResource{}
+var _ list.ListResourceWithConfigure = &alertListResource{}
+
+func NewAlertListResource() list.ListResource {
+	return &alertListResource{}
+}
+
+// alertListResource lists the alerts of the organization as astro_alert resources.
+type alertListResource struct {
+	platformClient *platform.ClientWithResponses
+	organizationId string
+}
+
+func (r *alertListResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_alert"
+}
+
+func (r *alertListResource) ListResourceConfigSchema(
+	ctx context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = listschema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Alert list resource",
+		Attributes:          schemas.AlertsListResourceSchemaAttributes(),
+	}
+}
+
+func (r *alertListResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClients, ok := req.ProviderData.(models.ApiClientsModel)
+	if !ok {
+		utils.ResourceApiClientConfigureError(ctx, req, resp)
+		return
+	}
+
+	r.platformClient = apiClients.PlatformClient
+	r.organizationId = apiClients.OrganizationId
+}
+
+func (r *alertListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var config models.AlertsListConfig
+
+	// Read Terraform configuration data into the model
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	params := &platform.ListAlertsParams{
+		Limit: lo.ToPtr(listPageLimit),
+	}
+	if len(config.AlertIds) > 0 {
+		params.AlertIds = &config.AlertIds
+	}
+	if len(config.AlertTypes) > 0 {
+		alertTypes := lo.Map(config.AlertTypes, func(alertType string, _ int) platform.ListAlertsParamsAlertTypes {
+			return platform.ListAlertsParamsAlertTypes(alertType)
+		})
+		params.AlertTypes = &alertTypes
+	}
+	if !config.EntityType.IsNull() {
+		params.EntityType = (*platform.ListAlertsParamsEntityType)(config.EntityType.ValueStringPointer())
+	}
+	if len(config.DeploymentIds) > 0 {
+		params.DeploymentIds = &config.DeploymentIds
+	}
+	if len(config.WorkspaceIds) > 0 {
+		params.WorkspaceIds = &config.WorkspaceIds
+	}
+
+	listPage := func(ctx context.Context, offset int) ([]platform.Alert, int, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		params.Offset = &offset
+		alertsResp, err := r.platformClient.ListAlertsWithResponse(ctx, r.organizationId, params)
+		if err != nil {
+			tflog.Error(ctx, "failed to list alerts", map[string]interface{}{"error": err})
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to list alerts, got error: %s", err),
+			)
+			return nil, 0, diags
+		}
+		_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, alertsResp.HTTPResponse, alertsResp.Body, alertsResp.JSON200, "list alerts")
+		if diagnostic != nil {
+			diags.Append(diagnostic)
+			return nil, 0, diags
+		}
+		return alertsResp.JSON200.Alerts, alertsResp.JSON200.TotalCount, diags
+	}
+
+	stream.Results = listResults(ctx, req, r.organizationId, alertIdentity, listPage,
+		func(ctx context.Context, alert platform.Alert, state *tfsdk.Resource) (string, diag.Diagnostics) {
+			var data models.AlertResource
+			diags := data.ReadFromResponse(ctx, &alert)
+			if diags.HasError() {
+				return "", diags
+			}
+			return alert.Name, state.Set(ctx, &data)
+		},
+	)
+}
@@ -0,0 +1,133 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &apiTokenListResource{}
+var _ list.ListResourceWithConfigure = &apiTokenListResource{}
+
+func NewApiTokenListResource() list.ListResource {
+	return &apiTokenListResource{}
+}
+
+// apiTokenListResource lists the API tokens of the organization as astro_api_token resources.
+type apiTokenListResource struct {
+	IamClient      *iam.ClientWithResponses
+	OrganizationId string
+}
+
+func (r *apiTokenListResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *apiTokenListResource) ListResourceConfigSchema(
+	ctx context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = listschema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "API token list resource",
+		Attributes:          schemas.ApiTokensListResourceSchemaAttributes(),
+	}
+}
+
+func (r *apiTokenListResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClients, ok := req.ProviderData.(models.ApiClientsModel)
+	if !ok {
+		utils.ResourceApiClientConfigureError(ctx, req, resp)
+		return
+	}
+
+	r.IamClient = apiClients.IamClient
+	r.OrganizationId = apiClients.OrganizationId
+}
+
+func (r *apiTokenListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var config models.ApiTokensListConfig
+
+	// Read Terraform configuration data into the model
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	params := &iam.ListApiTokensParams{
+		WorkspaceId:                   config.WorkspaceId.ValueStringPointer(),
+		DeploymentId:                  config.DeploymentId.ValueStringPointer(),
+		IncludeOnlyOrganizationTokens: config.IncludeOnlyOrganizationTokens.ValueBoolPointer(),
+		DagId:                         config.DagId.ValueStringPointer(),
+		Limit:                         lo.ToPtr(listPageLimit),
+	}
+	if len(config.DagTags) > 0 {
+		params.DagTags = &config.DagTags
+	}
+	if !config.Kind.IsNull() {
+		params.Kind = (*iam.ListApiTokensParamsKind)(config.Kind.ValueStringPointer())
+	}
+
+	listPage := func(ctx context.Context, offset int) ([]iam.ApiToken, int, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		params.Offset = &offset
+		apiTokensResp, err := r.IamClient.ListApiTokensWithResponse(ctx, r.OrganizationId, params)
+		if err != nil {
+			tflog.Error(ctx, "failed to list API tokens", map[string]interface{}{"error": err})
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to list API tokens, got error: %s", err),
+			)
+			return nil, 0, diags
+		}
+		_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, apiTokensResp.HTTPResponse, apiTokensResp.Body, apiTokensResp.JSON200, "list API tokens")
+		if diagnostic != nil {
+			diags.Append(diagnostic)
+			return nil, 0, diags
+		}
+		return apiTokensResp.JSON200.Tokens, apiTokensResp.JSON200.TotalCount, diags
+	}
+
+	stream.Results = listResults(ctx, req, r.OrganizationId, apiTokenIdentity, listPage,
+		func(ctx context.Context, apiToken iam.ApiToken, state *tfsdk.Resource) (string, diag.Diagnostics) {
+			var data models.ApiTokenResource
+			diags := data.ReadFromResponse(ctx, &apiToken, "")
+			if diags.HasError() {
+				return "", diags
+			}
+			return apiToken.Name, state.Set(ctx, &data)
+		},
+	)
+}
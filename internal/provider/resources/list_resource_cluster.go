@@ -0,0 +1,138 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &clusterListResource{}
+var _ list.ListResourceWithConfigure = &clusterListResource{}
+
+func NewClusterListResource() list.ListResource {
+	return &clusterListResource{}
+}
+
+// clusterListResource lists the clusters of the organization as astro_cluster resources.
+type clusterListResource struct {
+	platformClient *platform.ClientWithResponses
+	organizationId string
+}
+
+func (r *clusterListResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cluster"
+}
+
+func (r *clusterListResource) ListResourceConfigSchema(
+	ctx context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = listschema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Cluster list resource",
+		Attributes:          schemas.ClustersListResourceSchemaAttributes(),
+	}
+}
+
+func (r *clusterListResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClients, ok := req.ProviderData.(models.ApiClientsModel)
+	if !ok {
+		utils.ResourceApiClientConfigureError(ctx, req, resp)
+		return
+	}
+
+	r.platformClient = apiClients.PlatformClient
+	r.organizationId = apiClients.OrganizationId
+}
+
+func (r *clusterListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var config models.ClustersListConfig
+
+	// Read Terraform configuration data into the model
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	params := &platform.ListClustersParams{
+		Limit: lo.ToPtr(listPageLimit),
+	}
+	if !config.CloudProvider.IsNull() {
+		params.Provider = (*platform.ListClustersParamsProvider)(config.CloudProvider.ValueStringPointer())
+	}
+	if len(config.Names) > 0 {
+		params.Names = &config.Names
+	}
+
+	listPage := func(ctx context.Context, offset int) ([]platform.Cluster, int, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		params.Offset = &offset
+		clustersResp, err := r.platformClient.ListClustersWithResponse(ctx, r.organizationId, params)
+		if err != nil {
+			tflog.Error(ctx, "failed to list clusters", map[string]interface{}{"error": err})
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to list clusters, got error: %s", err),
+			)
+			return nil, 0, diags
+		}
+		_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, clustersResp.HTTPResponse, clustersResp.Body, clustersResp.JSON200, "list clusters")
+		if diagnostic != nil {
+			diags.Append(diagnostic)
+			return nil, 0, diags
+		}
+		return clustersResp.JSON200.Clusters, clustersResp.JSON200.TotalCount, diags
+	}
+
+	stream.Results = listResults(ctx, req, r.organizationId, clusterIdentity, listPage,
+		func(ctx context.Context, cluster platform.Cluster, state *tfsdk.Resource) (string, diag.Diagnostics) {
+			var data models.ClusterResource
+			diags := data.ReadFromResponse(ctx, &cluster)
+			if diags.HasError() {
+				return "", diags
+			}
+			// Timeouts are only configured, they are null like after an import
+			data.Timeouts = timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			})}
+			return cluster.Name, state.Set(ctx, &data)
+		},
+	)
+}
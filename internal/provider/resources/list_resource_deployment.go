@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &deploymentListResource{}
+var _ list.ListResourceWithConfigure = &deploymentListResource{}
+
+func NewDeploymentListResource() list.ListResource {
+	return &deploymentListResource{}
+}
+
+// deploymentListResource lists the deployments of the organization as astro_deployment resources.
+type deploymentListResource struct {
+	platformClient *platform.ClientWithResponses
+	organizationId string
+}
+
+func (r *deploymentListResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+func (r *deploymentListResource) ListResourceConfigSchema(
+	ctx context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = listschema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Deployment list resource",
+		Attributes:          schemas.DeploymentsListResourceSchemaAttributes(),
+	}
+}
+
+func (r *deploymentListResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClients, ok := req.ProviderData.(models.ApiClientsModel)
+	if !ok {
+		utils.ResourceApiClientConfigureError(ctx, req, resp)
+		return
+	}
+
+	r.platformClient = apiClients.PlatformClient
+	r.organizationId = apiClients.OrganizationId
+}
+
+func (r *deploymentListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var config models.DeploymentsListConfig
+
+	// Read Terraform configuration data into the model
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	params := &platform.ListDeploymentsParams{
+		Limit: lo.ToPtr(listPageLimit),
+	}
+	if len(config.DeploymentIds) > 0 {
+		params.DeploymentIds = &config.DeploymentIds
+	}
+	if len(config.WorkspaceIds) > 0 {
+		params.WorkspaceIds = &config.WorkspaceIds
+	}
+	if len(config.Names) > 0 {
+		params.Names = &config.Names
+	}
+
+	listPage := func(ctx context.Context, offset int) ([]platform.Deployment, int, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		params.Offset = &offset
+		deploymentsResp, err := r.platformClient.ListDeploymentsWithResponse(ctx, r.organizationId, params)
+		if err != nil {
+			tflog.Error(ctx, "failed to list deployments", map[string]interface{}{"error": err})
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to list deployments, got error: %s", err),
+			)
+			return nil, 0, diags
+		}
+		_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, deploymentsResp.HTTPResponse, deploymentsResp.Body, deploymentsResp.JSON200, "list deployments")
+		if diagnostic != nil {
+			diags.Append(diagnostic)
+			return nil, 0, diags
+		}
+		return deploymentsResp.JSON200.Deployments, deploymentsResp.JSON200.TotalCount, diags
+	}
+
+	stream.Results = listResults(ctx, req, r.organizationId, deploymentIdentity, listPage,
+		func(ctx context.Context, deployment platform.Deployment, state *tfsdk.Resource) (string, diag.Diagnostics) {
+			var data models.DeploymentResource
+			diags := data.ReadFromResponse(ctx, &deployment, nil, nil)
+			if diags.HasError() {
+				return "", diags
+			}
+			return deployment.Name, state.Set(ctx, &data)
+		},
+	)
+}
@@ -0,0 +1,129 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &environmentObjectListResource{}
+var _ list.ListResourceWithConfigure = &environmentObjectListResource{}
+
+func NewEnvironmentObjectListResource() list.ListResource {
+	return &environmentObjectListResource{}
+}
+
+// environmentObjectListResource lists the environment objects of the organization as astro_environment_object resources.
+type environmentObjectListResource struct {
+	platformV1Client *platform_v1.ClientWithResponses
+	organizationId   string
+}
+
+func (r *environmentObjectListResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_environment_object"
+}
+
+func (r *environmentObjectListResource) ListResourceConfigSchema(
+	ctx context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = listschema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Environment object list resource",
+		Attributes:          schemas.EnvironmentObjectsListResourceSchemaAttributes(),
+	}
+}
+
+func (r *environmentObjectListResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClients, ok := req.ProviderData.(models.ApiClientsModel)
+	if !ok {
+		utils.ResourceApiClientConfigureError(ctx, req, resp)
+		return
+	}
+
+	r.platformV1Client = apiClients.PlatformV1Client
+	r.organizationId = apiClients.OrganizationId
+}
+
+func (r *environmentObjectListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var config models.EnvironmentObjectsListConfig
+
+	// Read Terraform configuration data into the model
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	params := &platform_v1.ListEnvironmentObjectsParams{
+		WorkspaceId:  config.WorkspaceId.ValueStringPointer(),
+		DeploymentId: config.DeploymentId.ValueStringPointer(),
+		ObjectKey:    config.ObjectKey.ValueStringPointer(),
+		Limit:        lo.ToPtr(listPageLimit),
+	}
+	if !config.ObjectType.IsNull() {
+		params.ObjectType = (*platform_v1.ListEnvironmentObjectsParamsObjectType)(config.ObjectType.ValueStringPointer())
+	}
+
+	listPage := func(ctx context.Context, offset int) ([]platform_v1.EnvironmentObject, int, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		params.Offset = &offset
+		environmentObjectsResp, err := r.platformV1Client.ListEnvironmentObjectsWithResponse(ctx, r.organizationId, params)
+		if err != nil {
+			tflog.Error(ctx, "failed to list environment objects", map[string]interface{}{"error": err})
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to list environment objects, got error: %s", err),
+			)
+			return nil, 0, diags
+		}
+		_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, environmentObjectsResp.HTTPResponse, environmentObjectsResp.Body, environmentObjectsResp.JSON200, "list environment objects")
+		if diagnostic != nil {
+			diags.Append(diagnostic)
+			return nil, 0, diags
+		}
+		return environmentObjectsResp.JSON200.EnvironmentObjects, environmentObjectsResp.JSON200.TotalCount, diags
+	}
+
+	stream.Results = listResults(ctx, req, r.organizationId, environmentObjectIdentity, listPage,
+		func(ctx context.Context, environmentObject platform_v1.EnvironmentObject, state *tfsdk.Resource) (string, diag.Diagnostics) {
+			var data models.EnvironmentObject
+			diags := data.ReadFromResponse(ctx, &environmentObject, nil)
+			if diags.HasError() {
+				return "", diags
+			}
+			return environmentObject.ObjectKey, state.Set(ctx, &data)
+		},
+	)
+}
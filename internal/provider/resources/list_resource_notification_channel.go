@@ -0,0 +1,141 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &notificationChannelListResource{}
+var _ list.ListResourceWithConfigure = &notificationChannelListResource{}
+
+func NewNotificationChannelListResource() list.ListResource {
+	return &notificationChannelListResource{}
+}
+
+// notificationChannelListResource lists the notification channels of the organization as astro_notification_channel resources.
+type notificationChannelListResource struct {
+	platformClient *platform.ClientWithResponses
+	organizationId string
+}
+
+func (r *notificationChannelListResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_notification_channel"
+}
+
+func (r *notificationChannelListResource) ListResourceConfigSchema(
+	ctx context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = listschema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Notification channel list resource",
+		Attributes:          schemas.NotificationChannelsListResourceSchemaAttributes(),
+	}
+}
+
+func (r *notificationChannelListResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClients, ok := req.ProviderData.(models.ApiClientsModel)
+	if !ok {
+		utils.ResourceApiClientConfigureError(ctx, req, resp)
+		return
+	}
+
+	r.platformClient = apiClients.PlatformClient
+	r.organizationId = apiClients.OrganizationId
+}
+
+func (r *notificationChannelListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var config models.NotificationChannelsListConfig
+
+	// Read Terraform configuration data into the model
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	params := &platform.ListNotificationChannelsParams{
+		Limit: lo.ToPtr(listPageLimit),
+	}
+	if len(config.NotificationChannelIds) > 0 {
+		params.NotificationChannelIds = &config.NotificationChannelIds
+	}
+	if len(config.ChannelTypes) > 0 {
+		channelTypes := lo.Map(config.ChannelTypes, func(channelType string, _ int) platform.ListNotificationChannelsParamsChannelTypes {
+			return platform.ListNotificationChannelsParamsChannelTypes(channelType)
+		})
+		params.ChannelTypes = &channelTypes
+	}
+	if !config.EntityType.IsNull() {
+		params.EntityType = (*platform.ListNotificationChannelsParamsEntityType)(config.EntityType.ValueStringPointer())
+	}
+	if len(config.DeploymentIds) > 0 {
+		params.DeploymentIds = &config.DeploymentIds
+	}
+	if len(config.WorkspaceIds) > 0 {
+		params.WorkspaceIds = &config.WorkspaceIds
+	}
+
+	listPage := func(ctx context.Context, offset int) ([]platform.NotificationChannel, int, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		params.Offset = &offset
+		notificationChannelsResp, err := r.platformClient.ListNotificationChannelsWithResponse(ctx, r.organizationId, params)
+		if err != nil {
+			tflog.Error(ctx, "failed to list notification channels", map[string]interface{}{"error": err})
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to list notification channels, got error: %s", err),
+			)
+			return nil, 0, diags
+		}
+		_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, notificationChannelsResp.HTTPResponse, notificationChannelsResp.Body, notificationChannelsResp.JSON200, "list notification channels")
+		if diagnostic != nil {
+			diags.Append(diagnostic)
+			return nil, 0, diags
+		}
+		return notificationChannelsResp.JSON200.NotificationChannels, notificationChannelsResp.JSON200.TotalCount, diags
+	}
+
+	stream.Results = listResults(ctx, req, r.organizationId, notificationChannelIdentity, listPage,
+		func(ctx context.Context, notificationChannel platform.NotificationChannel, state *tfsdk.Resource) (string, diag.Diagnostics) {
+			var data models.NotificationChannelResource
+			diags := data.ReadFromResponse(ctx, &notificationChannel)
+			if diags.HasError() {
+				return "", diags
+			}
+			return notificationChannel.Name, state.Set(ctx, &data)
+		},
+	)
+}
@@ -0,0 +1,126 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &teamListResource{}
+var _ list.ListResourceWithConfigure = &teamListResource{}
+
+func NewTeamListResource() list.ListResource {
+	return &teamListResource{}
+}
+
+// teamListResource lists the teams of the organization as astro_team resources.
+type teamListResource struct {
+	IamClient      *iam.ClientWithResponses
+	OrganizationId string
+}
+
+func (r *teamListResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (r *teamListResource) ListResourceConfigSchema(
+	ctx context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = listschema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Team list resource",
+		Attributes:          schemas.TeamsListResourceSchemaAttributes(),
+	}
+}
+
+func (r *teamListResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClients, ok := req.ProviderData.(models.ApiClientsModel)
+	if !ok {
+		utils.ResourceApiClientConfigureError(ctx, req, resp)
+		return
+	}
+
+	r.IamClient = apiClients.IamClient
+	r.OrganizationId = apiClients.OrganizationId
+}
+
+func (r *teamListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var config models.TeamsListConfig
+
+	// Read Terraform configuration data into the model
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	params := &iam.ListTeamsParams{
+		Limit: lo.ToPtr(listPageLimit),
+	}
+	if len(config.Names) > 0 {
+		params.Names = &config.Names
+	}
+
+	listPage := func(ctx context.Context, offset int) ([]iam.Team, int, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		params.Offset = &offset
+		teamsResp, err := r.IamClient.ListTeamsWithResponse(ctx, r.OrganizationId, params)
+		if err != nil {
+			tflog.Error(ctx, "failed to list teams", map[string]interface{}{"error": err})
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to list teams, got error: %s", err),
+			)
+			return nil, 0, diags
+		}
+		_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, teamsResp.HTTPResponse, teamsResp.Body, teamsResp.JSON200, "list teams")
+		if diagnostic != nil {
+			diags.Append(diagnostic)
+			return nil, 0, diags
+		}
+		return teamsResp.JSON200.Teams, teamsResp.JSON200.TotalCount, diags
+	}
+
+	stream.Results = listResults(ctx, req, r.OrganizationId, teamIdentity, listPage,
+		func(ctx context.Context, team iam.Team, state *tfsdk.Resource) (string, diag.Diagnostics) {
+			var data models.TeamResource
+			diags := data.ReadFromResponse(ctx, &team, nil)
+			if diags.HasError() {
+				return "", diags
+			}
+			return team.Name, state.Set(ctx, &data)
+		},
+	)
+}
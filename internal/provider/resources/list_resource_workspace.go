@@ -0,0 +1,129 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &workspaceListResource{}
+var _ list.ListResourceWithConfigure = &workspaceListResource{}
+
+func NewWorkspaceListResource() list.ListResource {
+	return &workspaceListResource{}
+}
+
+// workspaceListResource lists the workspaces of the organization as astro_workspace resources.
+type workspaceListResource struct {
+	platformClient *platform.ClientWithResponses
+	organizationId string
+}
+
+func (r *workspaceListResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_workspace"
+}
+
+func (r *workspaceListResource) ListResourceConfigSchema(
+	ctx context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = listschema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Workspace list resource",
+		Attributes:          schemas.WorkspacesListResourceSchemaAttributes(),
+	}
+}
+
+func (r *workspaceListResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClients, ok := req.ProviderData.(models.ApiClientsModel)
+	if !ok {
+		utils.ResourceApiClientConfigureError(ctx, req, resp)
+		return
+	}
+
+	r.platformClient = apiClients.PlatformClient
+	r.organizationId = apiClients.OrganizationId
+}
+
+func (r *workspaceListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var config models.WorkspacesListConfig
+
+	// Read Terraform configuration data into the model
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	params := &platform.ListWorkspacesParams{
+		Limit: lo.ToPtr(listPageLimit),
+	}
+	if len(config.WorkspaceIds) > 0 {
+		params.WorkspaceIds = &config.WorkspaceIds
+	}
+	if len(config.Names) > 0 {
+		params.Names = &config.Names
+	}
+
+	listPage := func(ctx context.Context, offset int) ([]platform.Workspace, int, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		params.Offset = &offset
+		workspacesResp, err := r.platformClient.ListWorkspacesWithResponse(ctx, r.organizationId, params)
+		if err != nil {
+			tflog.Error(ctx, "failed to list workspaces", map[string]interface{}{"error": err})
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to list workspaces, got error: %s", err),
+			)
+			return nil, 0, diags
+		}
+		_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, workspacesResp.HTTPResponse, workspacesResp.Body, workspacesResp.JSON200, "list workspaces")
+		if diagnostic != nil {
+			diags.Append(diagnostic)
+			return nil, 0, diags
+		}
+		return workspacesResp.JSON200.Workspaces, workspacesResp.JSON200.TotalCount, diags
+	}
+
+	stream.Results = listResults(ctx, req, r.organizationId, workspaceIdentity, listPage,
+		func(ctx context.Context, workspace platform.Workspace, state *tfsdk.Resource) (string, diag.Diagnostics) {
+			var data models.WorkspaceResource
+			diags := data.ReadFromResponse(ctx, &workspace)
+			if diags.HasError() {
+				return "", diags
+			}
+			return workspace.Name, state.Set(ctx, &data)
+		},
+	)
+}
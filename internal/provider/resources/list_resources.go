@@ -0,0 +1,68 @@
+package resources
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// The list resources let `terraform query` list the Astro objects of the organization, filtered like the data source
+// listing them, so that Terraform generates the import blocks and configuration of the objects it does not manage
+// yet. A list result has the identity of the resource, see resourceIdentity, and its state when the query includes
+// the resources, read like after an import.
+
+// listPageLimit is the number of objects requested per page by the list resources
+const listPageLimit = 1000
+
+// listPageFunc returns the objects listed from offset and the total count of objects
+type listPageFunc[T any] func(ctx context.Context, offset int) (objects []T, totalCount int, diags diag.Diagnostics)
+
+// listResults returns the results of the objects listed a page at a time by listPage, until all the objects are
+// listed, the limit of the query is reached or Terraform stops reading the results. read reads an object into the
+// resource data of its result and returns the display name of the object.
+func listResults[T any](
+	ctx context.Context,
+	req list.ListRequest,
+	organizationId string,
+	identity resourceIdentity,
+	listPage listPageFunc[T],
+	read func(ctx context.Context, object T, resource *tfsdk.Resource) (displayName string, diags diag.Diagnostics),
+) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		var count int64
+		offset := 0
+		for {
+			objects, totalCount, diags := listPage(ctx, offset)
+			if diags.HasError() {
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+			for _, object := range objects {
+				result := req.NewListResult(ctx)
+				result.DisplayName, result.Diagnostics = read(ctx, object, result.Resource)
+				if !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(identity.Set(ctx, organizationId, *result.Resource, result.Identity)...)
+				}
+				if !req.IncludeResource {
+					result.Resource = nil
+				}
+				if !push(result) {
+					return
+				}
+				count++
+				if req.Limit > 0 && count >= req.Limit {
+					return
+				}
+			}
+
+			// Advance by the page length, an empty page ends the list even if the total count is higher
+			offset += len(objects)
+			if len(objects) == 0 || offset >= totalCount {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package resources
+
+import (
+	"context"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+	"github.com/astronomer/terraform-provider-astro/internal/fakeapi"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listResourceResults lists the resources of the list resource newListResource of the managed resource r, with the
+// list configuration attributes set to config and the other attributes null
+func listResourceResults(
+	t *testing.T,
+	ctx context.Context,
+	apiClients models.ApiClientsModel,
+	r resource.ResourceWithIdentity,
+	newListResource func() list.ListResource,
+	config map[string]attr.Value,
+	includeResource bool,
+	limit int64,
+) []list.ListResult {
+	t.Helper()
+	listResource := newListResource()
+	var configureResp resource.ConfigureResponse
+	listResource.(list.ListResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: apiClients}, &configureResp)
+	require.False(t, configureResp.Diagnostics.HasError(), configureResp.Diagnostics)
+
+	var configSchemaResp list.ListResourceSchemaResponse
+	listResource.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchemaResp)
+	// Terraform sends the configuration with the attributes that are not set null
+	configState := &tfsdk.State{Schema: configSchemaResp.Schema, Raw: utils.TestObjectValue(ctx, configSchemaResp.Schema.Type(), nil)}
+	diags := setAttributes(ctx, configState, config)
+	require.False(t, diags.HasError(), diags)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identityResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	stream := &list.ListResultsStream{}
+	listResource.List(ctx, list.ListRequest{
+		Config:                 tfsdk.Config{Schema: configSchemaResp.Schema, Raw: configState.Raw},
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identityResp.IdentitySchema,
+	}, stream)
+	return slices.Collect(stream.Results)
+}
+
+// resultIds returns the id identity attribute of the results
+func resultIds(t *testing.T, ctx context.Context, results []list.ListResult) []string {
+	t.Helper()
+	ids := make([]string, len(results))
+	for i, result := range results {
+		require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+		var id types.String
+		diags := result.Identity.GetAttribute(ctx, path.Root("id"), &id)
+		require.False(t, diags.HasError(), diags)
+		ids[i] = id.ValueString()
+	}
+	return ids
+}
+
+func TestUnit_ListResources(t *testing.T) {
+	ctx := context.Background()
+	server := fakeapi.NewTestServer(t)
+	env, orgId := server.Env, server.OrganizationId
+	apiClients := models.ApiClientsModel{
+		OrganizationId:   orgId,
+		PlatformClient:   server.PlatformClient,
+		PlatformV1Client: server.PlatformV1Client,
+		IamClient:        server.IamClient,
+	}
+
+	created, err := server.PlatformV1Client.CreateEnvironmentObjectWithResponse(ctx, orgId, platform_v1.CreateEnvironmentObjectRequest{
+		ObjectKey:       "list_variable",
+		ObjectType:      platform_v1.CreateEnvironmentObjectRequestObjectTypeAIRFLOWVARIABLE,
+		Scope:           platform_v1.CreateEnvironmentObjectRequestScopeWORKSPACE,
+		ScopeEntityId:   env["HOSTED_WORKSPACE_ID"],
+		AirflowVariable: &platform_v1.CreateEnvironmentObjectAirflowVariableRequest{Value: lo.ToPtr("value")},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, created.JSON200)
+
+	t.Run("lists the resources with their identity and state", func(t *testing.T) {
+		for _, test := range []struct {
+			resource        resource.ResourceWithIdentity
+			newListResource func() list.ListResource
+			id              string
+		}{
+			{&workspaceResource{}, NewWorkspaceListResource, env["HOSTED_WORKSPACE_ID"]},
+			{&DeploymentResource{}, NewDeploymentListResource, env["HOSTED_DEPLOYMENT_ID"]},
+			{&ClusterResource{}, NewClusterListResource, env["HOSTED_DEDICATED_CLUSTER_ID"]},
+			{&TeamResource{}, NewTeamListResource, env["HOSTED_TEAM_ID"]},
+			{&ApiTokenResource{}, NewApiTokenListResource, env["HOSTED_API_TOKEN_ID"]},
+			{&alertResource{}, NewAlertListResource, env["HOSTED_ALERT_ID"]},
+			{&notificationChannelResource{}, NewNotificationChannelListResource, env["HOSTED_NOTIFICATION_CHANNEL_ID"]},
+			{&environmentObjectResource{}, NewEnvironmentObjectListResource, created.JSON200.Id},
+		} {
+			var metadataResp resource.MetadataResponse
+			test.newListResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "astro"}, &metadataResp)
+			t.Run(metadataResp.TypeName, func(t *testing.T) {
+				var resourceMetadataResp resource.MetadataResponse
+				test.resource.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "astro"}, &resourceMetadataResp)
+				assert.Equal(t, resourceMetadataResp.TypeName, metadataResp.TypeName)
+
+				results := listResourceResults(t, ctx, apiClients, test.resource, test.newListResource, nil, true, 0)
+				ids := resultIds(t, ctx, results)
+				require.Contains(t, ids, test.id)
+
+				result := results[slices.Index(ids, test.id)]
+				assert.NotEmpty(t, result.DisplayName)
+				var organizationId, id types.String
+				diags := result.Identity.GetAttribute(ctx, path.Root("organization_id"), &organizationId)
+				require.False(t, diags.HasError(), diags)
+				assert.Equal(t, orgId, organizationId.ValueString())
+				require.NotNil(t, result.Resource)
+				diags = result.Resource.GetAttribute(ctx, path.Root("id"), &id)
+				require.False(t, diags.HasError(), diags)
+				assert.Equal(t, test.id, id.ValueString())
+			})
+		}
+	})
+
+	t.Run("filters like the data source", func(t *testing.T) {
+		results := listResourceResults(t, ctx, apiClients, &DeploymentResource{}, NewDeploymentListResource, map[string]attr.Value{
+			"names": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("acceptance-tests-standard")}),
+		}, false, 0)
+		assert.Equal(t, []string{env["HOSTED_STANDARD_DEPLOYMENT_ID"]}, resultIds(t, ctx, results))
+
+		results = listResourceResults(t, ctx, apiClients, &notificationChannelResource{}, NewNotificationChannelListResource, map[string]attr.Value{
+			"channel_types": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("EMAIL")}),
+		}, false, 0)
+		assert.Equal(t, []string{env["HOSTED_NOTIFICATION_CHANNEL_ID"]}, resultIds(t, ctx, results))
+
+		results = listResourceResults(t, ctx, apiClients, &environmentObjectResource{}, NewEnvironmentObjectListResource, map[string]attr.Value{
+			"object_type": types.StringValue(string(platform_v1.CreateEnvironmentObjectRequestObjectTypeAIRFLOWVARIABLE)),
+			"object_key":  types.StringValue("list_variable"),
+		}, false, 0)
+		assert.Equal(t, []string{created.JSON200.Id}, resultIds(t, ctx, results))
+	})
+
+	t.Run("omits the resource unless the query includes it", func(t *testing.T) {
+		results := listResourceResults(t, ctx, apiClients, &workspaceResource{}, NewWorkspaceListResource, nil, false, 0)
+		require.NotEmpty(t, results)
+		assert.Nil(t, results[0].Resource)
+		assert.NotNil(t, results[0].Identity)
+	})
+
+	t.Run("stops at the limit of the query", func(t *testing.T) {
+		results := listResourceResults(t, ctx, apiClients, &DeploymentResource{}, NewDeploymentListResource, nil, false, 2)
+		assert.Len(t, results, 2)
+	})
+
+	t.Run("reports an API error", func(t *testing.T) {
+		closed := httptest.NewServer(server)
+		closed.Close()
+		closedPlatformClient, err := platform.NewPlatformClient(closed.URL, env["HOSTED_ORGANIZATION_API_TOKEN"], "test")
+		require.NoError(t, err)
+		results := listResourceResults(t, ctx, models.ApiClientsModel{
+			OrganizationId: orgId,
+			PlatformClient: closedPlatformClient,
+		}, &workspaceResource{}, NewWorkspaceListResource, nil, false, 0)
+		require.Len(t, results, 1)
+		assert.True(t, results[0].Diagnostics.HasError())
+	})
+}
+
+func TestUnit_ListResults(t *testing.T) {
+	ctx := context.Background()
+	objects := []string{"a", "b", "c", "d", "e"}
+	var offsets []int
+	listPage := func(ctx context.Context, offset int) ([]string, int, diag.Diagnostics) {
+		offsets = append(offsets, offset)
+		return objects[offset:min(offset+2, len(objects))], len(objects), nil
+	}
+
+	var schemaResp resource.SchemaResponse
+	(&workspaceResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	req := list.ListRequest{
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: workspaceIdentity.Schema(),
+	}
+	read := func(ctx context.Context, object string, state *tfsdk.Resource) (string, diag.Diagnostics) {
+		return object, state.SetAttribute(ctx, path.Root("id"), object)
+	}
+
+	t.Run("lists every page", func(t *testing.T) {
+		offsets = nil
+		results := slices.Collect(listResults(ctx, req, testOrganizationId, workspaceIdentity, listPage, read))
+		assert.Equal(t, objects, resultIds(t, ctx, results))
+		assert.Equal(t, []int{0, 2, 4}, offsets)
+	})
+
+	t.Run("stops when Terraform stops reading the results", func(t *testing.T) {
+		offsets = nil
+		for result := range listResults(ctx, req, testOrganizationId, workspaceIdentity, listPage, read) {
+			if result.DisplayName == "b" {
+				break
+			}
+		}
+		assert.Equal(t, []int{0}, offsets)
+	})
+}
@@ -0,0 +1,12 @@
+package resources_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/astronomer/terraform-provider-astro/internal/fakeapi"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(fakeapi.Run(m))
+}
@@ -22,6 +22,13 @@ import (
 var _ resource.Resource = &AgentTokenResource{}
 var _ resource.ResourceWithImportState = &AgentTokenResource{}
 var _ resource.ResourceWithConfigure = &AgentTokenResource{}
+var _ resource.ResourceWithIdentity = &AgentTokenResource{}
+
+// agentTokenIdentity identifies an astro_agent_token by its deployment and ID
+var agentTokenIdentity = resourceIdentity{
+	{name: "deployment_id", description: "Deployment identifier"},
+	{name: "id", description: "Agent token identifier"},
+}
 
 func NewAgentTokenResource() resource.Resource {
 	return &AgentTokenResource{}
@@ -52,6 +59,14 @@ func (r *AgentTokenResource) Schema(
 	}
 }
 
+func (r *AgentTokenResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = agentTokenIdentity.Schema()
+}
+
 func (r *AgentTokenResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -125,6 +140,7 @@ func (r *AgentTokenResource) Create(
 	tflog.Trace(ctx, fmt.Sprintf("created an agent token resource: %v", data.Id.ValueString()))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(agentTokenIdentity.Set(ctx, r.OrganizationId, resp.State, resp.Identity)...)
 }
 
 func (r *AgentTokenResource) Read(
@@ -138,6 +154,10 @@ func (r *AgentTokenResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(agentTokenIdentity.Set(ctx, r.OrganizationId, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	getResp, err := r.IamClient.GetAgentTokenWithResponse(
 		ctx,
@@ -224,11 +244,16 @@ func (r *AgentTokenResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	parts := strings.SplitN(req.ID, "/", 2)
+	id, diags := agentTokenIdentity.ImportID(ctx, r.OrganizationId, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	parts := strings.SplitN(id, "/", 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Invalid import ID",
-			fmt.Sprintf("Expected format: <deployment_id>/<token_id>, got: %q", req.ID),
+			fmt.Sprintf("Expected format: <deployment_id>/<token_id>, got: %q", id),
 		)
 		return
 	}
@@ -0,0 +1,274 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &airflowVariableResource{}
+var _ resource.ResourceWithImportState = &airflowVariableResource{}
+var _ resource.ResourceWithConfigure = &airflowVariableResource{}
+var _ resource.ResourceWithValidateConfig = &airflowVariableResource{}
+var _ resource.ResourceWithMoveState = &airflowVariableResource{}
+var _ resource.ResourceWithIdentity = &airflowVariableResource{}
+
+// airflowVariableIdentity identifies an astro_airflow_variable by its ID
+var airflowVariableIdentity = resourceIdentity{
+	{name: "id", description: "Airflow variable identifier"},
+}
+
+func NewAirflowVariableResource() resource.Resource {
+	return &airflowVariableResource{}
+}
+
+// airflowVariableResource defines the resource implementation.
+type airflowVariableResource struct {
+	platformClient   *platform.ClientWithResponses
+	platformV1Client *platform_v1.ClientWithResponses
+	organizationId   string
+}
+
+func (r *airflowVariableResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_airflow_variable"
+}
+
+func (r *airflowVariableResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Airflow variable resource. Manages an Airflow variable scoped to a Workspace or Deployment, an environment object with `object_type=AIRFLOW_VARIABLE`.",
+		Attributes:          schemas.AirflowVariableResourceSchemaAttributes(),
+	}
+}
+
+func (r *airflowVariableResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = airflowVariableIdentity.Schema()
+}
+
+func (r *airflowVariableResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClients, ok := req.ProviderData.(models.ApiClientsModel)
+	if !ok {
+		utils.ResourceApiClientConfigureError(ctx, req, resp)
+		return
+	}
+
+	r.platformClient = apiClients.PlatformClient
+	r.platformV1Client = apiClients.PlatformV1Client
+	r.organizationId = apiClients.OrganizationId
+}
+
+func (r *airflowVariableResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data models.AirflowVariable
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envObj, diags := data.EnvironmentObject(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(createEnvironmentObject(ctx, r.platformV1Client, r.organizationId, &envObj, &resp.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.ReadFromEnvironmentObject(ctx, &envObj)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created an Airflow variable resource: %v", data.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(airflowVariableIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
+}
+
+func (r *airflowVariableResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data models.AirflowVariable
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(airflowVariableIdentity.Set(ctx, r.organizationId, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envObj, diags := data.EnvironmentObject(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := readEnvironmentObject(ctx, r.platformV1Client, r.organizationId, &envObj)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(validateEnvironmentObjectType(&envObj, platform_v1.CreateEnvironmentObjectRequestObjectTypeAIRFLOWVARIABLE, "astro_airflow_variable")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.ReadFromEnvironmentObject(ctx, &envObj)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("read an Airflow variable resource: %v", data.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *airflowVariableResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data models.AirflowVariable
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envObj, diags := data.EnvironmentObject(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateEnvironmentObject(ctx, r.platformV1Client, r.organizationId, &envObj)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.ReadFromEnvironmentObject(ctx, &envObj)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated an Airflow variable resource: %v", data.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(airflowVariableIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
+}
+
+func (r *airflowVariableResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data models.AirflowVariable
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(deleteEnvironmentObject(ctx, r.platformV1Client, r.organizationId, data.Id.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted an Airflow variable resource: %v", data.Id.ValueString()))
+}
+
+func (r *airflowVariableResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	importStateByName(ctx, airflowVariableIdentity, r.organizationId, path.Root("id"), req, resp, func(ctx context.Context, id string) (string, diag.Diagnostics) {
+		return resolveEnvironmentObjectImportId(ctx, r.platformClient, r.platformV1Client, r.organizationId, id, "airflow_variable", lo.ToPtr(platform_v1.AIRFLOWVARIABLE))
+	})
+}
+
+func (r *airflowVariableResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		environmentObjectStateMover(platform_v1.CreateEnvironmentObjectRequestObjectTypeAIRFLOWVARIABLE,
+			func(ctx context.Context, envObj *models.EnvironmentObject, state *tfsdk.State) diag.Diagnostics {
+				var data models.AirflowVariable
+				diags := data.ReadFromEnvironmentObject(ctx, envObj)
+				if diags.HasError() {
+					return diags
+				}
+				return state.Set(ctx, &data)
+			}),
+	}
+}
+
+// ValidateConfig validates the scope of the links, with the same rules as astro_environment_object.
+func (r *airflowVariableResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data models.AirflowVariable
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envObj, diags := data.EnvironmentObject(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateScopeFields(&envObj)...)
+}
@@ -0,0 +1,78 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	astronomerprovider "github.com/astronomer/terraform-provider-astro/internal/provider"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceAirflowVariable(t *testing.T) {
+	namePrefix := utils.GenerateTestResourceName(10)
+	varKey := fmt.Sprintf("test_var_%v", namePrefix)
+	deploymentId := os.Getenv("HOSTED_DEPLOYMENT_ID")
+	resourceVar := "astro_airflow_variable.test"
+
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { astronomerprovider.TestAccPreCheck(t) },
+		CheckDestroy:             testAccCheckEnvironmentObjectDestroyed(t, varKey),
+		Steps: []resource.TestStep{
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + airflowVariable("test", varKey, "DEPLOYMENT", deploymentId, "initial_value", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentObjectExists(t, varKey),
+					resource.TestCheckResourceAttr(resourceVar, "key", varKey),
+					resource.TestCheckResourceAttr(resourceVar, "scope", "DEPLOYMENT"),
+					resource.TestCheckResourceAttr(resourceVar, "scope_entity_id", deploymentId),
+					resource.TestCheckResourceAttr(resourceVar, "value", "initial_value"),
+					resource.TestCheckResourceAttr(resourceVar, "is_secret", "false"),
+					resource.TestCheckResourceAttrSet(resourceVar, "id"),
+					captureResourceID(resourceVar, &id),
+				),
+			},
+			// Update value in place
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + airflowVariable("test", varKey, "DEPLOYMENT", deploymentId, "updated_value", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentObjectExists(t, varKey),
+					resource.TestCheckResourceAttr(resourceVar, "value", "updated_value"),
+					checkResourceIDUnchanged(resourceVar, &id),
+				),
+			},
+			// Toggle is_secret, which forces a replacement; the secret value is kept from the config
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + airflowVariable("test", varKey, "DEPLOYMENT", deploymentId, "secret_value", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentObjectExists(t, varKey),
+					resource.TestCheckResourceAttr(resourceVar, "is_secret", "true"),
+					resource.TestCheckResourceAttr(resourceVar, "value", "secret_value"),
+				),
+			},
+			// Import
+			{
+				ResourceName:            resourceVar,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+		},
+	})
+}
+
+func airflowVariable(tfName, varKey, scope, scopeEntityId, value string, isSecret bool) string {
+	return fmt.Sprintf(`
+resource "astro_airflow_variable" "%s" {
+  key             = "%s"
+  scope           = "%s"
+  scope_entity_id = "%s"
+
+  value     = "%s"
+  is_secret = %t
+}
+`, tfName, varKey, scope, scopeEntityId, value, isSecret)
+}
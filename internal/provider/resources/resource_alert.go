@@ -10,6 +10,7 @@ import (
 	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
 	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
 	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,6 +21,13 @@ import (
 var _ resource.Resource = &alertResource{}
 var _ resource.ResourceWithImportState = &alertResource{}
 var _ resource.ResourceWithConfigure = &alertResource{}
+var _ resource.ResourceWithMoveState = &alertResource{}
+var _ resource.ResourceWithIdentity = &alertResource{}
+
+// alertIdentity identifies an astro_alert by its ID
+var alertIdentity = resourceIdentity{
+	{name: "id", description: "Alert identifier"},
+}
 
 func NewAlertResource() resource.Resource {
 	return &alertResource{}
@@ -57,6 +65,14 @@ func (r *alertResource) Schema(
 	}
 }
 
+func (r *alertResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = alertIdentity.Schema()
+}
+
 func (r *alertResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -120,6 +136,7 @@ func (r *alertResource) Create(
 
 	// Save to state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(alertIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *alertResource) Read(
@@ -132,6 +149,10 @@ func (r *alertResource) Read(
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(alertIdentity.Set(ctx, r.organizationId, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -217,6 +238,7 @@ func (r *alertResource) Update(
 
 	// Save to state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(alertIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *alertResource) Delete(
@@ -262,5 +284,13 @@ func (r *alertResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, alertIdentity, r.organizationId, path.Root("id"), req, resp, func(ctx context.Context, id string) (string, diag.Diagnostics) {
+		return resolveAlertImportId(ctx, r.platformClient, r.organizationId, id)
+	})
+}
+
+func (r *alertResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		alertsToAlertStateMover(),
+	}
 }
@@ -32,6 +32,7 @@ const (
 var (
 	_ resource.Resource              = &alertsResource{}
 	_ resource.ResourceWithConfigure = &alertsResource{}
+	_ resource.ResourceWithMoveState = &alertsResource{}
 )
 
 func NewAlertsResource() resource.Resource {
@@ -290,6 +291,14 @@ func (r *alertsResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	resp.Diagnostics.Append(r.bulkDelete(ctx, ids)...)
 }
 
+// MoveState moves an astro_alert to the alerts map, keyed by the alert name. Key the alert by its name in the
+// configuration, otherwise the next apply deletes and recreates it under the configured key.
+func (r *alertsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		alertToAlertsStateMover(),
+	}
+}
+
 // refreshState fetches the current server state for the alerts identified by keyToId and maps each
 // back to its key. Alerts that no longer exist server-side are dropped from the result.
 func (r *alertsResource) refreshState(ctx context.Context, keyToId map[string]string) (map[string]models.AlertsResourceElementModel, diag.Diagnostics) {
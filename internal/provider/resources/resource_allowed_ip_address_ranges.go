@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/netip"
 	"strings"
 
 	"github.com/astronomer/terraform-provider-astro/internal/clients"
@@ -30,11 +31,17 @@ const (
 )
 
 var (
-	_ resource.Resource                = &allowedIpAddressRangesResource{}
-	_ resource.ResourceWithConfigure   = &allowedIpAddressRangesResource{}
-	_ resource.ResourceWithImportState = &allowedIpAddressRangesResource{}
+	_ resource.Resource                   = &allowedIpAddressRangesResource{}
+	_ resource.ResourceWithConfigure      = &allowedIpAddressRangesResource{}
+	_ resource.ResourceWithImportState    = &allowedIpAddressRangesResource{}
+	_ resource.ResourceWithIdentity       = &allowedIpAddressRangesResource{}
+	_ resource.ResourceWithModifyPlan     = &allowedIpAddressRangesResource{}
+	_ resource.ResourceWithValidateConfig = &allowedIpAddressRangesResource{}
 )
 
+// allowedIpAddressRangesIdentity identifies the astro_allowed_ip_address_ranges of an organization by the organization alone
+var allowedIpAddressRangesIdentity = resourceIdentity{}
+
 func NewAllowedIpAddressRangesResource() resource.Resource {
 	return &allowedIpAddressRangesResource{}
 }
@@ -48,6 +55,8 @@ type allowedIpAddressRangesResource struct {
 	iamClient      *iam.ClientWithResponses
 	labsClient     *labs.ClientWithResponses
 	organizationId string
+	// egressIp returns the public IP address of the machine running Terraform, for the lockout check
+	egressIp func(ctx context.Context) (netip.Addr, error)
 }
 
 func (r *allowedIpAddressRangesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,7 +73,10 @@ func (r *allowedIpAddressRangesResource) Schema(ctx context.Context, req resourc
 			"runner's egress IP). Once enforcement is on, the API does **not** protect you from removing the range " +
 			"that covers your own IP: doing so - or narrowing/replacing it so your IP is no longer covered - locks " +
 			"you (and this provider) out and will fail the apply mid-way. Always keep a range covering the machine " +
-			"that runs Terraform.\n\n" +
+			"that runs Terraform. Plans that do not cover the public IP address of the machine running Terraform " +
+			"fail unless `allow_self_lockout` is set. To detect that IP address, plans that change the ranges make a " +
+			"request to `https://checkip.amazonaws.com` through the provider's HTTP client, with its `ca_cert_file`, " +
+			"`proxy_url` and `insecure_skip_verify` settings. When that request fails, the plan only warns.\n\n" +
 			"~> **Note** Do not manage the IP access list with more than one `astro_allowed_ip_address_ranges` " +
 			"resource. To adopt an access list that already exists, import it first (see below) rather than " +
 			"re-declaring it, otherwise the first apply will conflict with the existing ranges.",
@@ -72,6 +84,10 @@ func (r *allowedIpAddressRangesResource) Schema(ctx context.Context, req resourc
 	}
 }
 
+func (r *allowedIpAddressRangesResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = allowedIpAddressRangesIdentity.Schema()
+}
+
 func (r *allowedIpAddressRangesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -84,6 +100,9 @@ func (r *allowedIpAddressRangesResource) Configure(ctx context.Context, req reso
 	r.iamClient = apiClients.IamClient
 	r.labsClient = apiClients.LabsClient
 	r.organizationId = apiClients.OrganizationId
+	r.egressIp = func(ctx context.Context) (netip.Addr, error) {
+		return detectEgressIp(ctx, apiClients.HttpClient)
+	}
 }
 
 func (r *allowedIpAddressRangesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -110,7 +129,13 @@ func (r *allowedIpAddressRangesResource) Create(ctx context.Context, req resourc
 	// bits set, IPv6 form) would raise "Provider produced inconsistent result after apply" - the same
 	// class as GH #244/#314. Read reconciles any server-side drift on the next refresh.
 	data.Id = types.StringValue(r.organizationId)
+	data.IpAddressRangeIds, diags = r.rangeIds(ctx, cidrs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(allowedIpAddressRangesIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *allowedIpAddressRangesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -119,12 +144,22 @@ func (r *allowedIpAddressRangesResource) Read(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(allowedIpAddressRangesIdentity.Set(ctx, r.organizationId, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	result, diags := r.listAll(ctx)
+	ranges, diags := r.listAllRanges(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	result := make([]string, 0, len(ranges))
+	ids := make(map[string]string, len(ranges))
+	for _, rng := range ranges {
+		result = append(result, rng.IpAddressRange)
+		ids[rng.IpAddressRange] = rng.Id
+	}
 
 	setVal, d := utils.StringSet(&result)
 	resp.Diagnostics.Append(d...)
@@ -133,6 +168,23 @@ func (r *allowedIpAddressRangesResource) Read(ctx context.Context, req resource.
 	}
 	data.Id = types.StringValue(r.organizationId)
 	data.IpAddressRanges = setVal
+	data.IpAddressRangeIds, d = types.MapValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(d...)
+	// The API does not store descriptions, so only the descriptions of the ranges that still exist are kept
+	if !data.IpAddressRangeDescriptions.IsNull() && !data.IpAddressRangeDescriptions.IsUnknown() {
+		descriptions := make(map[string]string)
+		resp.Diagnostics.Append(data.IpAddressRangeDescriptions.ElementsAs(ctx, &descriptions, false)...)
+		for cidr := range descriptions {
+			if _, ok := ids[cidr]; !ok {
+				delete(descriptions, cidr)
+			}
+		}
+		data.IpAddressRangeDescriptions, d = types.MapValueFrom(ctx, types.StringType, descriptions)
+		resp.Diagnostics.Append(d...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -181,7 +233,16 @@ func (r *allowedIpAddressRangesResource) Update(ctx context.Context, req resourc
 	// Store the planned ranges verbatim (see Create) so state equals the Required config value and
 	// avoids an inconsistent-result error; Read reconciles server-side drift on the next refresh.
 	plan.Id = types.StringValue(r.organizationId)
+	// The range IDs are only unknown when the ranges change, see ModifyPlan
+	if plan.IpAddressRangeIds.IsUnknown() {
+		plan.IpAddressRangeIds, diags = r.rangeIds(ctx, planCidrs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(allowedIpAddressRangesIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *allowedIpAddressRangesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -209,12 +270,68 @@ func (r *allowedIpAddressRangesResource) Delete(ctx context.Context, req resourc
 	resp.Diagnostics.Append(r.bulkDelete(ctx, ids)...)
 }
 
+// ModifyPlan keeps the range IDs while the ranges are unchanged and refuses plans that would lock the machine running
+// Terraform out of the Astro API, see checkSelfLockout.
+func (r *allowedIpAddressRangesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destroying the resource empties the access list, which does not restrict access
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan models.AllowedIpAddressRangesResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state models.AllowedIpAddressRangesResource
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.IpAddressRanges.Equal(state.IpAddressRanges) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ip_address_range_ids"), state.IpAddressRangeIds)...)
+			return
+		}
+	}
+	resp.Diagnostics.Append(r.checkSelfLockout(ctx, plan)...)
+}
+
+// ValidateConfig checks that every description is keyed by a range of ip_address_ranges.
+func (r *allowedIpAddressRangesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.AllowedIpAddressRangesResource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.IpAddressRanges.IsUnknown() || data.IpAddressRangeDescriptions.IsNull() || data.IpAddressRangeDescriptions.IsUnknown() {
+		return
+	}
+	cidrs, diags := utils.TypesSetToStringSlice(ctx, data.IpAddressRanges)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ranges := make(map[string]bool, len(cidrs))
+	for _, c := range cidrs {
+		ranges[c] = true
+	}
+	for cidr := range data.IpAddressRangeDescriptions.Elements() {
+		if !ranges[cidr] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ip_address_range_descriptions").AtMapKey(cidr),
+				"Invalid Attribute Value",
+				fmt.Sprintf("%s has a description but is not in ip_address_ranges", cidr),
+			)
+		}
+	}
+}
+
 // ImportState imports the organization's existing IP access list. The resource is a singleton for
 // the organization configured on the provider, so the import ID is only cosmetic (use the
 // organization ID) - the subsequent Read populates ip_address_ranges from the API and sets id to
 // the organization ID regardless of the value passed here.
 func (r *allowedIpAddressRangesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	allowedIpAddressRangesIdentity.ImportStatePassthrough(ctx, r.organizationId, path.Root("id"), req, resp)
 }
 
 // bulkCreate chunks the given CIDRs by the API's per-request limit and creates them via the labs
@@ -296,6 +413,32 @@ func (r *allowedIpAddressRangesResource) listAll(ctx context.Context) ([]string,
 	return cidrs, diags
 }
 
+// rangeIds returns the range IDs of the given CIDRs keyed by CIDR. A CIDR the API stored in canonical form (host bits
+// cleared) is matched to the canonical range, so that the keys are the CIDRs of ip_address_ranges.
+func (r *allowedIpAddressRangesResource) rangeIds(ctx context.Context, cidrs []string) (types.Map, diag.Diagnostics) {
+	ranges, diags := r.listAllRanges(ctx)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), diags
+	}
+	byCidr := make(map[string]string, len(ranges))
+	for _, rng := range ranges {
+		byCidr[rng.IpAddressRange] = rng.Id
+	}
+	ids := make(map[string]string, len(cidrs))
+	for _, c := range cidrs {
+		if id, ok := byCidr[c]; ok {
+			ids[c] = id
+		} else if prefix, err := netip.ParsePrefix(c); err == nil {
+			if id, ok := byCidr[prefix.Masked().String()]; ok {
+				ids[c] = id
+			}
+		}
+	}
+	idsVal, d := types.MapValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	return idsVal, diags
+}
+
 // idsForCidrs looks up the range IDs for the given CIDRs via the list endpoint.
 func (r *allowedIpAddressRangesResource) idsForCidrs(ctx context.Context, cidrs []string) ([]string, diag.Diagnostics) {
 	ranges, diags := r.listAllRanges(ctx)
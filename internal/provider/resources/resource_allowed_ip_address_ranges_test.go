@@ -62,6 +62,8 @@ resource "astro_allowed_ip_address_ranges" "test" {
 					resource.TestCheckTypeSetElemAttr(resourceVar, "ip_address_ranges.*", runnerCidr),
 					resource.TestCheckTypeSetElemAttr(resourceVar, "ip_address_ranges.*", rangeA),
 					resource.TestCheckResourceAttrSet(resourceVar, "id"),
+					resource.TestCheckResourceAttr(resourceVar, "ip_address_range_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceVar, fmt.Sprintf("ip_address_range_ids.%s", rangeA)),
 				),
 			},
 			// Update: replace rangeA with rangeB while keeping the runner CIDR. Exercises
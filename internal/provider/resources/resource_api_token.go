@@ -27,6 +27,12 @@ import (
 var _ resource.Resource = &ApiTokenResource{}
 var _ resource.ResourceWithImportState = &ApiTokenResource{}
 var _ resource.ResourceWithConfigure = &ApiTokenResource{}
+var _ resource.ResourceWithIdentity = &ApiTokenResource{}
+
+// apiTokenIdentity identifies an astro_api_token by its ID
+var apiTokenIdentity = resourceIdentity{
+	{name: "id", description: "API token identifier"},
+}
 
 func NewApiTokenResource() resource.Resource {
 	return &ApiTokenResource{}
@@ -58,6 +64,14 @@ func (r *ApiTokenResource) Schema(
 		Attributes:          schemas.ApiTokenResourceSchemaAttributes(),
 	}
 }
+func (r *ApiTokenResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = apiTokenIdentity.Schema()
+}
+
 func (r *ApiTokenResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -248,6 +262,7 @@ func (r *ApiTokenResource) Create(
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(apiTokenIdentity.Set(ctx, r.OrganizationId, resp.State, resp.Identity)...)
 }
 
 func (r *ApiTokenResource) Read(
@@ -260,6 +275,10 @@ func (r *ApiTokenResource) Read(
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(apiTokenIdentity.Set(ctx, r.OrganizationId, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -431,6 +450,31 @@ func (r *ApiTokenResource) Update(
 		return
 	}
 
+	// Rotate the token when rotation_trigger was set or changed, the new token is only returned by the rotation
+	token := currentState.Token.ValueString()
+	if !data.RotationTrigger.IsNull() && !data.RotationTrigger.Equal(currentState.RotationTrigger) {
+		rotatedApiToken, err := r.IamClient.RotateApiTokenWithResponse(
+			ctx,
+			r.OrganizationId,
+			data.Id.ValueString(),
+		)
+		if err != nil {
+			tflog.Error(ctx, "failed to rotate API token", map[string]interface{}{"error": err})
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to rotate API token, got error: %s", err),
+			)
+			return
+		}
+		_, diagnostic = clients.NormalizeAPIResponseWithBody(ctx, rotatedApiToken.HTTPResponse, rotatedApiToken.Body, rotatedApiToken.JSON200, "rotate API token")
+		if diagnostic != nil {
+			resp.Diagnostics.Append(diagnostic)
+			return
+		}
+		token = lo.FromPtr(rotatedApiToken.JSON200.Token)
+		tflog.Trace(ctx, fmt.Sprintf("rotated an API token: %v", data.Id.ValueString()))
+	}
+
 	// Get api token and use this as data since it will have the correct roles
 	apiTokenResp, err := r.IamClient.GetApiTokenWithResponse(
 		ctx,
@@ -451,18 +495,17 @@ func (r *ApiTokenResource) Update(
 		return
 	}
 
-	diags = data.ReadFromResponse(ctx, apiTokenResp.JSON200, data.Token.ValueString())
+	// use the current or rotated token as it is not returned in the get request due to its sensitive nature
+	diags = data.ReadFromResponse(ctx, apiTokenResp.JSON200, token)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("updated an API token resource: %v", data.Id.ValueString()))
-	if !currentState.Token.IsNull() {
-		data.Token = currentState.Token // use current states token as it is not returned in request due to sensitive nature
-	}
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(apiTokenIdentity.Set(ctx, r.OrganizationId, resp.State, resp.Identity)...)
 }
 
 func (r *ApiTokenResource) Delete(
@@ -508,7 +551,9 @@ func (r *ApiTokenResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, apiTokenIdentity, r.OrganizationId, path.Root("id"), req, resp, func(ctx context.Context, id string) (string, diag.Diagnostics) {
+		return resolveApiTokenImportId(ctx, r.IamClient, r.OrganizationId, id)
+	})
 }
 
 func (r *ApiTokenResource) ValidateApiTokenRoles(entityType string, roles []iam.ApiTokenRole) diag.Diagnostics {
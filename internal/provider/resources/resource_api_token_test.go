@@ -12,8 +12,11 @@ import (
 	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
 	astronomerprovider "github.com/astronomer/terraform-provider-astro/internal/provider"
 	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
@@ -30,6 +33,7 @@ func TestAcc_ResourceOrganizationApiToken(t *testing.T) {
 
 	apiTokenName := fmt.Sprintf("%v_org", namePrefix)
 	resourceVar := fmt.Sprintf("astro_api_token.%v", apiTokenName)
+	rotatedTokens := statecheck.CompareValue(compare.ValuesDiffer())
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
@@ -232,6 +236,43 @@ func TestAcc_ResourceOrganizationApiToken(t *testing.T) {
 					testAccCheckApiTokenExistence(t, checkApiTokensExistenceInput{name: apiTokenName, organization: true, shouldExist: true}),
 					resource.TestCheckResourceAttrSet(resourceVar, "token"),
 				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					rotatedTokens.AddStateValue(resourceVar, tfjsonpath.New("token")),
+				},
+			},
+			// Rotate the token with rotation_trigger and check the new token is stored
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + apiToken(apiTokenInput{
+					Name:            apiTokenName,
+					Description:     "new description",
+					Type:            string(iam.ApiTokenTypeORGANIZATION),
+					RotationTrigger: "rotated",
+					Roles: []apiTokenRole{
+						{
+							Role:       string(iam.UserOrganizationRoleORGANIZATIONOWNER),
+							EntityId:   organizationId,
+							EntityType: string(iam.ApiTokenRoleEntityTypeORGANIZATION),
+						},
+						{
+							Role:       string(iam.WORKSPACEOWNER),
+							EntityId:   workspaceId,
+							EntityType: string(iam.ApiTokenRoleEntityTypeWORKSPACE),
+						},
+						{
+							Role:       "DEPLOYMENT_ADMIN",
+							EntityId:   deploymentId,
+							EntityType: string(iam.ApiTokenRoleEntityTypeDEPLOYMENT),
+						},
+					},
+					ExpiryPeriodInDays: 30,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceVar, "rotation_trigger", "rotated"),
+					resource.TestCheckResourceAttrSet(resourceVar, "token"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					rotatedTokens.AddStateValue(resourceVar, tfjsonpath.New("token")),
+				},
 			},
 			// Test invalid expiry period update
 			{
@@ -895,6 +936,7 @@ type apiTokenInput struct {
 	Type               string
 	Roles              []apiTokenRole
 	ExpiryPeriodInDays int
+	RotationTrigger    string
 }
 
 func apiToken(input apiTokenInput) string {
@@ -922,6 +964,11 @@ func apiToken(input apiTokenInput) string {
 		rolesString = fmt.Sprintf("roles = [%v]", strings.Join(roles, ", "))
 	}
 
+	var rotationTrigger string
+	if input.RotationTrigger != "" {
+		rotationTrigger = fmt.Sprintf("rotation_trigger = \"%v\"", input.RotationTrigger)
+	}
+
 	return fmt.Sprintf(`
 resource astro_api_token "%v" {
 	name = "%v"
@@ -929,7 +976,8 @@ resource astro_api_token "%v" {
 	type = "%s"
 	%v
 	expiry_period_in_days = %v
-}`, input.Name, input.Name, description, input.Type, rolesString, input.ExpiryPeriodInDays)
+	%v
+}`, input.Name, input.Name, description, input.Type, rolesString, input.ExpiryPeriodInDays, rotationTrigger)
 }
 
 type checkApiTokensExistenceInput struct {
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -24,7 +25,15 @@ import (
 var _ resource.Resource = &ClusterResource{}
 var _ resource.ResourceWithImportState = &ClusterResource{}
 var _ resource.ResourceWithConfigure = &ClusterResource{}
+var _ resource.ResourceWithModifyPlan = &ClusterResource{}
 var _ resource.ResourceWithValidateConfig = &ClusterResource{}
+var _ resource.ResourceWithUpgradeState = &ClusterResource{}
+var _ resource.ResourceWithIdentity = &ClusterResource{}
+
+// clusterIdentity identifies an astro_cluster by its ID
+var clusterIdentity = resourceIdentity{
+	{name: "id", description: "Cluster identifier"},
+}
 
 func NewClusterResource() resource.Resource {
 	return &ClusterResource{}
@@ -50,12 +59,22 @@ func (r *ClusterResource) Schema(
 	resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
+		// Version 1 upgrades the state of the earlier releases, see rawStateUpgrader
+		Version: 1,
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Cluster resource. If creating multiple clusters, add a delay between each cluster creation to avoid cluster creation limiting errors.",
 		Attributes:          schemas.ClusterResourceSchemaAttributes(ctx),
 	}
 }
 
+func (r *ClusterResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = clusterIdentity.Schema()
+}
+
 func (r *ClusterResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -249,6 +268,7 @@ func (r *ClusterResource) Create(
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(clusterIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *ClusterResource) Read(
@@ -261,6 +281,10 @@ func (r *ClusterResource) Read(
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(clusterIdentity.Set(ctx, r.organizationId, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -342,9 +366,16 @@ func (r *ClusterResource) Update(
 		updateDedicatedClusterRequest.DrVpcSubnetRange = data.DrVpcSubnetRange.ValueStringPointer()
 		updateDedicatedClusterRequest.EnableReplicationTimeControl = azureEffectiveEnableReplicationTimeControl(&data)
 	}
-	// Set IsFailedOver if specified (must be an explicit value, not null or unknown)
-	if !data.IsFailedOver.IsNull() && !data.IsFailedOver.IsUnknown() {
-		updateDedicatedClusterRequest.IsFailedOver = data.IsFailedOver.ValueBoolPointer()
+	// Set IsFailedOver if specified in the configuration (must be an explicit value, not null or unknown). The planned
+	// value of an unset is_failed_over is the prior state, which is stale when the failover is managed elsewhere, e.g.
+	// by an astro_cluster_failover resource.
+	var configIsFailedOver types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("is_failed_over"), &configIsFailedOver)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !configIsFailedOver.IsNull() && !configIsFailedOver.IsUnknown() {
+		updateDedicatedClusterRequest.IsFailedOver = configIsFailedOver.ValueBoolPointer()
 	}
 
 	// workspaceIds
@@ -433,6 +464,7 @@ func (r *ClusterResource) Update(
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(clusterIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *ClusterResource) Delete(
@@ -448,6 +480,11 @@ func (r *ClusterResource) Delete(
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(DeletionProtectionDiagnostic("Cluster", data.Id.ValueString(), "destroy"))
+		return
+	}
+
 	// Create the timeout context for the cluster delete
 	deleteTimeout, diags := data.Timeouts.Delete(ctx, 1*time.Hour)
 	resp.Diagnostics.Append(diags...)
@@ -511,12 +548,37 @@ func (r *ClusterResource) Delete(
 	tflog.Trace(ctx, fmt.Sprintf("deleted a cluster resource: %v", data.Id.ValueString()))
 }
 
+// ModifyPlan blocks destroy and replace plans while deletion_protection is enabled in the prior state.
+func (r *ClusterResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	ModifyPlanDeletionProtection(ctx, req, resp, "Cluster", RequiresReplaceIfConfigured(
+		path.Root("type"),
+		path.Root("cloud_provider"),
+		path.Root("region"),
+		path.Root("pod_subnet_range"),
+		path.Root("service_peering_range"),
+		path.Root("service_subnet_range"),
+		path.Root("vpc_subnet_range"),
+	))
+}
+
 func (r *ClusterResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, clusterIdentity, r.organizationId, path.Root("id"), req, resp, func(ctx context.Context, id string) (string, diag.Diagnostics) {
+		return resolveClusterImportId(ctx, r.platformClient, r.organizationId, id)
+	})
+}
+
+func (r *ClusterResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: rawStateUpgrader(),
+	}
 }
 
 // ValidateConfig validates the configuration of the resource as a whole before any operations are performed.
@@ -0,0 +1,418 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/samber/lo"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ClusterFailoverResource{}
+var _ resource.ResourceWithImportState = &ClusterFailoverResource{}
+var _ resource.ResourceWithConfigure = &ClusterFailoverResource{}
+var _ resource.ResourceWithModifyPlan = &ClusterFailoverResource{}
+var _ resource.ResourceWithIdentity = &ClusterFailoverResource{}
+
+// clusterFailoverIdentity identifies an astro_cluster_failover by its cluster
+var clusterFailoverIdentity = resourceIdentity{
+	{name: "cluster_id", description: "Cluster identifier"},
+}
+
+// clusterFailoverInProgress is the status polled while the cluster reports a failover in progress, whatever its status
+const clusterFailoverInProgress = "FAILOVER_IN_PROGRESS"
+
+func NewClusterFailoverResource() resource.Resource {
+	return &ClusterFailoverResource{}
+}
+
+// ClusterFailoverResource defines the resource implementation.
+type ClusterFailoverResource struct {
+	platformClient *platform.ClientWithResponses
+	organizationId string
+}
+
+func (r *ClusterFailoverResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_failover"
+}
+
+func (r *ClusterFailoverResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Cluster failover resource. Fails a dedicated cluster with disaster recovery enabled over to its DR region, or back to its primary region, and waits until the failover is done. Creating the resource fails the cluster over only if `is_failed_over` differs from the current state of the cluster or its last failover failed, destroying it leaves the cluster as it is. Do not set `is_failed_over` on the `astro_cluster` resource of a cluster managed by this resource.",
+		Attributes:          schemas.ClusterFailoverResourceSchemaAttributes(),
+	}
+}
+
+func (r *ClusterFailoverResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = clusterFailoverIdentity.Schema()
+}
+
+func (r *ClusterFailoverResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClients, ok := req.ProviderData.(models.ApiClientsModel)
+	if !ok {
+		utils.ResourceApiClientConfigureError(ctx, req, resp)
+		return
+	}
+
+	r.platformClient = apiClients.PlatformClient
+	r.organizationId = apiClients.OrganizationId
+}
+
+func (r *ClusterFailoverResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data models.ClusterFailoverResource
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, diags := r.Failover(ctx, data.ClusterId.ValueString(), data.IsFailedOver.ValueBool())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	data.ReadFromResponse(cluster)
+
+	tflog.Trace(ctx, fmt.Sprintf("created a cluster failover resource: %v", data.Id.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(clusterFailoverIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
+}
+
+func (r *ClusterFailoverResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data models.ClusterFailoverResource
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(clusterFailoverIdentity.Set(ctx, r.organizationId, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := r.platformClient.GetClusterWithResponse(ctx, r.organizationId, data.ClusterId.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to get cluster", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get cluster, got error: %s", err),
+		)
+		return
+	}
+	statusCode, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, cluster.HTTPResponse, cluster.Body, cluster.JSON200, "read cluster failover")
+	// If the resource no longer exists, it is recommended to ignore the errors
+	// and call RemoveResource to remove the resource from the state. The next Terraform plan will recreate the resource.
+	if statusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if diagnostic != nil {
+		resp.Diagnostics.Append(diagnostic)
+		return
+	}
+
+	data.ReadFromResponse(cluster.JSON200)
+
+	tflog.Trace(ctx, fmt.Sprintf("read a cluster failover resource: %v", data.Id.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterFailoverResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data models.ClusterFailoverResource
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, diags := r.Failover(ctx, data.ClusterId.ValueString(), data.IsFailedOver.ValueBool())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	data.ReadFromResponse(cluster)
+
+	tflog.Trace(ctx, fmt.Sprintf("updated a cluster failover resource: %v", data.Id.ValueString()))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(clusterFailoverIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
+}
+
+// Delete only removes the resource from the state, the cluster stays in the region it is running in
+func (r *ClusterFailoverResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data models.ClusterFailoverResource
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted a cluster failover resource: %v", data.Id.ValueString()))
+}
+
+func (r *ClusterFailoverResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := clusterFailoverIdentity.ImportID(ctx, r.organizationId, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// ModifyPlan checks that the cluster of a planned failover has disaster recovery enabled and reports the failover
+// or failback the plan will trigger, so that it is reviewed before it is applied.
+func (r *ClusterFailoverResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		var state models.ClusterFailoverResource
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if !resp.Diagnostics.HasError() && state.IsFailedOver.ValueBool() {
+			resp.Diagnostics.AddWarning(
+				"Cluster stays failed over",
+				fmt.Sprintf("Removing the cluster failover of cluster '%s' does not fail it back, the cluster keeps running in region '%s'.", state.ClusterId.ValueString(), state.DrRegion.ValueString()),
+			)
+		}
+		return
+	}
+
+	var plan models.ClusterFailoverResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// The cluster can only be checked once it is known, e.g. not when it is created in the same apply
+	if resp.Diagnostics.HasError() || plan.ClusterId.IsUnknown() || plan.IsFailedOver.IsUnknown() {
+		return
+	}
+
+	cluster, err := r.platformClient.GetClusterWithResponse(ctx, r.organizationId, plan.ClusterId.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to get cluster", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get cluster, got error: %s", err),
+		)
+		return
+	}
+	_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, cluster.HTTPResponse, cluster.Body, cluster.JSON200, "read cluster")
+	if diagnostic != nil {
+		resp.Diagnostics.Append(diagnostic)
+		return
+	}
+
+	resp.Diagnostics.Append(validateClusterFailover(cluster.JSON200)...)
+	if resp.Diagnostics.HasError() || plan.IsFailedOver.ValueBool() == lo.FromPtr(cluster.JSON200.IsFailedOver) {
+		return
+	}
+	if plan.IsFailedOver.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("is_failed_over"),
+			"Cluster failover",
+			fmt.Sprintf("Applying this plan fails cluster '%s' over from region '%s' to its disaster recovery region '%s'.", cluster.JSON200.Id, cluster.JSON200.Region, cluster.JSON200.DrRegion),
+		)
+	} else {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("is_failed_over"),
+			"Cluster failback",
+			fmt.Sprintf("Applying this plan fails cluster '%s' back from its disaster recovery region '%s' to region '%s'.", cluster.JSON200.Id, cluster.JSON200.DrRegion, cluster.JSON200.Region),
+		)
+	}
+}
+
+// Failover fails the cluster over when isFailedOver is true, or back when it is false, and waits until the failover
+// is done. A failover already in progress is waited for first. Nothing is changed when the cluster is already in the
+// requested state, unless its last failover failed so that it is retried or failed back.
+func (r *ClusterFailoverResource) Failover(
+	ctx context.Context,
+	clusterId string,
+	isFailedOver bool,
+) (*platform.Cluster, diag.Diagnostics) {
+	cluster, diags := r.waitForFailover(ctx, clusterId, true)
+	if diags.HasError() {
+		return nil, diags
+	}
+	diags.Append(validateClusterFailover(cluster)...)
+	failed := cluster.Status == platform.ClusterStatusFAILOVERFAILED
+	if diags.HasError() || (!failed && lo.FromPtr(cluster.IsFailedOver) == isFailedOver) {
+		return cluster, diags
+	}
+
+	var updateClusterRequest platform.UpdateClusterRequest
+	err := updateClusterRequest.FromUpdateDedicatedClusterRequest(platform.UpdateDedicatedClusterRequest{
+		ClusterType:  lo.ToPtr(platform.UpdateDedicatedClusterRequestClusterTypeDEDICATED),
+		IsFailedOver: &isFailedOver,
+		K8sTags:      append([]platform.ClusterK8sTag{}, lo.FromPtr(cluster.Tags)...),
+		Name:         cluster.Name,
+		WorkspaceIds: cluster.WorkspaceIds,
+	})
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("failed to fail over cluster error: %v", err))
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to build update cluster request body, got error: %s", err),
+		)
+		return nil, diags
+	}
+
+	// Retry update cluster request if there is a 409 conflict (workflow already running)
+	err = retry.RetryContext(ctx, 3*time.Hour, func() *retry.RetryError {
+		updatedCluster, apiErr := r.platformClient.UpdateClusterWithResponse(ctx, r.organizationId, clusterId, updateClusterRequest)
+		if apiErr != nil {
+			tflog.Error(ctx, "failed to fail over cluster", map[string]interface{}{"error": apiErr})
+			return retry.NonRetryableError(fmt.Errorf("unable to update cluster, got error: %s", apiErr))
+		}
+		statusCode, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, updatedCluster.HTTPResponse, updatedCluster.Body, updatedCluster.JSON200, "fail over cluster")
+		if statusCode == http.StatusConflict {
+			// Workflow is already running, retry after a delay
+			tflog.Info(ctx, "cluster workflow in progress, retrying failover", map[string]interface{}{"clusterId": clusterId})
+			return retry.RetryableError(fmt.Errorf("workflow is already running for cluster, retrying"))
+		}
+		if diagnostic != nil {
+			return retry.NonRetryableError(fmt.Errorf("%s", diagnostic.Detail()))
+		}
+		return nil
+	})
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to fail over cluster after retries, got error: %s", err),
+		)
+		return nil, diags
+	}
+
+	return r.waitForFailover(ctx, clusterId, false)
+}
+
+// waitForFailover returns the cluster once no failover is in progress. A failed failover is returned as the cluster
+// when acceptFailed is set, and otherwise as an error with the reasons reported by the health status of the cluster.
+func (r *ClusterFailoverResource) waitForFailover(ctx context.Context, clusterId string, acceptFailed bool) (*platform.Cluster, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	refresh := ClusterResourceRefreshFunc(ctx, r.platformClient, r.organizationId, clusterId)
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{string(platform.ClusterStatusCREATING), string(platform.ClusterStatusUPDATING), string(platform.ClusterStatusUPGRADEPENDING), string(platform.ClusterStatusFAILINGOVER), clusterFailoverInProgress},
+		Target:  []string{string(platform.ClusterStatusCREATED), string(platform.ClusterStatusUPDATEFAILED), string(platform.ClusterStatusFAILOVERFAILED)},
+		Refresh: func() (any, string, error) {
+			result, status, err := refresh()
+			switch status {
+			case string(platform.ClusterStatusFAILOVERFAILED):
+				if acceptFailed {
+					return result, status, nil
+				}
+				return result, status, clusterFailoverFailedError(result.(*platform.Cluster))
+			case string(platform.ClusterStatusUPDATEFAILED):
+				// A failed update does not prevent failing the cluster over
+				return result, status, nil
+			case string(platform.ClusterStatusCREATED):
+				if lo.FromPtr(result.(*platform.Cluster).FailoverInProgress) {
+					return result, clusterFailoverInProgress, nil
+				}
+			}
+			return result, status, err
+		},
+		Timeout:    3 * time.Hour,
+		MinTimeout: 1 * time.Minute,
+	}
+
+	// readyCluster is the final state of the cluster after it has reached a target status
+	readyCluster, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		diags.AddError("Cluster failover failed", err.Error())
+		return nil, diags
+	}
+	return readyCluster.(*platform.Cluster), diags
+}
+
+// validateClusterFailover returns an error if the cluster cannot be failed over
+func validateClusterFailover(cluster *platform.Cluster) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if cluster.Type != platform.ClusterTypeDEDICATED || !cluster.IsDrEnabled {
+		diags.AddAttributeError(
+			path.Root("cluster_id"),
+			"Disaster recovery not enabled",
+			fmt.Sprintf("Cluster '%s' cannot be failed over because it is not a dedicated cluster with disaster recovery enabled. Set is_dr_enabled and dr_region on the cluster first.", cluster.Id),
+		)
+	}
+	return diags
+}
+
+// clusterFailoverFailedError returns the error of a FAILOVER_FAILED cluster, with the details of its health status
+func clusterFailoverFailedError(cluster *platform.Cluster) error {
+	var reasons []string
+	if cluster.HealthStatus != nil {
+		reasons = lo.Map(lo.FromPtr(cluster.HealthStatus.Details), func(detail platform.ClusterHealthStatusDetail, _ int) string {
+			return fmt.Sprintf("%s (%s): %s", detail.Code, detail.Severity, detail.Description)
+		})
+	}
+	if len(reasons) == 0 {
+		return fmt.Errorf("cluster failover failed for cluster '%v', no reason was reported in its health status", cluster.Id)
+	}
+	return fmt.Errorf("cluster failover failed for cluster '%v': %s", cluster.Id, strings.Join(reasons, "; "))
+}
@@ -0,0 +1,131 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/samber/lo"
+
+	astronomerprovider "github.com/astronomer/terraform-provider-astro/internal/provider"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+)
+
+func TestAcc_ResourceClusterFailover(t *testing.T) {
+	if os.Getenv(SKIP_CLUSTER_RESOURCE_TESTS) == "True" {
+		t.Skip(SKIP_CLUSTER_RESOURCE_TESTS_REASON)
+	}
+	namePrefix := utils.GenerateTestResourceName(10)
+
+	clusterName := fmt.Sprintf("%v_dr", namePrefix)
+	clusterResourceVar := fmt.Sprintf("astro_cluster.%v", clusterName)
+	clusterConfig := cluster(clusterInput{
+		Name:          clusterName,
+		Region:        "us-east-1",
+		CloudProvider: "AWS",
+		IsDrEnabled:   true,
+		DrRegion:      "us-west-2",
+	})
+	failoverName := fmt.Sprintf("%v_failover", namePrefix)
+	failoverResourceVar := fmt.Sprintf("astro_cluster_failover.%v", failoverName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { astronomerprovider.TestAccPreCheck(t) },
+		CheckDestroy:             testAccCheckClusterExistence(t, clusterName, true, false),
+		Steps: []resource.TestStep{
+			// Creating the resource for a cluster in the requested state does not fail it over
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + clusterConfig +
+					clusterFailover(failoverName, clusterResourceVar+".id", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(failoverResourceVar, "cluster_id", clusterResourceVar, "id"),
+					resource.TestCheckResourceAttr(failoverResourceVar, "is_failed_over", "false"),
+					resource.TestCheckResourceAttr(failoverResourceVar, "region", "us-east-1"),
+					resource.TestCheckResourceAttr(failoverResourceVar, "dr_region", "us-west-2"),
+					resource.TestCheckResourceAttr(failoverResourceVar, "active_region", "us-east-1"),
+					testAccCheckClusterFailedOver(t, clusterResourceVar, false),
+				),
+			},
+			// Fail over
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + clusterConfig +
+					clusterFailover(failoverName, clusterResourceVar+".id", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(failoverResourceVar, "is_failed_over", "true"),
+					resource.TestCheckResourceAttr(failoverResourceVar, "active_region", "us-west-2"),
+					testAccCheckClusterFailedOver(t, clusterResourceVar, true),
+				),
+			},
+			// Import existing cluster failover
+			{
+				ResourceName:      failoverResourceVar,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Fail back
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + clusterConfig +
+					clusterFailover(failoverName, clusterResourceVar+".id", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(failoverResourceVar, "is_failed_over", "false"),
+					resource.TestCheckResourceAttr(failoverResourceVar, "active_region", "us-east-1"),
+					testAccCheckClusterFailedOver(t, clusterResourceVar, false),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceClusterFailoverWithoutDr(t *testing.T) {
+	name := utils.GenerateTestResourceName(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { astronomerprovider.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) +
+					clusterFailover(name, fmt.Sprintf("%q", os.Getenv("HOSTED_DEDICATED_CLUSTER_ID")), true),
+				ExpectError: regexp.MustCompile("Disaster recovery not enabled"),
+			},
+		},
+	})
+}
+
+func clusterFailover(name, clusterId string, isFailedOver bool) string {
+	return fmt.Sprintf(`
+resource "astro_cluster_failover" "%v" {
+	cluster_id = %v
+	is_failed_over = %v
+}
+`, name, clusterId, isFailedOver)
+}
+
+// testAccCheckClusterFailedOver checks through the API whether the cluster is failed over
+func testAccCheckClusterFailedOver(t *testing.T, clusterResourceVar string, isFailedOver bool) resource.TestCheckFunc {
+	t.Helper()
+	return func(state *terraform.State) error {
+		client, err := utils.GetTestHostedPlatformClient()
+		if err != nil {
+			return fmt.Errorf("failed to get platform client: %w", err)
+		}
+		clusterId := state.RootModule().Resources[clusterResourceVar].Primary.ID
+		resp, err := client.GetClusterWithResponse(context.Background(), os.Getenv("HOSTED_ORGANIZATION_ID"), clusterId)
+		if err != nil {
+			return fmt.Errorf("failed to get cluster: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return fmt.Errorf("failed to get cluster %s: %s", clusterId, string(resp.Body))
+		}
+		if lo.FromPtr(resp.JSON200.IsFailedOver) != isFailedOver || lo.FromPtr(resp.JSON200.FailoverInProgress) {
+			return fmt.Errorf("expected cluster %s to have is_failed_over %v with no failover in progress, got %v (in progress: %v)",
+				clusterId, isFailedOver, lo.FromPtr(resp.JSON200.IsFailedOver), lo.FromPtr(resp.JSON200.FailoverInProgress))
+		}
+		return nil
+	}
+}
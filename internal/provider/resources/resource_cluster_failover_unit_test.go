@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+)
+
+func TestUnit_ClusterFailover(t *testing.T) {
+	t.Run("reports the health status details of a failed failover", func(t *testing.T) {
+		cluster := &platform.Cluster{
+			Id:     "clusterid",
+			Status: platform.ClusterStatusFAILOVERFAILED,
+			HealthStatus: &platform.ClusterHealthStatus{
+				Value: "UNHEALTHY",
+				Details: &[]platform.ClusterHealthStatusDetail{
+					{Code: "DR_REPLICATION_LAG", Severity: "ERROR", Description: "The database replica is 2 hours behind"},
+					{Code: "DR_DNS", Severity: "WARNING", Description: "DNS records were not updated"},
+				},
+			},
+		}
+		assert.EqualError(t, clusterFailoverFailedError(cluster),
+			"cluster failover failed for cluster 'clusterid': DR_REPLICATION_LAG (ERROR): The database replica is 2 hours behind; DR_DNS (WARNING): DNS records were not updated")
+
+		cluster.HealthStatus = nil
+		assert.EqualError(t, clusterFailoverFailedError(cluster),
+			"cluster failover failed for cluster 'clusterid', no reason was reported in its health status")
+	})
+
+	t.Run("requires a dedicated cluster with disaster recovery enabled", func(t *testing.T) {
+		assert.False(t, validateClusterFailover(&platform.Cluster{Type: platform.ClusterTypeDEDICATED, IsDrEnabled: true}).HasError())
+		assert.True(t, validateClusterFailover(&platform.Cluster{Type: platform.ClusterTypeDEDICATED}).HasError())
+		assert.True(t, validateClusterFailover(&platform.Cluster{Type: platform.ClusterTypeHYBRID, IsDrEnabled: true}).HasError())
+	})
+
+	t.Run("retries or fails back a failed failover", func(t *testing.T) {
+		for _, isFailedOver := range []bool{true, false} {
+			cluster := platform.Cluster{
+				Id:           "clusterid",
+				Type:         platform.ClusterTypeDEDICATED,
+				IsDrEnabled:  true,
+				IsFailedOver: lo.ToPtr(true),
+				Status:       platform.ClusterStatusFAILOVERFAILED,
+			}
+			var updates []platform.UpdateDedicatedClusterRequest
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.Method == http.MethodPost {
+					var update platform.UpdateDedicatedClusterRequest
+					require.NoError(t, json.NewDecoder(req.Body).Decode(&update))
+					updates = append(updates, update)
+					cluster.Status = platform.ClusterStatusCREATED
+					cluster.IsFailedOver = update.IsFailedOver
+				}
+				w.Header().Set("Content-Type", "application/json")
+				require.NoError(t, json.NewEncoder(w).Encode(cluster))
+			}))
+			platformClient, err := platform.NewPlatformClient(srv.URL, "token", "test")
+			require.NoError(t, err)
+			r := &ClusterFailoverResource{platformClient: platformClient, organizationId: "orgid"}
+
+			failedOver, diags := r.Failover(context.Background(), cluster.Id, isFailedOver)
+			srv.Close()
+			require.False(t, diags.HasError(), diags)
+			require.Len(t, updates, 1)
+			assert.Equal(t, isFailedOver, lo.FromPtr(updates[0].IsFailedOver))
+			assert.Equal(t, platform.ClusterStatusCREATED, failedOver.Status)
+			assert.Equal(t, isFailedOver, lo.FromPtr(failedOver.IsFailedOver))
+		}
+	})
+}
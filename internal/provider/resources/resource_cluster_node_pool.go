@@ -0,0 +1,465 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/samber/lo"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ClusterNodePoolResource{}
+var _ resource.ResourceWithImportState = &ClusterNodePoolResource{}
+var _ resource.ResourceWithConfigure = &ClusterNodePoolResource{}
+var _ resource.ResourceWithIdentity = &ClusterNodePoolResource{}
+
+// clusterNodePoolIdentity identifies an astro_cluster_node_pool by its cluster and ID
+var clusterNodePoolIdentity = resourceIdentity{
+	{name: "cluster_id", description: "Cluster identifier"},
+	{name: "id", description: "Node pool identifier"},
+}
+
+// clusterNodePoolLocks holds a mutex per cluster ID. The cluster update endpoint replaces all the node pools of a
+// cluster, so the changes of node pools of the same cluster are serialized to not overwrite each other.
+var clusterNodePoolLocks sync.Map
+
+// errHybridClusterNodePools is returned when changing the node pools of a hybrid cluster, which the API does not support
+var errHybridClusterNodePools = errors.New("the node pools of hybrid clusters cannot be changed through the API, contact Astronomer support to change them")
+
+func lockClusterNodePools(clusterId string) func() {
+	mu, _ := clusterNodePoolLocks.LoadOrStore(clusterId, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+func NewClusterNodePoolResource() resource.Resource {
+	return &ClusterNodePoolResource{}
+}
+
+// ClusterNodePoolResource defines the resource implementation.
+type ClusterNodePoolResource struct {
+	platformClient *platform.ClientWithResponses
+	organizationId string
+}
+
+func (r *ClusterNodePoolResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_node_pool"
+}
+
+func (r *ClusterNodePoolResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Cluster node pool resource. Manages a single node pool of a dedicated cluster through the cluster update API, leaving the other node pools of the cluster unchanged. The node pools of hybrid clusters can be imported but not changed.",
+		Attributes:          schemas.ClusterNodePoolResourceSchemaAttributes(),
+	}
+}
+
+func (r *ClusterNodePoolResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = clusterNodePoolIdentity.Schema()
+}
+
+func (r *ClusterNodePoolResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClients, ok := req.ProviderData.(models.ApiClientsModel)
+	if !ok {
+		utils.ResourceApiClientConfigureError(ctx, req, resp)
+		return
+	}
+
+	r.platformClient = apiClients.PlatformClient
+	r.organizationId = apiClients.OrganizationId
+}
+
+func (r *ClusterNodePoolResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data models.ClusterNodePoolResource
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, diags := r.MutateNodePools(ctx, data.ClusterId.ValueString(), "", nodePoolRequest(&data, data.IsDefault))
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	nodePool, ok := lo.Find(lo.FromPtr(cluster.NodePools), func(nodePool platform.NodePool) bool {
+		return nodePool.Name == data.Name.ValueString()
+	})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Node pool not found",
+			fmt.Sprintf("Node pool '%s' was not found in cluster '%s' after it was created", data.Name.ValueString(), cluster.Id),
+		)
+		return
+	}
+
+	diags = data.ReadFromResponse(&nodePool)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created a cluster node pool resource: %v", data.Id.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(clusterNodePoolIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
+}
+
+func (r *ClusterNodePoolResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data models.ClusterNodePoolResource
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(clusterNodePoolIdentity.Set(ctx, r.organizationId, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := r.platformClient.GetClusterWithResponse(ctx, r.organizationId, data.ClusterId.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to get cluster", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get cluster, got error: %s", err),
+		)
+		return
+	}
+	statusCode, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, cluster.HTTPResponse, cluster.Body, cluster.JSON200, "read cluster node pool")
+	// If the resource no longer exists, it is recommended to ignore the errors
+	// and call RemoveResource to remove the resource from the state. The next Terraform plan will recreate the resource.
+	if statusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if diagnostic != nil {
+		resp.Diagnostics.Append(diagnostic)
+		return
+	}
+
+	nodePool, ok := lo.Find(lo.FromPtr(cluster.JSON200.NodePools), func(nodePool platform.NodePool) bool {
+		return nodePool.Id == data.Id.ValueString()
+	})
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags := data.ReadFromResponse(&nodePool)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("read a cluster node pool resource: %v", data.Id.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterNodePoolResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data models.ClusterNodePoolResource
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// is_default is planned from the state when it is not configured, in which case the node pool keeps the default
+	// the platform has now, which another node pool may have changed
+	var isDefault types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("is_default"), &isDefault)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, diags := r.MutateNodePools(ctx, data.ClusterId.ValueString(), data.Id.ValueString(), nodePoolRequest(&data, isDefault))
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	nodePool, ok := lo.Find(lo.FromPtr(cluster.NodePools), func(nodePool platform.NodePool) bool {
+		return nodePool.Id == data.Id.ValueString()
+	})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Node pool not found",
+			fmt.Sprintf("Node pool '%s' was not found in cluster '%s' after it was updated", data.Id.ValueString(), cluster.Id),
+		)
+		return
+	}
+
+	diags = data.ReadFromResponse(&nodePool)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated a cluster node pool resource: %v", data.Id.ValueString()))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(clusterNodePoolIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
+}
+
+func (r *ClusterNodePoolResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data models.ClusterNodePoolResource
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := r.MutateNodePools(ctx, data.ClusterId.ValueString(), data.Id.ValueString(), nil)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted a cluster node pool resource: %v", data.Id.ValueString()))
+}
+
+func (r *ClusterNodePoolResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := clusterNodePoolIdentity.ImportID(ctx, r.organizationId, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Import ID format: <cluster_id>/<node_pool_id>
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID must be in the format `<cluster_id>/<node_pool_id>`",
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// MutateNodePools replaces the node pool with the ID nodePoolId of the cluster by nodePool, adds nodePool when
+// nodePoolId is empty or removes the node pool when nodePool is nil. The other node pools of the cluster are sent
+// unchanged. It returns the cluster once the update is done, or nil if there was nothing to remove.
+func (r *ClusterNodePoolResource) MutateNodePools(
+	ctx context.Context,
+	clusterId string,
+	nodePoolId string,
+	nodePool *platform.UpdateNodePoolRequest,
+) (*platform.Cluster, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	unlock := lockClusterNodePools(clusterId)
+	defer unlock()
+
+	// The node pools are read again before each attempt, a 409 conflict means the cluster is being changed
+	var updated bool
+	err := retry.RetryContext(ctx, 3*time.Hour, func() *retry.RetryError {
+		cluster, err := r.platformClient.GetClusterWithResponse(ctx, r.organizationId, clusterId)
+		if err != nil {
+			tflog.Error(ctx, "failed to get cluster", map[string]interface{}{"error": err})
+			return retry.NonRetryableError(fmt.Errorf("unable to get cluster, got error: %s", err))
+		}
+		statusCode, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, cluster.HTTPResponse, cluster.Body, cluster.JSON200, "read cluster")
+		// A node pool is removed with its cluster
+		if statusCode == http.StatusNotFound && nodePool == nil {
+			return nil
+		}
+		if diagnostic != nil {
+			return retry.NonRetryableError(fmt.Errorf("%s", diagnostic.Detail()))
+		}
+		if cluster.JSON200.Type == platform.ClusterTypeHYBRID {
+			return retry.NonRetryableError(errHybridClusterNodePools)
+		}
+
+		existing := lo.FromPtr(cluster.JSON200.NodePools)
+		switch {
+		case nodePoolId == "" && lo.ContainsBy(existing, func(e platform.NodePool) bool { return e.Name == nodePool.Name }):
+			return retry.NonRetryableError(fmt.Errorf("node pool '%s' already exists in cluster '%s', import it to manage it", nodePool.Name, clusterId))
+		case nodePoolId != "" && !lo.ContainsBy(existing, func(e platform.NodePool) bool { return e.Id == nodePoolId }):
+			if nodePool == nil {
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("node pool '%s' not found in cluster '%s'", nodePoolId, clusterId))
+		}
+
+		var updateClusterRequest platform.UpdateClusterRequest
+		err = updateClusterRequest.FromUpdateDedicatedClusterRequest(platform.UpdateDedicatedClusterRequest{
+			ClusterType:  lo.ToPtr(platform.UpdateDedicatedClusterRequestClusterTypeDEDICATED),
+			K8sTags:      append([]platform.ClusterK8sTag{}, lo.FromPtr(cluster.JSON200.Tags)...),
+			Name:         cluster.JSON200.Name,
+			NodePools:    lo.ToPtr(nodePoolRequests(existing, nodePoolId, nodePool)),
+			WorkspaceIds: cluster.JSON200.WorkspaceIds,
+		})
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("unable to build update cluster request body, got error: %s", err))
+		}
+
+		updatedCluster, err := r.platformClient.UpdateClusterWithResponse(ctx, r.organizationId, clusterId, updateClusterRequest)
+		if err != nil {
+			tflog.Error(ctx, "failed to update cluster node pools", map[string]interface{}{"error": err})
+			return retry.NonRetryableError(fmt.Errorf("unable to update cluster, got error: %s", err))
+		}
+		statusCode, diagnostic = clients.NormalizeAPIResponseWithBody(ctx, updatedCluster.HTTPResponse, updatedCluster.Body, updatedCluster.JSON200, "update cluster node pools")
+		if statusCode == http.StatusConflict {
+			// Workflow is already running, retry after a delay
+			tflog.Info(ctx, "cluster workflow in progress, retrying node pool update", map[string]interface{}{"clusterId": clusterId})
+			return retry.RetryableError(fmt.Errorf("workflow is already running for cluster, retrying"))
+		}
+		if diagnostic != nil {
+			return retry.NonRetryableError(fmt.Errorf("%s", diagnostic.Detail()))
+		}
+		updated = true
+		return nil
+	})
+	if errors.Is(err, errHybridClusterNodePools) {
+		diags.AddAttributeError(path.Root("cluster_id"), "Hybrid cluster node pools", fmt.Sprintf("Unable to change the node pools of cluster '%s': %s", clusterId, err))
+		return nil, diags
+	}
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to update cluster node pools, got error: %s", err),
+		)
+		return nil, diags
+	}
+	if !updated {
+		return nil, diags
+	}
+
+	// Wait for the cluster to be updated (or fail)
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{string(platform.ClusterStatusCREATING), string(platform.ClusterStatusUPDATING), string(platform.ClusterStatusUPGRADEPENDING), string(platform.ClusterStatusFAILINGOVER)},
+		Target:     []string{string(platform.ClusterStatusCREATED), string(platform.ClusterStatusUPDATEFAILED), string(platform.ClusterStatusCREATEFAILED), string(platform.ClusterStatusACCESSDENIED), string(platform.ClusterStatusFAILOVERFAILED)},
+		Refresh:    ClusterResourceRefreshFunc(ctx, r.platformClient, r.organizationId, clusterId),
+		Timeout:    3 * time.Hour,
+		MinTimeout: 1 * time.Minute,
+	}
+
+	// readyCluster is the final state of the cluster after it has reached a target status
+	readyCluster, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		diags.AddError("Cluster node pool update failed", err.Error())
+		return nil, diags
+	}
+
+	return readyCluster.(*platform.Cluster), diags
+}
+
+// nodePoolRequest returns the node pool request of the planned node pool, which is made the default node pool or not
+// depending on isDefault
+func nodePoolRequest(data *models.ClusterNodePoolResource, isDefault types.Bool) *platform.UpdateNodePoolRequest {
+	nodePool := &platform.UpdateNodePoolRequest{
+		Name:             data.Name.ValueString(),
+		NodeInstanceType: data.NodeInstanceType.ValueString(),
+		MaxNodeCount:     int(data.MaxNodeCount.ValueInt64()),
+	}
+	// An unknown is_default is left to the platform
+	if !isDefault.IsNull() && !isDefault.IsUnknown() {
+		nodePool.IsDefault = isDefault.ValueBoolPointer()
+	}
+	return nodePool
+}
+
+// nodePoolRequests returns the node pools to send to the cluster update endpoint, which replaces all the node pools of
+// the cluster, including whether they are the default node pool. The existing node pools are kept as they are, except
+// the node pool with the ID nodePoolId which is replaced by nodePool, or removed when nodePool is nil. nodePool is added
+// when nodePoolId is empty. The other node pools are made non-default when nodePool becomes the default node pool.
+func nodePoolRequests(existing []platform.NodePool, nodePoolId string, nodePool *platform.UpdateNodePoolRequest) []platform.UpdateNodePoolRequest {
+	makeDefault := nodePool != nil && lo.FromPtr(nodePool.IsDefault)
+	nodePools := make([]platform.UpdateNodePoolRequest, 0, len(existing)+1)
+	for _, e := range existing {
+		if nodePoolId != "" && e.Id == nodePoolId {
+			if nodePool != nil {
+				// A node pool without a planned is_default stays as it is
+				isDefault := nodePool.IsDefault
+				if isDefault == nil {
+					isDefault = lo.ToPtr(e.IsDefault)
+				}
+				nodePools = append(nodePools, platform.UpdateNodePoolRequest{
+					Id:               lo.ToPtr(e.Id),
+					IsDefault:        isDefault,
+					MaxNodeCount:     nodePool.MaxNodeCount,
+					Name:             nodePool.Name,
+					NodeInstanceType: nodePool.NodeInstanceType,
+				})
+			}
+			continue
+		}
+		nodePools = append(nodePools, platform.UpdateNodePoolRequest{
+			Id:               lo.ToPtr(e.Id),
+			IsDefault:        lo.ToPtr(e.IsDefault && !makeDefault),
+			MaxNodeCount:     e.MaxNodeCount,
+			Name:             e.Name,
+			NodeInstanceType: e.NodeInstanceType,
+		})
+	}
+	if nodePoolId == "" && nodePool != nil {
+		nodePools = append(nodePools, *nodePool)
+	}
+	return nodePools
+}
@@ -0,0 +1,207 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/samber/lo"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	astronomerprovider "github.com/astronomer/terraform-provider-astro/internal/provider"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+)
+
+func TestAcc_ResourceClusterNodePool(t *testing.T) {
+	if os.Getenv(SKIP_CLUSTER_RESOURCE_TESTS) == "True" {
+		t.Skip(SKIP_CLUSTER_RESOURCE_TESTS_REASON)
+	}
+	namePrefix := utils.GenerateTestResourceName(10)
+
+	clusterName := fmt.Sprintf("%v_cluster", namePrefix)
+	clusterConfig := cluster(clusterInput{
+		Name:          clusterName,
+		Region:        "us-east-1",
+		CloudProvider: "AWS",
+	})
+	clusterId := fmt.Sprintf("astro_cluster.%v.id", clusterName)
+
+	primaryName := fmt.Sprintf("%v_primary", namePrefix)
+	primaryResourceVar := fmt.Sprintf("astro_cluster_node_pool.%v", primaryName)
+	secondaryName := fmt.Sprintf("%v_secondary", namePrefix)
+	secondaryResourceVar := fmt.Sprintf("astro_cluster_node_pool.%v", secondaryName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { astronomerprovider.TestAccPreCheck(t) },
+		CheckDestroy:             testAccCheckClusterExistence(t, clusterName, true, false),
+		Steps: []resource.TestStep{
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + clusterConfig +
+					clusterNodePool(clusterNodePoolInput{
+						Name:             primaryName,
+						ClusterId:        clusterId,
+						NodeInstanceType: "m5.xlarge",
+						MaxNodeCount:     10,
+					}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(primaryResourceVar, "id"),
+					resource.TestCheckResourceAttrPair(primaryResourceVar, "cluster_id", fmt.Sprintf("astro_cluster.%v", clusterName), "id"),
+					resource.TestCheckResourceAttr(primaryResourceVar, "name", primaryName),
+					resource.TestCheckResourceAttr(primaryResourceVar, "node_instance_type", "m5.xlarge"),
+					resource.TestCheckResourceAttr(primaryResourceVar, "max_node_count", "10"),
+					resource.TestCheckResourceAttrSet(primaryResourceVar, "is_default"),
+					resource.TestCheckResourceAttr(primaryResourceVar, "cloud_provider", "AWS"),
+					testAccCheckClusterNodePools(t, clusterName, primaryName),
+				),
+			},
+			// Update a node pool while adding another one to the same cluster
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + clusterConfig +
+					clusterNodePool(clusterNodePoolInput{
+						Name:             primaryName,
+						ClusterId:        clusterId,
+						NodeInstanceType: "m5.xlarge",
+						MaxNodeCount:     20,
+					}) +
+					clusterNodePool(clusterNodePoolInput{
+						Name:             secondaryName,
+						ClusterId:        clusterId,
+						NodeInstanceType: "m5.2xlarge",
+						MaxNodeCount:     5,
+						IsDefault:        lo.ToPtr(true),
+					}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(primaryResourceVar, "max_node_count", "20"),
+					resource.TestCheckResourceAttr(secondaryResourceVar, "name", secondaryName),
+					resource.TestCheckResourceAttr(secondaryResourceVar, "node_instance_type", "m5.2xlarge"),
+					resource.TestCheckResourceAttr(secondaryResourceVar, "max_node_count", "5"),
+					resource.TestCheckResourceAttr(secondaryResourceVar, "is_default", "true"),
+					testAccCheckClusterNodePools(t, clusterName, primaryName, secondaryName),
+				),
+			},
+			// Import existing node pool
+			{
+				ResourceName:      primaryResourceVar,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					nodePool := state.RootModule().Resources[primaryResourceVar]
+					return fmt.Sprintf("%v/%v", nodePool.Primary.Attributes["cluster_id"], nodePool.Primary.ID), nil
+				},
+				ImportStateVerifyIgnore: []string{"is_default", "updated_at", "supported_astro_machines.#"},
+			},
+			// Removing a node pool leaves the other node pools of the cluster
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + clusterConfig +
+					clusterNodePool(clusterNodePoolInput{
+						Name:             secondaryName,
+						ClusterId:        clusterId,
+						NodeInstanceType: "m5.2xlarge",
+						MaxNodeCount:     5,
+						IsDefault:        lo.ToPtr(true),
+					}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterNodePools(t, clusterName, secondaryName),
+				),
+			},
+			// A node pool with an existing name is not taken over
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + clusterConfig +
+					clusterNodePool(clusterNodePoolInput{
+						Name:             secondaryName,
+						ClusterId:        clusterId,
+						NodeInstanceType: "m5.2xlarge",
+						MaxNodeCount:     5,
+						IsDefault:        lo.ToPtr(true),
+					}) +
+					strings.Replace(clusterNodePool(clusterNodePoolInput{
+						Name:             secondaryName,
+						ClusterId:        clusterId,
+						NodeInstanceType: "m5.2xlarge",
+						MaxNodeCount:     5,
+					}), fmt.Sprintf(`"%v"`, secondaryName), fmt.Sprintf(`"%v_duplicate"`, secondaryName), 1),
+				ExpectError: regexp.MustCompile("already exists in cluster"),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceClusterNodePoolHybrid(t *testing.T) {
+	name := utils.GenerateTestResourceName(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { astronomerprovider.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HYBRID) +
+					clusterNodePool(clusterNodePoolInput{
+						Name:             name,
+						ClusterId:        fmt.Sprintf("%q", os.Getenv("HYBRID_CLUSTER_ID")),
+						NodeInstanceType: "m5.xlarge",
+						MaxNodeCount:     10,
+					}),
+				ExpectError: regexp.MustCompile("Hybrid cluster node pools"),
+			},
+		},
+	})
+}
+
+type clusterNodePoolInput struct {
+	Name             string
+	ClusterId        string
+	NodeInstanceType string
+	MaxNodeCount     int
+	IsDefault        *bool
+}
+
+func clusterNodePool(input clusterNodePoolInput) string {
+	isDefault := ""
+	if input.IsDefault != nil {
+		isDefault = fmt.Sprintf("is_default = %v", *input.IsDefault)
+	}
+	return fmt.Sprintf(`
+resource "astro_cluster_node_pool" "%v" {
+	cluster_id = %v
+	name = "%v"
+	node_instance_type = "%v"
+	max_node_count = %v
+	%v
+}
+`, input.Name, input.ClusterId, input.Name, input.NodeInstanceType, input.MaxNodeCount, isDefault)
+}
+
+// testAccCheckClusterNodePools checks through the API that the node pools of the cluster are nodePoolNames
+func testAccCheckClusterNodePools(t *testing.T, clusterName string, nodePoolNames ...string) resource.TestCheckFunc {
+	t.Helper()
+	return func(state *terraform.State) error {
+		client, err := utils.GetTestHostedPlatformClient()
+		if err != nil {
+			return fmt.Errorf("failed to get platform client: %w", err)
+		}
+		clusterId := state.RootModule().Resources[fmt.Sprintf("astro_cluster.%v", clusterName)].Primary.ID
+		resp, err := client.GetClusterWithResponse(context.Background(), os.Getenv("HOSTED_ORGANIZATION_ID"), clusterId)
+		if err != nil {
+			return fmt.Errorf("failed to get cluster: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return fmt.Errorf("failed to get cluster %s: %s", clusterId, string(resp.Body))
+		}
+		names := lo.Map(lo.FromPtr(resp.JSON200.NodePools), func(nodePool platform.NodePool, _ int) string {
+			return nodePool.Name
+		})
+		sort.Strings(names)
+		sort.Strings(nodePoolNames)
+		if strings.Join(names, ",") != strings.Join(nodePoolNames, ",") {
+			return fmt.Errorf("expected node pools %v in cluster %s, got %v", nodePoolNames, clusterId, names)
+		}
+		return nil
+	}
+}
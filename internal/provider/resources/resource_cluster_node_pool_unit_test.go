@@ -0,0 +1,59 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+)
+
+func TestUnit_NodePoolRequests(t *testing.T) {
+	existing := []platform.NodePool{
+		{Id: "default-id", Name: "default", NodeInstanceType: "m5.xlarge", MaxNodeCount: 20, IsDefault: true},
+		{Id: "large-id", Name: "large", NodeInstanceType: "m5.4xlarge", MaxNodeCount: 5},
+	}
+	unchangedDefault := platform.UpdateNodePoolRequest{Id: lo.ToPtr("default-id"), Name: "default", NodeInstanceType: "m5.xlarge", MaxNodeCount: 20, IsDefault: lo.ToPtr(true)}
+	unchangedLarge := platform.UpdateNodePoolRequest{Id: lo.ToPtr("large-id"), Name: "large", NodeInstanceType: "m5.4xlarge", MaxNodeCount: 5, IsDefault: lo.ToPtr(false)}
+
+	t.Run("adds a node pool", func(t *testing.T) {
+		nodePool := platform.UpdateNodePoolRequest{Name: "small", NodeInstanceType: "m5.large", MaxNodeCount: 3}
+		assert.Equal(t, []platform.UpdateNodePoolRequest{unchangedDefault, unchangedLarge, nodePool}, nodePoolRequests(existing, "", &nodePool))
+	})
+
+	t.Run("updates only its node pool", func(t *testing.T) {
+		nodePool := platform.UpdateNodePoolRequest{Name: "large", NodeInstanceType: "m5.4xlarge", MaxNodeCount: 10}
+		assert.Equal(t, []platform.UpdateNodePoolRequest{
+			unchangedDefault,
+			{Id: lo.ToPtr("large-id"), Name: "large", NodeInstanceType: "m5.4xlarge", MaxNodeCount: 10, IsDefault: lo.ToPtr(false)},
+		}, nodePoolRequests(existing, "large-id", &nodePool))
+	})
+
+	t.Run("keeps the default node pool default when another node pool is updated", func(t *testing.T) {
+		nodePool := platform.UpdateNodePoolRequest{Name: "large", NodeInstanceType: "m5.4xlarge", MaxNodeCount: 10, IsDefault: lo.ToPtr(false)}
+		requests := nodePoolRequests(existing, "large-id", &nodePool)
+		assert.Equal(t, lo.ToPtr(true), requests[0].IsDefault)
+		assert.Equal(t, lo.ToPtr(false), requests[1].IsDefault)
+	})
+
+	t.Run("keeps the default of the updated node pool without a planned is_default", func(t *testing.T) {
+		nodePool := platform.UpdateNodePoolRequest{Name: "default", NodeInstanceType: "m5.xlarge", MaxNodeCount: 30}
+		assert.Equal(t, []platform.UpdateNodePoolRequest{
+			{Id: lo.ToPtr("default-id"), Name: "default", NodeInstanceType: "m5.xlarge", MaxNodeCount: 30, IsDefault: lo.ToPtr(true)},
+			unchangedLarge,
+		}, nodePoolRequests(existing, "default-id", &nodePool))
+	})
+
+	t.Run("makes the other node pools non-default", func(t *testing.T) {
+		nodePool := platform.UpdateNodePoolRequest{Name: "large", NodeInstanceType: "m5.4xlarge", MaxNodeCount: 5, IsDefault: lo.ToPtr(true)}
+		assert.Equal(t, []platform.UpdateNodePoolRequest{
+			{Id: lo.ToPtr("default-id"), Name: "default", NodeInstanceType: "m5.xlarge", MaxNodeCount: 20, IsDefault: lo.ToPtr(false)},
+			{Id: lo.ToPtr("large-id"), Name: "large", NodeInstanceType: "m5.4xlarge", MaxNodeCount: 5, IsDefault: lo.ToPtr(true)},
+		}, nodePoolRequests(existing, "large-id", &nodePool))
+	})
+
+	t.Run("removes its node pool", func(t *testing.T) {
+		assert.Equal(t, []platform.UpdateNodePoolRequest{unchangedDefault}, nodePoolRequests(existing, "large-id", nil))
+	})
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/astronomer/terraform-provider-astro/internal/clients"
 	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/fakeapi"
 	"github.com/astronomer/terraform-provider-astro/internal/utils"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
@@ -695,7 +696,7 @@ func TestAcc_ResourceClusterAzureEnableDrOnExistingCluster(t *testing.T) {
 			// Enable DR on the existing cluster via update
 			{
 				PreConfig: func() {
-					time.Sleep(2 * time.Minute)
+					time.Sleep(clusterWorkflowSettleTime())
 					waitForClusterStableState(t, azureClusterName)
 				},
 				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) +
@@ -718,7 +719,7 @@ func TestAcc_ResourceClusterAzureEnableDrOnExistingCluster(t *testing.T) {
 			// step needs an explicit `is_dr_enabled = false` to exercise the disable path)
 			{
 				PreConfig: func() {
-					time.Sleep(2 * time.Minute)
+					time.Sleep(clusterWorkflowSettleTime())
 					// The enable-DR step above can leave a background reconciliation workflow
 					// running well past the point where the API reports the cluster CREATED.
 					// Wait for that workflow to reach an actual terminal state - success or
@@ -1022,14 +1023,19 @@ resource "astro_cluster" "%v" {
 }
 
 type dedicatedDeploymentInput struct {
-	ClusterId     string
-	WorkspaceId   string
-	Name          string
-	Description   string
-	SchedulerSize string
+	ClusterId          string
+	WorkspaceId        string
+	Name               string
+	Description        string
+	SchedulerSize      string
+	DrWorkloadIdentity string
 }
 
 func dedicatedDeployment(input dedicatedDeploymentInput) string {
+	drWorkloadIdentityStr := ""
+	if input.DrWorkloadIdentity != "" {
+		drWorkloadIdentityStr = fmt.Sprintf(`dr_workload_identity = "%s"`, input.DrWorkloadIdentity)
+	}
 	return fmt.Sprintf(`
 resource "astro_deployment" "%v" {
 	name = "%s"
@@ -1049,8 +1055,9 @@ resource "astro_deployment" "%v" {
 	scheduler_size = "%v"
 	workspace_id = %s
 	environment_variables = []
+	%v
 }
-`, input.Name, input.Name, input.Description, input.ClusterId, input.SchedulerSize, input.WorkspaceId)
+`, input.Name, input.Name, input.Description, input.ClusterId, input.SchedulerSize, input.WorkspaceId, drWorkloadIdentityStr)
 }
 
 func dedicatedDeploymentWithAstroExecutor(input dedicatedDeploymentInput) string {
@@ -1241,6 +1248,15 @@ func deleteClusterOutsideOfTerraform(t *testing.T, name string) {
 	assert.NoError(t, err)
 }
 
+// clusterWorkflowSettleTime returns how long to let the background workflows of a cluster settle before updating it.
+// The fake API has no background workflows.
+func clusterWorkflowSettleTime() time.Duration {
+	if fakeapi.Enabled() {
+		return 0
+	}
+	return 2 * time.Minute
+}
+
 func waitForClusterStableState(t *testing.T, name string) {
 	t.Helper()
 
@@ -0,0 +1,319 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &connectionResource{}
+var _ resource.ResourceWithImportState = &connectionResource{}
+var _ resource.ResourceWithConfigure = &connectionResource{}
+var _ resource.ResourceWithValidateConfig = &connectionResource{}
+var _ resource.ResourceWithMoveState = &connectionResource{}
+var _ resource.ResourceWithModifyPlan = &connectionResource{}
+var _ resource.ResourceWithIdentity = &connectionResource{}
+
+// connectionIdentity identifies an astro_connection by its ID
+var connectionIdentity = resourceIdentity{
+	{name: "id", description: "Connection identifier"},
+}
+
+func NewConnectionResource() resource.Resource {
+	return &connectionResource{}
+}
+
+// connectionResource defines the resource implementation.
+type connectionResource struct {
+	platformClient   *platform.ClientWithResponses
+	platformV1Client *platform_v1.ClientWithResponses
+	organizationId   string
+}
+
+func (r *connectionResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_connection"
+}
+
+func (r *connectionResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Connection resource. Manages an Airflow connection scoped to a Workspace or Deployment, an environment object with `object_type=CONNECTION`.",
+		Attributes:          schemas.ConnectionResourceSchemaAttributes(),
+	}
+}
+
+func (r *connectionResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = connectionIdentity.Schema()
+}
+
+func (r *connectionResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClients, ok := req.ProviderData.(models.ApiClientsModel)
+	if !ok {
+		utils.ResourceApiClientConfigureError(ctx, req, resp)
+		return
+	}
+
+	r.platformClient = apiClients.PlatformClient
+	r.platformV1Client = apiClients.PlatformV1Client
+	r.organizationId = apiClients.OrganizationId
+}
+
+func (r *connectionResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data models.Connection
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envObj, diags := data.EnvironmentObject(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(createEnvironmentObject(ctx, r.platformV1Client, r.organizationId, &envObj, &resp.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.ReadFromEnvironmentObject(ctx, &envObj)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created a connection resource: %v", data.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(connectionIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
+}
+
+func (r *connectionResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data models.Connection
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(connectionIdentity.Set(ctx, r.organizationId, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envObj, diags := data.EnvironmentObject(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := readEnvironmentObject(ctx, r.platformV1Client, r.organizationId, &envObj)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(validateEnvironmentObjectType(&envObj, platform_v1.CreateEnvironmentObjectRequestObjectTypeCONNECTION, "astro_connection")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.ReadFromEnvironmentObject(ctx, &envObj)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("read a connection resource: %v", data.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *connectionResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data models.Connection
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envObj, diags := data.EnvironmentObject(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateEnvironmentObject(ctx, r.platformV1Client, r.organizationId, &envObj)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.ReadFromEnvironmentObject(ctx, &envObj)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated a connection resource: %v", data.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(connectionIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
+}
+
+func (r *connectionResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data models.Connection
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(deleteEnvironmentObject(ctx, r.platformV1Client, r.organizationId, data.Id.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted a connection resource: %v", data.Id.ValueString()))
+}
+
+func (r *connectionResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data models.Connection
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan, diags := data.EnvironmentObject(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *models.EnvironmentObject
+	if !req.State.Raw.IsNull() {
+		var stateData models.Connection
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		envObj, diags := stateData.EnvironmentObject(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state = &envObj
+	}
+
+	if planConnectionUri(&plan, state, resp) {
+		resp.Diagnostics.Append(data.ReadFromEnvironmentObject(ctx, &plan)...)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+	}
+}
+
+func (r *connectionResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	importStateByName(ctx, connectionIdentity, r.organizationId, path.Root("id"), req, resp, func(ctx context.Context, id string) (string, diag.Diagnostics) {
+		return resolveEnvironmentObjectImportId(ctx, r.platformClient, r.platformV1Client, r.organizationId, id, "connection", lo.ToPtr(platform_v1.CONNECTION))
+	})
+}
+
+func (r *connectionResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		environmentObjectStateMover(platform_v1.CreateEnvironmentObjectRequestObjectTypeCONNECTION,
+			func(ctx context.Context, envObj *models.EnvironmentObject, state *tfsdk.State) diag.Diagnostics {
+				var data models.Connection
+				diags := data.ReadFromEnvironmentObject(ctx, envObj)
+				if diags.HasError() {
+					return diags
+				}
+				return state.Set(ctx, &data)
+			}),
+	}
+}
+
+// ValidateConfig validates the `uri` or `extra` JSON at plan time and the scope of the links, with the same rules as
+// astro_environment_object with object_type=CONNECTION.
+func (r *connectionResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data models.Connection
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envObj, diags := data.EnvironmentObject(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateConnectionFields(&envObj)...)
+	resp.Diagnostics.Append(validateScopeFields(&envObj)...)
+}
@@ -21,6 +21,12 @@ import (
 var _ resource.Resource = &customRoleResource{}
 var _ resource.ResourceWithImportState = &customRoleResource{}
 var _ resource.ResourceWithConfigure = &customRoleResource{}
+var _ resource.ResourceWithIdentity = &customRoleResource{}
+
+// customRoleIdentity identifies an astro_custom_role by its ID
+var customRoleIdentity = resourceIdentity{
+	{name: "id", description: "Custom role identifier"},
+}
 
 func NewCustomRoleResource() resource.Resource {
 	return &customRoleResource{}
@@ -52,6 +58,14 @@ func (r *customRoleResource) Schema(
 	}
 }
 
+func (r *customRoleResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = customRoleIdentity.Schema()
+}
+
 func (r *customRoleResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -145,6 +159,7 @@ func (r *customRoleResource) Create(
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(customRoleIdentity.Set(ctx, r.OrganizationId, resp.State, resp.Identity)...)
 }
 
 func (r *customRoleResource) Read(
@@ -157,6 +172,10 @@ func (r *customRoleResource) Read(
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(customRoleIdentity.Set(ctx, r.OrganizationId, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -272,6 +291,7 @@ func (r *customRoleResource) Update(
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(customRoleIdentity.Set(ctx, r.OrganizationId, resp.State, resp.Identity)...)
 }
 
 func (r *customRoleResource) Delete(
@@ -317,5 +337,5 @@ func (r *customRoleResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	customRoleIdentity.ImportStatePassthrough(ctx, r.OrganizationId, path.Root("id"), req, resp)
 }
@@ -26,7 +26,15 @@ import (
 var _ resource.Resource = &DeploymentResource{}
 var _ resource.ResourceWithImportState = &DeploymentResource{}
 var _ resource.ResourceWithConfigure = &DeploymentResource{}
+var _ resource.ResourceWithModifyPlan = &DeploymentResource{}
 var _ resource.ResourceWithValidateConfig = &DeploymentResource{}
+var _ resource.ResourceWithUpgradeState = &DeploymentResource{}
+var _ resource.ResourceWithIdentity = &DeploymentResource{}
+
+// deploymentIdentity identifies an astro_deployment by its ID
+var deploymentIdentity = resourceIdentity{
+	{name: "id", description: "Deployment identifier"},
+}
 
 func NewDeploymentResource() resource.Resource {
 	return &DeploymentResource{}
@@ -34,8 +42,10 @@ func NewDeploymentResource() resource.Resource {
 
 // DeploymentResource defines the resource implementation.
 type DeploymentResource struct {
-	platformClient *platform.ClientWithResponses
-	organizationId string
+	platformClient     *platform.ClientWithResponses
+	organizationId     string
+	deploymentDefaults models.DeploymentDefaults
+	workspaceTransfer  deploymentWorkspaceTransfer
 }
 
 func (r *DeploymentResource) Metadata(
@@ -52,12 +62,22 @@ func (r *DeploymentResource) Schema(
 	resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
+		// Version 1 upgrades the state of the earlier releases, see rawStateUpgrader
+		Version: 1,
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Deployment resource",
 		Attributes:          schemas.DeploymentResourceSchemaAttributes(),
 	}
 }
 
+func (r *DeploymentResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = deploymentIdentity.Schema()
+}
+
 func (r *DeploymentResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -76,6 +96,13 @@ func (r *DeploymentResource) Configure(
 
 	r.platformClient = apiClients.PlatformClient
 	r.organizationId = apiClients.OrganizationId
+	r.deploymentDefaults = apiClients.DeploymentDefaults
+	r.workspaceTransfer = deploymentWorkspaceTransfer{
+		platformClient:   apiClients.PlatformClient,
+		platformV1Client: apiClients.PlatformV1Client,
+		iamClient:        apiClients.IamClient,
+		organizationId:   apiClients.OrganizationId,
+	}
 }
 
 func (r *DeploymentResource) Create(
@@ -107,6 +134,7 @@ func (r *DeploymentResource) Create(
 	}
 
 	desiredWorkloadIdentity := data.DesiredWorkloadIdentity.ValueString()
+	drWorkloadIdentity := data.DrWorkloadIdentity.ValueString()
 
 	switch data.Type.ValueString() {
 	case string(platform.DeploymentTypeSTANDARD):
@@ -132,6 +160,9 @@ func (r *DeploymentResource) Create(
 		if desiredWorkloadIdentity != "" {
 			createStandardDeploymentRequest.WorkloadIdentity = &desiredWorkloadIdentity
 		}
+		if drWorkloadIdentity != "" {
+			createStandardDeploymentRequest.DrWorkloadIdentity = &drWorkloadIdentity
+		}
 
 		// contact emails
 		contactEmails, diags := utils.TypesSetToStringSlice(ctx, data.ContactEmails)
@@ -195,6 +226,9 @@ func (r *DeploymentResource) Create(
 		if desiredWorkloadIdentity != "" {
 			createDedicatedDeploymentRequest.WorkloadIdentity = &desiredWorkloadIdentity
 		}
+		if drWorkloadIdentity != "" {
+			createDedicatedDeploymentRequest.DrWorkloadIdentity = &drWorkloadIdentity
+		}
 
 		// contact emails
 		contactEmails, diags := utils.TypesSetToStringSlice(ctx, data.ContactEmails)
@@ -264,6 +298,9 @@ func (r *DeploymentResource) Create(
 		if desiredWorkloadIdentity != "" {
 			createHybridDeploymentRequest.WorkloadIdentity = &desiredWorkloadIdentity
 		}
+		if drWorkloadIdentity != "" {
+			createHybridDeploymentRequest.DrWorkloadIdentity = &drWorkloadIdentity
+		}
 
 		// contact emails
 		contactEmails, diags := utils.TypesSetToStringSlice(ctx, data.ContactEmails)
@@ -324,10 +361,13 @@ func (r *DeploymentResource) Create(
 		return
 	}
 
+	data.RuntimeSupportStatus = r.readRuntimeSupportStatus(ctx, &data)
+
 	tflog.Trace(ctx, fmt.Sprintf("created a deployment resource: %v", data.Id.ValueString()))
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(deploymentIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *DeploymentResource) Read(
@@ -342,6 +382,10 @@ func (r *DeploymentResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(deploymentIdentity.Set(ctx, r.organizationId, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	envVars, diags := RequestDeploymentEnvironmentVariables(ctx, data.EnvironmentVariables)
 	if diags.HasError() {
@@ -381,6 +425,8 @@ func (r *DeploymentResource) Read(
 		return
 	}
 
+	data.RuntimeSupportStatus = r.readRuntimeSupportStatus(ctx, &data)
+
 	tflog.Trace(ctx, fmt.Sprintf("read a deployment resource: %v", data.Id.ValueString()))
 
 	// Save data into Terraform state
@@ -406,6 +452,7 @@ func (r *DeploymentResource) Update(
 	var envVars []platform.DeploymentEnvironmentVariableRequest
 
 	desiredWorkloadIdentity := data.DesiredWorkloadIdentity.ValueString()
+	drWorkloadIdentity := data.DrWorkloadIdentity.ValueString()
 
 	switch data.Type.ValueString() {
 	case string(platform.DeploymentTypeSTANDARD):
@@ -429,6 +476,9 @@ func (r *DeploymentResource) Update(
 		if desiredWorkloadIdentity != "" {
 			updateStandardDeploymentRequest.WorkloadIdentity = &desiredWorkloadIdentity
 		}
+		if drWorkloadIdentity != "" {
+			updateStandardDeploymentRequest.DrWorkloadIdentity = &drWorkloadIdentity
+		}
 
 		// contact emails
 		contactEmails, diags := utils.TypesSetToStringSlice(ctx, data.ContactEmails)
@@ -491,6 +541,9 @@ func (r *DeploymentResource) Update(
 		if desiredWorkloadIdentity != "" {
 			updateDedicatedDeploymentRequest.WorkloadIdentity = &desiredWorkloadIdentity
 		}
+		if drWorkloadIdentity != "" {
+			updateDedicatedDeploymentRequest.DrWorkloadIdentity = &drWorkloadIdentity
+		}
 
 		// contact emails
 		contactEmails, diags := utils.TypesSetToStringSlice(ctx, data.ContactEmails)
@@ -558,6 +611,9 @@ func (r *DeploymentResource) Update(
 		if desiredWorkloadIdentity != "" {
 			updateHybridDeploymentRequest.WorkloadIdentity = &desiredWorkloadIdentity
 		}
+		if drWorkloadIdentity != "" {
+			updateHybridDeploymentRequest.DrWorkloadIdentity = &drWorkloadIdentity
+		}
 
 		// contact emails
 		contactEmails, diags := utils.TypesSetToStringSlice(ctx, data.ContactEmails)
@@ -619,10 +675,16 @@ func (r *DeploymentResource) Update(
 		return
 	}
 
+	// The runtime version cannot change on update, the status planned from the state is kept until the next refresh
+	if data.RuntimeSupportStatus.IsUnknown() {
+		data.RuntimeSupportStatus = r.readRuntimeSupportStatus(ctx, &data)
+	}
+
 	tflog.Trace(ctx, fmt.Sprintf("updated a deployment resource: %v", data.Id.ValueString()))
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(deploymentIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *DeploymentResource) Delete(
@@ -639,6 +701,11 @@ func (r *DeploymentResource) Delete(
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(DeletionProtectionDiagnostic("Deployment", data.Id.ValueString(), "destroy"))
+		return
+	}
+
 	deployment, deleteErr := r.platformClient.DeleteDeploymentWithResponse(
 		ctx,
 		r.organizationId,
@@ -662,12 +729,74 @@ func (r *DeploymentResource) Delete(
 	tflog.Trace(ctx, fmt.Sprintf("deleted a deployment resource: %v", data.Id.ValueString()))
 }
 
+// ModifyPlan blocks destroy and replace plans while deletion_protection is enabled in the prior state, fills
+// in the attributes that are not set on the resource from the provider deployment_defaults, reports deprecated
+// runtime versions and checks a move to another workspace.
+func (r *DeploymentResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	ModifyPlanDeletionProtection(ctx, req, resp, "Deployment", deploymentRequiresReplace)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	ModifyPlanDeploymentDefaults(ctx, req, resp, r.deploymentDefaults)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.ModifyPlanRuntimeSupport(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.workspaceTransfer.ModifyPlan(ctx, req, resp)
+}
+
+// deploymentRequiresReplace returns whether the plan replaces the deployment, following the RequiresReplace plan
+// modifiers of its schema
+func deploymentRequiresReplace(ctx context.Context, req resource.ModifyPlanRequest) (bool, diag.Diagnostics) {
+	replace, diags := RequiresReplaceIfConfigured(
+		path.Root("type"),
+		path.Root("cloud_provider"),
+		path.Root("region"),
+		path.Root("cluster_id"),
+	)(ctx, req)
+	if replace || diags.HasError() {
+		return replace, diags
+	}
+
+	// A deployment is recreated to turn on development mode
+	var configIsDevelopmentMode, stateIsDevelopmentMode types.Bool
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("is_development_mode"), &configIsDevelopmentMode)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("is_development_mode"), &stateIsDevelopmentMode)...)
+	if diags.HasError() {
+		return false, diags
+	}
+	return configIsDevelopmentMode.ValueBool() && !stateIsDevelopmentMode.ValueBool(), diags
+}
+
 func (r *DeploymentResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, deploymentIdentity, r.organizationId, path.Root("id"), req, resp, func(ctx context.Context, id string) (string, diag.Diagnostics) {
+		return resolveDeploymentImportId(ctx, r.platformClient, r.organizationId, id)
+	})
+}
+
+func (r *DeploymentResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: rawStateUpgrader(),
+	}
 }
 
 // ValidateConfig validates the configuration of the resource as a whole before any operations are performed.
@@ -684,29 +813,39 @@ func (r *DeploymentResource) ValidateConfig(
 		return
 	}
 
+	resp.Diagnostics.Append(validateDeploymentConfig(ctx, &data)...)
+}
+
+// validateDeploymentConfig runs the configuration checks shared by ValidateConfig and ModifyPlan, where the
+// configuration is validated again once the provider deployment_defaults have been applied
+func validateDeploymentConfig(ctx context.Context, data *models.DeploymentResource) diag.Diagnostics {
+	diags := make(diag.Diagnostics, 0)
+
 	// Block ASTRO executor for HYBRID deployments
 	if data.Executor.ValueString() == string(platform.DeploymentExecutorASTRO) &&
 		data.Type.ValueString() == string(platform.DeploymentTypeHYBRID) {
-		resp.Diagnostics.AddAttributeError(
+		diags.AddAttributeError(
 			path.Root("executor"),
 			"ASTRO executor is not allowed for HYBRID deployments",
 			"The 'ASTRO' executor cannot be used with deployment type 'HYBRID'.",
 		)
-		return
+		return diags
 	}
 
 	// Type specific validation
 	switch platform.DeploymentType(data.Type.ValueString()) {
 	case platform.DeploymentTypeSTANDARD:
-		resp.Diagnostics.Append(validateStandardConfig(ctx, &data)...)
-		resp.Diagnostics.Append(validateHostedConfig(ctx, &data)...)
+		diags.Append(validateStandardConfig(ctx, data)...)
+		diags.Append(validateHostedConfig(ctx, data)...)
 	case platform.DeploymentTypeDEDICATED:
-		resp.Diagnostics.Append(validateHostedConfig(ctx, &data)...)
-		resp.Diagnostics.Append(validateClusterIdConfig(ctx, &data)...)
+		diags.Append(validateHostedConfig(ctx, data)...)
+		diags.Append(validateClusterIdConfig(ctx, data)...)
 	case platform.DeploymentTypeHYBRID:
-		resp.Diagnostics.Append(validateHybridConfig(ctx, &data)...)
-		resp.Diagnostics.Append(validateClusterIdConfig(ctx, &data)...)
+		diags.Append(validateHybridConfig(ctx, data)...)
+		diags.Append(validateClusterIdConfig(ctx, data)...)
 	}
+
+	return diags
 }
 
 func validateHybridConfig(ctx context.Context, data *models.DeploymentResource) diag.Diagnostics {
@@ -857,12 +996,6 @@ func validateStandardConfig(ctx context.Context, data *models.DeploymentResource
 func validateHostedConfig(ctx context.Context, data *models.DeploymentResource) diag.Diagnostics {
 	// Required hosted values
 	diags := make(diag.Diagnostics, 0)
-	if data.SchedulerSize.IsNull() {
-		diags.AddError(
-			"scheduler_size is required for 'STANDARD' and 'DEDICATED' deployment",
-			"Please provide a scheduler_size",
-		)
-	}
 	if data.IsHighAvailability.IsNull() {
 		diags.AddError(
 			"is_high_availability is required for 'STANDARD' and 'DEDICATED' deployment",
@@ -916,6 +916,249 @@ func TestAcc_ResourceDeploymentStandardRemovedOutsideOfTerraform(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceDeploymentWorkspaceTransfer(t *testing.T) {
+	namePrefix := utils.GenerateTestResourceName(10)
+
+	standardDeploymentName := fmt.Sprintf("%v_standard", namePrefix)
+	standardDeploymentResource := fmt.Sprintf("astro_deployment.%v", standardDeploymentName)
+	targetWorkspaceName := fmt.Sprintf("%v_target", namePrefix)
+	standardConfig := standardDeployment(standardDeploymentInput{
+		Name:          standardDeploymentName,
+		Description:   utils.TestResourceDescription,
+		Region:        "us-west-2",
+		CloudProvider: "AWS",
+		Executor:      "KUBERNETES",
+		SchedulerSize: string(platform.SchedulerMachineNameSMALL),
+	}) + workspace(targetWorkspaceName, targetWorkspaceName, utils.TestResourceDescription, false)
+	transferredConfig := strings.Replace(standardConfig,
+		fmt.Sprintf("workspace_id = astro_workspace.%v_workspace.id", standardDeploymentName),
+		fmt.Sprintf("workspace_id = astro_workspace.%v.id", targetWorkspaceName), 1)
+
+	dedicatedDeploymentName := fmt.Sprintf("%v_dedicated", namePrefix)
+	dedicatedConfig := func(workspaceId string) string {
+		return workspace(targetWorkspaceName, targetWorkspaceName, utils.TestResourceDescription, false) +
+			dedicatedDeployment(dedicatedDeploymentInput{
+				ClusterId:     fmt.Sprintf(`"%v"`, os.Getenv("HOSTED_DEDICATED_CLUSTER_ID")),
+				WorkspaceId:   workspaceId,
+				Name:          dedicatedDeploymentName,
+				Description:   utils.TestResourceDescription,
+				SchedulerSize: "SMALL",
+			})
+	}
+
+	var deploymentId string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { astronomerprovider.TestAccPreCheck(t) },
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckDeploymentExistence(t, standardDeploymentName, true, false),
+			testAccCheckDeploymentExistence(t, dedicatedDeploymentName, true, false),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + standardConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(standardDeploymentResource, "workspace_id", fmt.Sprintf("astro_workspace.%v_workspace", standardDeploymentName), "id"),
+					resource.TestCheckResourceAttrWith(standardDeploymentResource, "id", func(value string) error {
+						deploymentId = value
+						return nil
+					}),
+				),
+			},
+			// Changing workspace_id moves the deployment in place
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + transferredConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(standardDeploymentResource, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(standardDeploymentResource, "workspace_id", fmt.Sprintf("astro_workspace.%v", targetWorkspaceName), "id"),
+					resource.TestCheckResourceAttrWith(standardDeploymentResource, "id", func(value string) error {
+						if value != deploymentId {
+							return fmt.Errorf("expected the deployment %v to be moved in place, got %v", deploymentId, value)
+						}
+						return nil
+					}),
+					testAccCheckDeploymentExistence(t, standardDeploymentName, true, true),
+				),
+			},
+			// The target workspace of a dedicated deployment must be authorized for its cluster
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + dedicatedConfig(fmt.Sprintf(`"%v"`, os.Getenv("HOSTED_WORKSPACE_ID"))),
+			},
+			{
+				Config:      astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + dedicatedConfig(fmt.Sprintf("astro_workspace.%v.id", targetWorkspaceName)),
+				ExpectError: regexp.MustCompile(`Workspace not authorized for cluster`),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceDeploymentDrWorkloadIdentity(t *testing.T) {
+	namePrefix := utils.GenerateTestResourceName(10)
+
+	clusterName := fmt.Sprintf("%v_dr", namePrefix)
+	clusterConfig := cluster(clusterInput{
+		Name:          clusterName,
+		Region:        "us-east-1",
+		CloudProvider: "AWS",
+		IsDrEnabled:   true,
+		DrRegion:      "us-west-2",
+	})
+	dedicatedDeploymentName := fmt.Sprintf("%v_dedicated", namePrefix)
+	dedicatedResourceVar := fmt.Sprintf("astro_deployment.%v", dedicatedDeploymentName)
+	dedicatedConfig := func(drWorkloadIdentity string) string {
+		return clusterConfig + dedicatedDeployment(dedicatedDeploymentInput{
+			ClusterId:          fmt.Sprintf("astro_cluster.%v.id", clusterName),
+			WorkspaceId:        fmt.Sprintf(`"%v"`, os.Getenv("HOSTED_WORKSPACE_ID")),
+			Name:               dedicatedDeploymentName,
+			Description:        utils.TestResourceDescription,
+			SchedulerSize:      "SMALL",
+			DrWorkloadIdentity: drWorkloadIdentity,
+		})
+	}
+
+	standardDeploymentName := fmt.Sprintf("%v_standard", namePrefix)
+	standardResourceVar := fmt.Sprintf("astro_deployment.%v", standardDeploymentName)
+	standardInput := standardDeploymentInput{
+		Name:          standardDeploymentName,
+		Description:   utils.TestResourceDescription,
+		Region:        "us-west-2",
+		CloudProvider: "AWS",
+		Executor:      "CELERY",
+		SchedulerSize: string(platform.SchedulerMachineNameSMALL),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { astronomerprovider.TestAccPreCheck(t) },
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckDeploymentExistence(t, dedicatedDeploymentName, true, false),
+			testAccCheckDeploymentExistence(t, standardDeploymentName, true, false),
+		),
+		Steps: []resource.TestStep{
+			// The DR workload identity is assigned automatically when it is not set
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + dedicatedConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dedicatedResourceVar, "dr_workload_identity"),
+					resource.TestCheckResourceAttr(dedicatedResourceVar, "dr_external_ips.#", "1"),
+					resource.TestCheckResourceAttrSet(dedicatedResourceVar, "dr_oidc_issuer_url"),
+				),
+			},
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + dedicatedConfig("arn:aws:iam::123456789012:role/dr-role") + fmt.Sprintf(`
+data "astro_deployment" "%v" {
+	id = %v.id
+}`, dedicatedDeploymentName, dedicatedResourceVar),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(dedicatedResourceVar, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dedicatedResourceVar, "dr_workload_identity", "arn:aws:iam::123456789012:role/dr-role"),
+					resource.TestCheckResourceAttr(dedicatedResourceVar, "dr_external_ips.#", "1"),
+					// The data source reads the DR attributes of the deployment
+					resource.TestCheckResourceAttrPair("data."+dedicatedResourceVar, "dr_workload_identity", dedicatedResourceVar, "dr_workload_identity"),
+					resource.TestCheckResourceAttrPair("data."+dedicatedResourceVar, "dr_external_ips.0", dedicatedResourceVar, "dr_external_ips.0"),
+					resource.TestCheckResourceAttrPair("data."+dedicatedResourceVar, "dr_oidc_issuer_url", dedicatedResourceVar, "dr_oidc_issuer_url"),
+				),
+			},
+			// Import existing deployment
+			{
+				ResourceName:      dedicatedResourceVar,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// The DR workload identity of a STANDARD deployment can be changed too
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + standardDeployment(standardInput),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(standardResourceVar, "dr_external_ips.#", "0"),
+				),
+			},
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + standardDeployment(standardDeploymentInput{
+					Name:               standardInput.Name,
+					Description:        standardInput.Description,
+					Region:             standardInput.Region,
+					CloudProvider:      standardInput.CloudProvider,
+					Executor:           standardInput.Executor,
+					SchedulerSize:      standardInput.SchedulerSize,
+					DrWorkloadIdentity: "arn:aws:iam::123456789012:role/dr-role",
+				}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(standardResourceVar, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(standardResourceVar, "dr_workload_identity", "arn:aws:iam::123456789012:role/dr-role"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceDeploymentRuntimeSupport(t *testing.T) {
+	deploymentName := utils.GenerateTestResourceName(10)
+	resourceVar := fmt.Sprintf("astro_deployment.%v", deploymentName)
+	deprecatedDeployment := func(severity string) string {
+		return standardDeployment(standardDeploymentInput{
+			Name:          deploymentName,
+			Description:   utils.TestResourceDescription,
+			Region:        "us-west-2",
+			CloudProvider: "AWS",
+			Executor:      "KUBERNETES",
+			SchedulerSize: string(platform.SchedulerMachineNameSMALL),
+			RuntimeStr: fmt.Sprintf(`original_astro_runtime_version = "9.18.0"
+	runtime_deprecation_severity = "%v"`, severity),
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { astronomerprovider.TestAccPreCheck(t) },
+		CheckDestroy:             testAccCheckDeploymentExistence(t, deploymentName, true, false),
+		Steps: []resource.TestStep{
+			{
+				Config:      astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + deprecatedDeployment("ERROR"),
+				ExpectError: regexp.MustCompile(`Deprecated Astro Runtime version`),
+			},
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + deprecatedDeployment("WARNING"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceVar, "astro_runtime_version", "9.18.0"),
+					resource.TestCheckResourceAttr(resourceVar, "runtime_support_status", "DEPRECATED"),
+					resource.TestCheckResourceAttr(resourceVar, "runtime_deprecation_severity", "WARNING"),
+				),
+			},
+			// Escalating to an error fails the plans of the existing deployment
+			{
+				Config:      astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + deprecatedDeployment("ERROR"),
+				ExpectError: regexp.MustCompile(`Astro Runtime 9.18.0 is deprecated`),
+			},
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + deprecatedDeployment("NONE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceVar, "runtime_support_status", "DEPRECATED"),
+					resource.TestCheckResourceAttr(resourceVar, "runtime_deprecation_severity", "NONE"),
+				),
+			},
+			// Import
+			{
+				ResourceName:            resourceVar,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"runtime_deprecation_severity"},
+			},
+		},
+	})
+}
+
 func workerQueuesStr(nodePoolId string) string {
 	workerStr := `astro_machine = "A5"`
 	if nodePoolId != "" {
@@ -1051,7 +1294,9 @@ type standardDeploymentInput struct {
 	ScalingSpec                 string
 	WorkerQueuesStr             string
 	DesiredWorkloadIdentity     string
+	DrWorkloadIdentity          string
 	RemoteExecutionStr          string
+	RuntimeStr                  string
 }
 
 func standardDeployment(input standardDeploymentInput) string {
@@ -1081,6 +1326,10 @@ func standardDeployment(input standardDeploymentInput) string {
 	if input.DesiredWorkloadIdentity != "" {
 		desiredWorkloadIdentityStr = fmt.Sprintf(`desired_workload_identity      = "%s"`, input.DesiredWorkloadIdentity)
 	}
+	drWorkloadIdentityStr := ""
+	if input.DrWorkloadIdentity != "" {
+		drWorkloadIdentityStr = fmt.Sprintf(`dr_workload_identity = "%s"`, input.DrWorkloadIdentity)
+	}
 	return fmt.Sprintf(`
 resource "astro_workspace" "%v_workspace" {
 	name = "%s"
@@ -1111,10 +1360,12 @@ resource "astro_deployment" "%v" {
     %v
     %v
 	%v
+	%v
+	%v
 }
 `,
 		input.Name, input.Name, utils.TestResourceDescription, input.Name, input.Name, input.Description, input.Region, input.CloudProvider, input.Executor, input.IsDevelopmentMode, input.SchedulerSize, input.Name,
-		envVarsStr(input.IncludeEnvironmentVariables), input.WorkerQueuesStr, scalingSpecStr, desiredWorkloadIdentityStr, input.RemoteExecutionStr)
+		envVarsStr(input.IncludeEnvironmentVariables), input.WorkerQueuesStr, scalingSpecStr, desiredWorkloadIdentityStr, drWorkloadIdentityStr, input.RemoteExecutionStr, input.RuntimeStr)
 }
 
 func standardDeploymentWithVariableName(input standardDeploymentInput) string {
@@ -7,7 +7,9 @@ import (
 	"net/http"
 
 	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
 	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/common"
 	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
 	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
 	"github.com/astronomer/terraform-provider-astro/internal/utils"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -26,6 +29,15 @@ var _ resource.Resource = &environmentObjectResource{}
 var _ resource.ResourceWithImportState = &environmentObjectResource{}
 var _ resource.ResourceWithConfigure = &environmentObjectResource{}
 var _ resource.ResourceWithValidateConfig = &environmentObjectResource{}
+var _ resource.ResourceWithModifyPlan = &environmentObjectResource{}
+var _ resource.ResourceWithMoveState = &environmentObjectResource{}
+var _ resource.ResourceWithUpgradeState = &environmentObjectResource{}
+var _ resource.ResourceWithIdentity = &environmentObjectResource{}
+
+// environmentObjectIdentity identifies an astro_environment_object by its ID
+var environmentObjectIdentity = resourceIdentity{
+	{name: "id", description: "Environment object identifier"},
+}
 
 func NewEnvironmentObjectResource() resource.Resource {
 	return &environmentObjectResource{}
@@ -33,6 +45,7 @@ func NewEnvironmentObjectResource() resource.Resource {
 
 // environmentObjectResource defines the resource implementation.
 type environmentObjectResource struct {
+	platformClient   *platform.ClientWithResponses
 	platformV1Client *platform_v1.ClientWithResponses
 	organizationId   string
 }
@@ -51,12 +64,22 @@ func (r *environmentObjectResource) Schema(
 	resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
+		// Version 1 upgrades the state of the earlier releases, see rawStateUpgrader
+		Version: 1,
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Environment Object resource. Manages Airflow connections, variables, and metrics exports scoped to a Workspace or Deployment.",
 		Attributes:          schemas.EnvironmentObjectResourceSchemaAttributes(),
 	}
 }
 
+func (r *environmentObjectResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = environmentObjectIdentity.Schema()
+}
+
 func (r *environmentObjectResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -73,6 +96,7 @@ func (r *environmentObjectResource) Configure(
 		return
 	}
 
+	r.platformClient = apiClients.PlatformClient
 	r.platformV1Client = apiClients.PlatformV1Client
 	r.organizationId = apiClients.OrganizationId
 }
@@ -90,65 +114,75 @@ func (r *environmentObjectResource) Create(
 		return
 	}
 
-	preserve, diags := buildPreserveFromModel(ctx, &data)
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(createEnvironmentObject(ctx, r.platformV1Client, r.organizationId, &data, &resp.State)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	createReq, diags := buildCreateRequest(ctx, &data)
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-		return
-	}
+	tflog.Trace(ctx, fmt.Sprintf("created an environment object resource: %v", data.Id.ValueString()))
 
-	createResp, err := r.platformV1Client.CreateEnvironmentObjectWithResponse(ctx, r.organizationId, createReq)
-	if err != nil {
-		tflog.Error(ctx, "failed to create environment object", map[string]interface{}{"error": err})
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create environment object, got error: %s", err))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(environmentObjectIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
+}
+
+func (r *environmentObjectResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data models.EnvironmentObject
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, createResp.HTTPResponse, createResp.Body, createResp.JSON200, "create environment object")
-	if diagnostic != nil {
-		resp.Diagnostics.Append(diagnostic)
+	resp.Diagnostics.Append(environmentObjectIdentity.Set(ctx, r.organizationId, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Persist the ID immediately so a failure in the follow-up GET leaves a
-	// refreshable row in state instead of orphaning the just-created object.
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), createResp.JSON200.Id)...)
+	found, diags := readEnvironmentObject(ctx, r.platformV1Client, r.organizationId, &data)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	// Create only returns the ID, do a follow-up GET to populate full state
-	getResp, err := r.platformV1Client.GetEnvironmentObjectWithResponse(ctx, r.organizationId, createResp.JSON200.Id)
-	if err != nil {
-		tflog.Error(ctx, "failed to get environment object after create", map[string]interface{}{"error": err})
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get environment object after create, got error: %s", err))
+	if !found {
+		resp.State.RemoveResource(ctx)
 		return
 	}
-	_, diagnostic = clients.NormalizeAPIResponseWithBody(ctx, getResp.HTTPResponse, getResp.Body, getResp.JSON200, "create and get environment object")
-	if diagnostic != nil {
-		resp.Diagnostics.Append(diagnostic)
+
+	tflog.Trace(ctx, fmt.Sprintf("read an environment object resource: %v", data.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *environmentObjectResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data models.EnvironmentObject
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	diags = data.ReadFromResponse(ctx, getResp.JSON200, preserve)
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(updateEnvironmentObject(ctx, r.platformV1Client, r.organizationId, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	tflog.Trace(ctx, fmt.Sprintf("created an environment object resource: %v", data.Id.ValueString()))
+	tflog.Trace(ctx, fmt.Sprintf("updated an environment object resource: %v", data.Id.ValueString()))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(environmentObjectIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
-func (r *environmentObjectResource) Read(
+func (r *environmentObjectResource) Delete(
 	ctx context.Context,
-	req resource.ReadRequest,
-	resp *resource.ReadResponse,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
 ) {
 	var data models.EnvironmentObject
 
@@ -157,131 +191,281 @@ func (r *environmentObjectResource) Read(
 		return
 	}
 
-	preserve, diags := buildPreserveFromModel(ctx, &data)
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(deleteEnvironmentObject(ctx, r.platformV1Client, r.organizationId, data.Id.ValueString())...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	envObj, err := r.platformV1Client.GetEnvironmentObjectWithResponse(ctx, r.organizationId, data.Id.ValueString())
-	if err != nil {
-		tflog.Error(ctx, "failed to get environment object", map[string]interface{}{"error": err})
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get environment object, got error: %s", err))
+	tflog.Trace(ctx, fmt.Sprintf("deleted an environment object resource: %v", data.Id.ValueString()))
+}
+
+func (r *environmentObjectResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
 		return
 	}
-	statusCode, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, envObj.HTTPResponse, envObj.Body, envObj.JSON200, "read environment object")
-	if statusCode == http.StatusNotFound {
-		resp.State.RemoveResource(ctx)
+
+	var plan models.EnvironmentObject
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	if diagnostic != nil {
-		resp.Diagnostics.Append(diagnostic)
-		return
+	var state *models.EnvironmentObject
+	if !req.State.Raw.IsNull() {
+		state = &models.EnvironmentObject{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	diags = data.ReadFromResponse(ctx, envObj.JSON200, preserve)
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-		return
+	if planConnectionUri(&plan, state, resp) {
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 	}
+}
 
-	tflog.Trace(ctx, fmt.Sprintf("read an environment object resource: %v", data.Id.ValueString()))
+// planConnectionUri plans the fields of a connection configured with uri from the parsed URI, so the plan shows the
+// connection that will be created and a change of its type forces a replacement. It returns false when the
+// environment object is not a connection configured with uri and plan is unchanged.
+func planConnectionUri(plan, state *models.EnvironmentObject, resp *resource.ModifyPlanResponse) bool {
+	if plan.ObjectType.ValueString() != string(platform_v1.CreateEnvironmentObjectRequestObjectTypeCONNECTION) || plan.Uri.IsNull() {
+		return false
+	}
+
+	if plan.Uri.IsUnknown() {
+		plan.Type = types.StringUnknown()
+		plan.Host = types.StringUnknown()
+		plan.Port = types.Int64Unknown()
+		plan.Schema = types.StringUnknown()
+		plan.Login = types.StringUnknown()
+		plan.Extra = types.StringUnknown()
+	} else {
+		conn, err := common.ParseConnectionUri(plan.Uri.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("uri"), "Invalid connection URI", err.Error())
+			return false
+		}
+		plan.Type = types.StringValue(conn.Type)
+		plan.Host = types.StringPointerValue(conn.Host)
+		plan.Port = types.Int64PointerValue(conn.Port)
+		plan.Schema = types.StringPointerValue(conn.Schema)
+		plan.Login = types.StringPointerValue(conn.Login)
+		plan.Extra = types.StringPointerValue(conn.Extra)
+	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// type is immutable on the API, and its RequiresReplaceIfConfigured plan modifier does not fire for a type that
+	// is not configured
+	if state != nil && !plan.Type.Equal(state.Type) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("type"))
+	}
+	return true
 }
 
-func (r *environmentObjectResource) Update(
+func (r *environmentObjectResource) ImportState(
 	ctx context.Context,
-	req resource.UpdateRequest,
-	resp *resource.UpdateResponse,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
 ) {
-	var data models.EnvironmentObject
+	importStateByName(ctx, environmentObjectIdentity, r.organizationId, path.Root("id"), req, resp, func(ctx context.Context, id string) (string, diag.Diagnostics) {
+		return resolveEnvironmentObjectImportId(ctx, r.platformClient, r.platformV1Client, r.organizationId, id, "environment_object", nil)
+	})
+}
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
+func (r *environmentObjectResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: rawStateUpgrader(),
 	}
+}
+
+// MoveState moves the typed environment object resources back to astro_environment_object
+func (r *environmentObjectResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		typedEnvironmentObjectStateMover("astro_connection", schemas.ConnectionResourceSchemaAttributes(),
+			func(ctx context.Context, source *tfsdk.State) (models.EnvironmentObject, diag.Diagnostics) {
+				var data models.Connection
+				diags := source.Get(ctx, &data)
+				if diags.HasError() {
+					return models.EnvironmentObject{}, diags
+				}
+				return data.EnvironmentObject(ctx)
+			}),
+		typedEnvironmentObjectStateMover("astro_airflow_variable", schemas.AirflowVariableResourceSchemaAttributes(),
+			func(ctx context.Context, source *tfsdk.State) (models.EnvironmentObject, diag.Diagnostics) {
+				var data models.AirflowVariable
+				diags := source.Get(ctx, &data)
+				if diags.HasError() {
+					return models.EnvironmentObject{}, diags
+				}
+				return data.EnvironmentObject(ctx)
+			}),
+		typedEnvironmentObjectStateMover("astro_metrics_export", schemas.MetricsExportResourceSchemaAttributes(),
+			func(ctx context.Context, source *tfsdk.State) (models.EnvironmentObject, diag.Diagnostics) {
+				var data models.MetricsExport
+				diags := source.Get(ctx, &data)
+				if diags.HasError() {
+					return models.EnvironmentObject{}, diags
+				}
+				return data.EnvironmentObject(ctx)
+			}),
+	}
+}
+
+// --- API calls ---
+//
+// The environment object CRUD is shared by astro_environment_object and the typed astro_connection,
+// astro_airflow_variable and astro_metrics_export resources, which convert their models to and from
+// models.EnvironmentObject.
 
-	preserve, diags := buildPreserveFromModel(ctx, &data)
+// createEnvironmentObject creates the environment object described by data and reads the created object back into
+// data. The ID is persisted to state as soon as the object exists, so a failure in the follow-up GET leaves a
+// refreshable row in state instead of orphaning the just-created object.
+func createEnvironmentObject(
+	ctx context.Context,
+	platformV1Client *platform_v1.ClientWithResponses,
+	organizationId string,
+	data *models.EnvironmentObject,
+	state *tfsdk.State,
+) diag.Diagnostics {
+	preserve, diags := buildPreserveFromModel(ctx, data)
 	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-		return
+		return diags
 	}
 
-	updateReq, diags := buildUpdateRequest(ctx, &data)
+	createReq, diags := buildCreateRequest(ctx, data)
 	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-		return
+		return diags
 	}
 
-	updateResp, err := r.platformV1Client.UpdateEnvironmentObjectWithResponse(ctx, r.organizationId, data.Id.ValueString(), updateReq)
+	createResp, err := platformV1Client.CreateEnvironmentObjectWithResponse(ctx, organizationId, createReq)
 	if err != nil {
-		tflog.Error(ctx, "failed to update environment object", map[string]interface{}{"error": err})
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update environment object, got error: %s", err))
-		return
+		tflog.Error(ctx, "failed to create environment object", map[string]interface{}{"error": err})
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create environment object, got error: %s", err))
+		return diags
 	}
-	_, diagnostic := clients.NormalizeAPIError(ctx, updateResp.HTTPResponse, updateResp.Body)
+	_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, createResp.HTTPResponse, createResp.Body, createResp.JSON200, "create environment object")
 	if diagnostic != nil {
-		resp.Diagnostics.Append(diagnostic)
-		return
+		diags.Append(diagnostic)
+		return diags
+	}
+
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), createResp.JSON200.Id)...)
+	if diags.HasError() {
+		return diags
 	}
 
-	getResp, err := r.platformV1Client.GetEnvironmentObjectWithResponse(ctx, r.organizationId, data.Id.ValueString())
+	// Create only returns the ID, do a follow-up GET to populate full state
+	getResp, err := platformV1Client.GetEnvironmentObjectWithResponse(ctx, organizationId, createResp.JSON200.Id)
 	if err != nil {
-		tflog.Error(ctx, "failed to get environment object after update", map[string]interface{}{"error": err})
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get environment object after update, got error: %s", err))
-		return
+		tflog.Error(ctx, "failed to get environment object after create", map[string]interface{}{"error": err})
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get environment object after create, got error: %s", err))
+		return diags
 	}
-	_, diagnostic = clients.NormalizeAPIResponseWithBody(ctx, getResp.HTTPResponse, getResp.Body, getResp.JSON200, "update and get environment object")
+	_, diagnostic = clients.NormalizeAPIResponseWithBody(ctx, getResp.HTTPResponse, getResp.Body, getResp.JSON200, "create and get environment object")
 	if diagnostic != nil {
-		resp.Diagnostics.Append(diagnostic)
-		return
+		diags.Append(diagnostic)
+		return diags
 	}
 
-	diags = data.ReadFromResponse(ctx, getResp.JSON200, preserve)
+	return data.ReadFromResponse(ctx, getResp.JSON200, preserve)
+}
+
+// readEnvironmentObject refreshes data from the API. It returns false when the environment object no longer exists.
+func readEnvironmentObject(
+	ctx context.Context,
+	platformV1Client *platform_v1.ClientWithResponses,
+	organizationId string,
+	data *models.EnvironmentObject,
+) (bool, diag.Diagnostics) {
+	preserve, diags := buildPreserveFromModel(ctx, data)
 	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-		return
+		return false, diags
 	}
 
-	tflog.Trace(ctx, fmt.Sprintf("updated an environment object resource: %v", data.Id.ValueString()))
+	envObj, err := platformV1Client.GetEnvironmentObjectWithResponse(ctx, organizationId, data.Id.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to get environment object", map[string]interface{}{"error": err})
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get environment object, got error: %s", err))
+		return false, diags
+	}
+	statusCode, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, envObj.HTTPResponse, envObj.Body, envObj.JSON200, "read environment object")
+	if statusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if diagnostic != nil {
+		diags.Append(diagnostic)
+		return false, diags
+	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return true, data.ReadFromResponse(ctx, envObj.JSON200, preserve)
 }
 
-func (r *environmentObjectResource) Delete(
+// updateEnvironmentObject updates the environment object described by data and reads the updated object back into data
+func updateEnvironmentObject(
 	ctx context.Context,
-	req resource.DeleteRequest,
-	resp *resource.DeleteResponse,
-) {
-	var data models.EnvironmentObject
+	platformV1Client *platform_v1.ClientWithResponses,
+	organizationId string,
+	data *models.EnvironmentObject,
+) diag.Diagnostics {
+	preserve, diags := buildPreserveFromModel(ctx, data)
+	if diags.HasError() {
+		return diags
+	}
 
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
+	updateReq, diags := buildUpdateRequest(ctx, data)
+	if diags.HasError() {
+		return diags
 	}
 
-	envObj, err := r.platformV1Client.DeleteEnvironmentObjectWithResponse(ctx, r.organizationId, data.Id.ValueString())
+	updateResp, err := platformV1Client.UpdateEnvironmentObjectWithResponse(ctx, organizationId, data.Id.ValueString(), updateReq)
 	if err != nil {
-		tflog.Error(ctx, "failed to delete environment object", map[string]interface{}{"error": err})
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete environment object, got error: %s", err))
-		return
+		tflog.Error(ctx, "failed to update environment object", map[string]interface{}{"error": err})
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update environment object, got error: %s", err))
+		return diags
 	}
-	statusCode, diagnostic := clients.NormalizeAPIError(ctx, envObj.HTTPResponse, envObj.Body)
-	if statusCode != http.StatusNotFound && diagnostic != nil {
-		resp.Diagnostics.Append(diagnostic)
-		return
+	_, diagnostic := clients.NormalizeAPIError(ctx, updateResp.HTTPResponse, updateResp.Body)
+	if diagnostic != nil {
+		diags.Append(diagnostic)
+		return diags
 	}
 
-	tflog.Trace(ctx, fmt.Sprintf("deleted an environment object resource: %v", data.Id.ValueString()))
+	getResp, err := platformV1Client.GetEnvironmentObjectWithResponse(ctx, organizationId, data.Id.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to get environment object after update", map[string]interface{}{"error": err})
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get environment object after update, got error: %s", err))
+		return diags
+	}
+	_, diagnostic = clients.NormalizeAPIResponseWithBody(ctx, getResp.HTTPResponse, getResp.Body, getResp.JSON200, "update and get environment object")
+	if diagnostic != nil {
+		diags.Append(diagnostic)
+		return diags
+	}
+
+	return data.ReadFromResponse(ctx, getResp.JSON200, preserve)
 }
 
-func (r *environmentObjectResource) ImportState(
+// deleteEnvironmentObject deletes an environment object. An object that no longer exists is not an error.
+func deleteEnvironmentObject(
 	ctx context.Context,
-	req resource.ImportStateRequest,
-	resp *resource.ImportStateResponse,
-) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	platformV1Client *platform_v1.ClientWithResponses,
+	organizationId string,
+	id string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	envObj, err := platformV1Client.DeleteEnvironmentObjectWithResponse(ctx, organizationId, id)
+	if err != nil {
+		tflog.Error(ctx, "failed to delete environment object", map[string]interface{}{"error": err})
+		diags.AddError("Client Error", fmt.Sprintf("Unable to delete environment object, got error: %s", err))
+		return diags
+	}
+	statusCode, diagnostic := clients.NormalizeAPIError(ctx, envObj.HTTPResponse, envObj.Body)
+	if statusCode != http.StatusNotFound && diagnostic != nil {
+		diags.Append(diagnostic)
+	}
+	return diags
 }
 
 // --- Request builders ---
@@ -308,11 +492,15 @@ func buildCreateRequest(ctx context.Context, data *models.EnvironmentObject) (pl
 			IsSecret: data.IsSecret.ValueBoolPointer(),
 		}
 	case platform_v1.CreateEnvironmentObjectRequestObjectTypeCONNECTION:
+		password, d := connectionPassword(data)
+		if d.HasError() {
+			return req, d
+		}
 		connReq := &platform_v1.CreateEnvironmentObjectConnectionRequest{
 			Type:       data.Type.ValueString(),
 			Host:       data.Host.ValueStringPointer(),
 			Login:      data.Login.ValueStringPointer(),
-			Password:   data.Password.ValueStringPointer(),
+			Password:   password,
 			Schema:     data.Schema.ValueStringPointer(),
 			AuthTypeId: data.AuthTypeId.ValueStringPointer(),
 		}
@@ -359,6 +547,19 @@ func buildCreateRequest(ctx context.Context, data *models.EnvironmentObject) (pl
 	return req, nil
 }
 
+// connectionPassword returns the password of a connection. A connection configured with uri has its other fields
+// planned from the URI, but the password is not planned since the password attribute is not computed.
+func connectionPassword(data *models.EnvironmentObject) (*string, diag.Diagnostics) {
+	if !isUserSet(data.Uri) {
+		return data.Password.ValueStringPointer(), nil
+	}
+	conn, err := common.ParseConnectionUri(data.Uri.ValueString())
+	if err != nil {
+		return nil, diag.Diagnostics{diag.NewAttributeErrorDiagnostic(path.Root("uri"), "Invalid connection URI", err.Error())}
+	}
+	return conn.Password, nil
+}
+
 func buildCreateLinks(ctx context.Context, data *models.EnvironmentObject, req *platform_v1.CreateEnvironmentObjectRequest) diag.Diagnostics {
 	if data.Links.IsNull() || data.Links.IsUnknown() {
 		return nil
@@ -423,11 +624,15 @@ func buildUpdateRequest(ctx context.Context, data *models.EnvironmentObject) (pl
 			Value: data.Value.ValueStringPointer(),
 		}
 	case platform_v1.CreateEnvironmentObjectRequestObjectTypeCONNECTION:
+		password, d := connectionPassword(data)
+		if d.HasError() {
+			return req, d
+		}
 		connReq := &platform_v1.UpdateEnvironmentObjectConnectionRequest{
 			Type:       data.Type.ValueString(),
 			Host:       data.Host.ValueStringPointer(),
 			Login:      data.Login.ValueStringPointer(),
-			Password:   data.Password.ValueStringPointer(),
+			Password:   password,
 			Schema:     data.Schema.ValueStringPointer(),
 			AuthTypeId: data.AuthTypeId.ValueStringPointer(),
 		}
@@ -715,6 +920,7 @@ func buildPreserveFromModel(ctx context.Context, data *models.EnvironmentObject)
 	case platform_v1.CreateEnvironmentObjectRequestObjectTypeCONNECTION:
 		preserve.Password = data.Password.ValueStringPointer()
 		preserve.AuthTypeId = data.AuthTypeId.ValueStringPointer()
+		preserve.Uri = data.Uri.ValueStringPointer()
 		preserve.Extra = data.Extra.ValueStringPointer()
 	case platform_v1.CreateEnvironmentObjectRequestObjectTypeMETRICSEXPORT:
 		preserve.Password = data.Password.ValueStringPointer()
@@ -794,25 +1000,32 @@ func (r *environmentObjectResource) ValidateConfig(
 		}
 	}
 
-	// scope=DEPLOYMENT can't carry workspace-only attributes.
-	if !data.Scope.IsUnknown() && !data.Scope.IsNull() &&
-		data.Scope.ValueString() == string(platform_v1.CreateEnvironmentObjectRequestScopeDEPLOYMENT) {
-		if !data.AutoLinkDeployments.IsNull() && !data.AutoLinkDeployments.IsUnknown() {
-			resp.Diagnostics.AddAttributeError(path.Root("auto_link_deployments"),
-				"Conflicting attribute",
-				"auto_link_deployments is only valid when scope=WORKSPACE")
-		}
-		if !data.Links.IsNull() && !data.Links.IsUnknown() {
-			resp.Diagnostics.AddAttributeError(path.Root("links"),
-				"Conflicting attribute",
-				"links is only valid when scope=WORKSPACE")
-		}
-		if !data.ExcludeLinks.IsNull() && !data.ExcludeLinks.IsUnknown() {
-			resp.Diagnostics.AddAttributeError(path.Root("exclude_links"),
-				"Conflicting attribute",
-				"exclude_links is only valid when scope=WORKSPACE")
-		}
+	resp.Diagnostics.Append(validateScopeFields(&data)...)
+}
+
+// validateScopeFields enforces that scope=DEPLOYMENT can't carry workspace-only attributes.
+func validateScopeFields(data *models.EnvironmentObject) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if data.Scope.IsUnknown() || data.Scope.IsNull() ||
+		data.Scope.ValueString() != string(platform_v1.CreateEnvironmentObjectRequestScopeDEPLOYMENT) {
+		return diags
+	}
+	if !data.AutoLinkDeployments.IsNull() && !data.AutoLinkDeployments.IsUnknown() {
+		diags.AddAttributeError(path.Root("auto_link_deployments"),
+			"Conflicting attribute",
+			"auto_link_deployments is only valid when scope=WORKSPACE")
+	}
+	if !data.Links.IsNull() && !data.Links.IsUnknown() {
+		diags.AddAttributeError(path.Root("links"),
+			"Conflicting attribute",
+			"links is only valid when scope=WORKSPACE")
+	}
+	if !data.ExcludeLinks.IsNull() && !data.ExcludeLinks.IsUnknown() {
+		diags.AddAttributeError(path.Root("exclude_links"),
+			"Conflicting attribute",
+			"exclude_links is only valid when scope=WORKSPACE")
 	}
+	return diags
 }
 
 // validateVariableFields enforces the invariants for both AIRFLOW_VARIABLE and
@@ -837,16 +1050,18 @@ func validateVariableFields(data *models.EnvironmentObject, objectTypeLabel stri
 }
 
 // validateConnectionFields enforces: airflow_variable + metrics_export fields
-// are absent, `type` is required, and `extra` parses as JSON object at plan time
+// are absent, `type` is required unless `uri` is set, and `extra` parses as JSON object at plan time
 // (otherwise it would fail mid-apply when the request builder calls
 // json.Unmarshal).
 func validateConnectionFields(data *models.EnvironmentObject) diag.Diagnostics {
 	var diags diag.Diagnostics
-	if data.Type.IsNull() && !data.Type.IsUnknown() {
+	if !data.Uri.IsNull() {
+		diags.Append(validateConnectionUri(data)...)
+	} else if data.Type.IsNull() && !data.Type.IsUnknown() {
 		diags.AddAttributeError(path.Root("type"), "Missing required field",
-			"type is required when object_type=CONNECTION")
+			"type or uri is required when object_type=CONNECTION")
 	}
-	if isUserSet(data.Extra) {
+	if data.Uri.IsNull() && isUserSet(data.Extra) {
 		var probe map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Extra.ValueString()), &probe); err != nil {
 			diags.AddAttributeError(path.Root("extra"), "Invalid extra JSON",
@@ -864,6 +1079,28 @@ func validateConnectionFields(data *models.EnvironmentObject) diag.Diagnostics {
 	return diags
 }
 
+// validateConnectionUri enforces that `uri` parses and is not mixed with the
+// discrete connection fields it is parsed into.
+func validateConnectionUri(data *models.EnvironmentObject) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if isUserSet(data.Uri) {
+		if _, err := common.ParseConnectionUri(data.Uri.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("uri"), "Invalid connection URI", err.Error())
+		}
+	}
+	for _, f := range connectionOnlyFields(data) {
+		if f.name != "uri" && f.name != "auth_type_id" {
+			diags.AddAttributeError(path.Root(f.name), "Conflicting field",
+				fmt.Sprintf("%s cannot be set with uri, it is parsed from the URI", f.name))
+		}
+	}
+	if !data.Password.IsNull() {
+		diags.AddAttributeError(path.Root("password"), "Conflicting field",
+			"password cannot be set with uri, it is parsed from the URI")
+	}
+	return diags
+}
+
 // validateMetricsExportFields enforces: variable + connection fields
 // are absent, and `endpoint` + `exporter_type` are required.
 func validateMetricsExportFields(data *models.EnvironmentObject) diag.Diagnostics {
@@ -915,6 +1152,7 @@ func connectionOnlyFields(data *models.EnvironmentObject) []namedField {
 		name string
 		v    types.String
 	}{
+		{"uri", data.Uri},
 		{"type", data.Type},
 		{"host", data.Host},
 		{"schema", data.Schema},
@@ -23,6 +23,12 @@ import (
 var _ resource.Resource = &hybridClusterWorkspaceAuthorizationResource{}
 var _ resource.ResourceWithImportState = &hybridClusterWorkspaceAuthorizationResource{}
 var _ resource.ResourceWithConfigure = &hybridClusterWorkspaceAuthorizationResource{}
+var _ resource.ResourceWithIdentity = &hybridClusterWorkspaceAuthorizationResource{}
+
+// hybridClusterWorkspaceAuthorizationIdentity identifies an astro_hybrid_cluster_workspace_authorization by its cluster
+var hybridClusterWorkspaceAuthorizationIdentity = resourceIdentity{
+	{name: "cluster_id", description: "Hybrid cluster identifier"},
+}
 
 func NewHybridClusterWorkspaceAuthorizationResource() resource.Resource {
 	return &hybridClusterWorkspaceAuthorizationResource{}
@@ -54,6 +60,14 @@ func (r *hybridClusterWorkspaceAuthorizationResource) Schema(
 	}
 }
 
+func (r *hybridClusterWorkspaceAuthorizationResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = hybridClusterWorkspaceAuthorizationIdentity.Schema()
+}
+
 func (r *hybridClusterWorkspaceAuthorizationResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -166,6 +180,7 @@ func (r *hybridClusterWorkspaceAuthorizationResource) Create(
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(hybridClusterWorkspaceAuthorizationIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *hybridClusterWorkspaceAuthorizationResource) Read(
@@ -180,6 +195,10 @@ func (r *hybridClusterWorkspaceAuthorizationResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(hybridClusterWorkspaceAuthorizationIdentity.Set(ctx, r.organizationId, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	cluster, err := r.platformClient.GetClusterWithResponse(ctx, r.organizationId, data.ClusterId.ValueString())
 	if err != nil {
@@ -234,6 +253,7 @@ func (r *hybridClusterWorkspaceAuthorizationResource) Update(
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(hybridClusterWorkspaceAuthorizationIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *hybridClusterWorkspaceAuthorizationResource) Delete(
@@ -314,5 +334,5 @@ func (r *hybridClusterWorkspaceAuthorizationResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("cluster_id"), req, resp)
+	hybridClusterWorkspaceAuthorizationIdentity.ImportStatePassthrough(ctx, r.organizationId, path.Root("cluster_id"), req, resp)
 }
@@ -0,0 +1,133 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The resources have a resource identity, stored by Terraform alongside their state, so that an import block can set
+// a structured `identity` instead of the positional import ID of each resource and tooling can match the state to
+// the Astro objects. The identity of a resource is the organization of the provider and the root state attributes
+// identifying the resource in the organization, e.g. the ID of a deployment or the team and user IDs of a team
+// membership. These attributes never change during the lifecycle of the resource.
+
+// identityOrganizationId is the identity attribute holding the organization of the resource
+const identityOrganizationId = "organization_id"
+
+// resourceIdentity lists the root state attributes identifying a resource in its organization, in the order of the
+// parts of its `/` separated import ID
+type resourceIdentity []identityAttribute
+
+type identityAttribute struct {
+	name        string
+	description string
+}
+
+// Schema returns the identity schema of the resource, where organization_id defaults to the organization of the
+// provider on import
+func (i resourceIdentity) Schema() identityschema.Schema {
+	attributes := map[string]identityschema.Attribute{
+		identityOrganizationId: identityschema.StringAttribute{
+			Description:       "Organization identifier, defaults to the organization of the provider on import",
+			OptionalForImport: true,
+		},
+	}
+	for _, attribute := range i {
+		attributes[attribute.name] = identityschema.StringAttribute{
+			Description:       attribute.description,
+			RequiredForImport: true,
+		}
+	}
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// resourceData is the state or the list result of a resource
+type resourceData interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// Set sets identity from the identifying attributes of state. identity is nil when Terraform does not support resource
+// identity.
+func (i resourceIdentity) Set(ctx context.Context, organizationId string, state resourceData, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil {
+		return diags
+	}
+	diags.Append(identity.SetAttribute(ctx, path.Root(identityOrganizationId), organizationId)...)
+	for _, attribute := range i {
+		var value types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(attribute.name), &value)...)
+		if diags.HasError() {
+			return diags
+		}
+		diags.Append(identity.SetAttribute(ctx, path.Root(attribute.name), value)...)
+	}
+	return diags
+}
+
+// ImportID returns the import ID of the import request, built from the identity of the import block when the resource
+// is imported by identity. A resource identified by its organization alone is imported by the organization ID.
+func (i resourceIdentity) ImportID(ctx context.Context, organizationId string, req resource.ImportStateRequest) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if req.ID != "" || req.Identity == nil {
+		return req.ID, diags
+	}
+
+	var identityOrganization types.String
+	diags.Append(req.Identity.GetAttribute(ctx, path.Root(identityOrganizationId), &identityOrganization)...)
+	if diags.HasError() {
+		return "", diags
+	}
+	if !identityOrganization.IsNull() && identityOrganization.ValueString() != organizationId {
+		diags.AddError(
+			"Invalid Import Identity",
+			fmt.Sprintf("The identity is in organization %s but the provider is configured for organization %s", identityOrganization.ValueString(), organizationId),
+		)
+		return "", diags
+	}
+	if len(i) == 0 {
+		return organizationId, diags
+	}
+
+	parts := make([]string, 0, len(i))
+	for _, attribute := range i {
+		var value types.String
+		diags.Append(req.Identity.GetAttribute(ctx, path.Root(attribute.name), &value)...)
+		if diags.HasError() {
+			return "", diags
+		}
+		if value.ValueString() == "" {
+			diags.AddError("Invalid Import Identity", fmt.Sprintf("The identity attribute %s is required", attribute.name))
+			return "", diags
+		}
+		parts = append(parts, value.ValueString())
+	}
+	return strings.Join(parts, "/"), diags
+}
+
+// ImportStatePassthrough sets the state attribute at attrPath to the import ID of the import request, like
+// resource.ImportStatePassthroughID but also when the resource is imported by identity
+func (i resourceIdentity) ImportStatePassthrough(
+	ctx context.Context,
+	organizationId string,
+	attrPath path.Path,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := i.ImportID(ctx, organizationId, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, id)...)
+}
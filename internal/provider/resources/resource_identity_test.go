@@ -0,0 +1,165 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testOrganizationId = "clx42kkcm01fo01o06agtmshg"
+
+// importIdentity builds the identity of an import block of a resource identified by identity
+func importIdentity(t *testing.T, ctx context.Context, identity resourceIdentity, values map[string]attr.Value) *tfsdk.ResourceIdentity {
+	t.Helper()
+	s := identity.Schema()
+	resourceIdentity := &tfsdk.ResourceIdentity{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	for name, value := range values {
+		diags := resourceIdentity.SetAttribute(ctx, path.Root(name), value)
+		require.False(t, diags.HasError(), diags)
+	}
+	return resourceIdentity
+}
+
+func TestUnit_ResourceIdentityImportID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("keeps the import ID", func(t *testing.T) {
+		id, diags := teamMembershipIdentity.ImportID(ctx, testOrganizationId, resource.ImportStateRequest{ID: "team-id/user-id"})
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, "team-id/user-id", id)
+	})
+
+	t.Run("builds the import ID from the identity", func(t *testing.T) {
+		identity := importIdentity(t, ctx, teamMembershipIdentity, map[string]attr.Value{
+			"team_id": types.StringValue("team-id"),
+			"user_id": types.StringValue("user-id"),
+		})
+		id, diags := teamMembershipIdentity.ImportID(ctx, testOrganizationId, resource.ImportStateRequest{Identity: identity})
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, "team-id/user-id", id)
+	})
+
+	t.Run("accepts the organization of the provider", func(t *testing.T) {
+		identity := importIdentity(t, ctx, deploymentIdentity, map[string]attr.Value{
+			"organization_id": types.StringValue(testOrganizationId),
+			"id":              types.StringValue("deployment-id"),
+		})
+		id, diags := deploymentIdentity.ImportID(ctx, testOrganizationId, resource.ImportStateRequest{Identity: identity})
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, "deployment-id", id)
+	})
+
+	t.Run("rejects another organization", func(t *testing.T) {
+		identity := importIdentity(t, ctx, deploymentIdentity, map[string]attr.Value{
+			"organization_id": types.StringValue("other-organization-id"),
+			"id":              types.StringValue("deployment-id"),
+		})
+		_, diags := deploymentIdentity.ImportID(ctx, testOrganizationId, resource.ImportStateRequest{Identity: identity})
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "other-organization-id")
+	})
+
+	t.Run("rejects a missing attribute", func(t *testing.T) {
+		identity := importIdentity(t, ctx, clusterNodePoolIdentity, map[string]attr.Value{
+			"cluster_id": types.StringValue("cluster-id"),
+		})
+		_, diags := clusterNodePoolIdentity.ImportID(ctx, testOrganizationId, resource.ImportStateRequest{Identity: identity})
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "id")
+	})
+
+	t.Run("imports a resource identified by its organization alone", func(t *testing.T) {
+		identity := importIdentity(t, ctx, allowedIpAddressRangesIdentity, nil)
+		id, diags := allowedIpAddressRangesIdentity.ImportID(ctx, testOrganizationId, resource.ImportStateRequest{Identity: identity})
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, testOrganizationId, id)
+	})
+}
+
+func TestUnit_ResourceIdentitySet(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&teamMembershipResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := sourceState(t, ctx, schemaResp.Schema.Attributes, map[string]attr.Value{
+		"id":      types.StringValue("team-id/user-id"),
+		"team_id": types.StringValue("team-id"),
+		"user_id": types.StringValue("user-id"),
+	})
+
+	identity := importIdentity(t, ctx, teamMembershipIdentity, nil)
+	diags := teamMembershipIdentity.Set(ctx, testOrganizationId, *state, identity)
+	require.False(t, diags.HasError(), diags)
+
+	var values struct {
+		OrganizationId types.String `tfsdk:"organization_id"`
+		TeamId         types.String `tfsdk:"team_id"`
+		UserId         types.String `tfsdk:"user_id"`
+	}
+	diags = identity.Get(ctx, &values)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, testOrganizationId, values.OrganizationId.ValueString())
+	assert.Equal(t, "team-id", values.TeamId.ValueString())
+	assert.Equal(t, "user-id", values.UserId.ValueString())
+
+	// Terraform versions without resource identity do not send one
+	diags = teamMembershipIdentity.Set(ctx, testOrganizationId, *state, nil)
+	assert.False(t, diags.HasError(), diags)
+}
+
+func TestUnit_ResourcesHaveIdentity(t *testing.T) {
+	ctx := context.Background()
+	for _, newResource := range []func() resource.Resource{
+		NewWorkspaceResource,
+		NewDeploymentResource,
+		NewClusterResource,
+		NewClusterNodePoolResource,
+		NewClusterFailoverResource,
+		NewTeamRolesResource,
+		NewHybridClusterWorkspaceAuthorizationResource,
+		NewApiTokenResource,
+		NewAgentTokenResource,
+		NewTeamResource,
+		NewTeamMembershipResource,
+		NewUserRolesResource,
+		NewUserResource,
+		NewAlertResource,
+		NewNotificationChannelResource,
+		NewCustomRoleResource,
+		NewEnvironmentObjectResource,
+		NewConnectionResource,
+		NewAirflowVariableResource,
+		NewMetricsExportResource,
+		NewAllowedIpAddressRangesResource,
+	} {
+		r := newResource()
+		var metadataResp resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "astro"}, &metadataResp)
+		t.Run(metadataResp.TypeName, func(t *testing.T) {
+			withIdentity, ok := r.(resource.ResourceWithIdentity)
+			require.True(t, ok)
+			var identityResp resource.IdentitySchemaResponse
+			withIdentity.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+			assert.False(t, identityResp.Diagnostics.HasError())
+			assert.Contains(t, identityResp.IdentitySchema.Attributes, "organization_id")
+
+			// Every identity attribute is a root attribute of the state
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			for name := range identityResp.IdentitySchema.Attributes {
+				if name == "organization_id" {
+					continue
+				}
+				assert.Contains(t, schemaResp.Schema.Attributes, name)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &metricsExportResource{}
+var _ resource.ResourceWithImportState = &metricsExportResource{}
+var _ resource.ResourceWithConfigure = &metricsExportResource{}
+var _ resource.ResourceWithValidateConfig = &metricsExportResource{}
+var _ resource.ResourceWithMoveState = &metricsExportResource{}
+var _ resource.ResourceWithIdentity = &metricsExportResource{}
+
+// metricsExportIdentity identifies an astro_metrics_export by its ID
+var metricsExportIdentity = resourceIdentity{
+	{name: "id", description: "Metrics export identifier"},
+}
+
+func NewMetricsExportResource() resource.Resource {
+	return &metricsExportResource{}
+}
+
+// metricsExportResource defines the resource implementation.
+type metricsExportResource struct {
+	platformClient   *platform.ClientWithResponses
+	platformV1Client *platform_v1.ClientWithResponses
+	organizationId   string
+}
+
+func (r *metricsExportResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_metrics_export"
+}
+
+func (r *metricsExportResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Metrics export resource. Manages the export of Deployment metrics to a Prometheus endpoint, scoped to a Workspace or Deployment, an environment object with `object_type=METRICS_EXPORT`.",
+		Attributes:          schemas.MetricsExportResourceSchemaAttributes(),
+	}
+}
+
+func (r *metricsExportResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = metricsExportIdentity.Schema()
+}
+
+func (r *metricsExportResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClients, ok := req.ProviderData.(models.ApiClientsModel)
+	if !ok {
+		utils.ResourceApiClientConfigureError(ctx, req, resp)
+		return
+	}
+
+	r.platformClient = apiClients.PlatformClient
+	r.platformV1Client = apiClients.PlatformV1Client
+	r.organizationId = apiClients.OrganizationId
+}
+
+func (r *metricsExportResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data models.MetricsExport
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envObj, diags := data.EnvironmentObject(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(createEnvironmentObject(ctx, r.platformV1Client, r.organizationId, &envObj, &resp.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.ReadFromEnvironmentObject(ctx, &envObj)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created a metrics export resource: %v", data.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(metricsExportIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
+}
+
+func (r *metricsExportResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data models.MetricsExport
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(metricsExportIdentity.Set(ctx, r.organizationId, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envObj, diags := data.EnvironmentObject(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := readEnvironmentObject(ctx, r.platformV1Client, r.organizationId, &envObj)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(validateEnvironmentObjectType(&envObj, platform_v1.CreateEnvironmentObjectRequestObjectTypeMETRICSEXPORT, "astro_metrics_export")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.ReadFromEnvironmentObject(ctx, &envObj)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("read a metrics export resource: %v", data.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *metricsExportResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data models.MetricsExport
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envObj, diags := data.EnvironmentObject(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateEnvironmentObject(ctx, r.platformV1Client, r.organizationId, &envObj)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.ReadFromEnvironmentObject(ctx, &envObj)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated a metrics export resource: %v", data.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(metricsExportIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
+}
+
+func (r *metricsExportResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data models.MetricsExport
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(deleteEnvironmentObject(ctx, r.platformV1Client, r.organizationId, data.Id.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted a metrics export resource: %v", data.Id.ValueString()))
+}
+
+func (r *metricsExportResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	importStateByName(ctx, metricsExportIdentity, r.organizationId, path.Root("id"), req, resp, func(ctx context.Context, id string) (string, diag.Diagnostics) {
+		return resolveEnvironmentObjectImportId(ctx, r.platformClient, r.platformV1Client, r.organizationId, id, "metrics_export", lo.ToPtr(platform_v1.METRICSEXPORT))
+	})
+}
+
+func (r *metricsExportResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		environmentObjectStateMover(platform_v1.CreateEnvironmentObjectRequestObjectTypeMETRICSEXPORT,
+			func(ctx context.Context, envObj *models.EnvironmentObject, state *tfsdk.State) diag.Diagnostics {
+				var data models.MetricsExport
+				diags := data.ReadFromEnvironmentObject(ctx, envObj)
+				if diags.HasError() {
+					return diags
+				}
+				return state.Set(ctx, &data)
+			}),
+	}
+}
+
+// ValidateConfig validates that the credentials match auth_type and the scope of the links, with the same rules as
+// astro_environment_object.
+func (r *metricsExportResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data models.MetricsExport
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateMetricsExportAuth(&data)...)
+
+	envObj, diags := data.EnvironmentObject(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateScopeFields(&envObj)...)
+}
+
+// validateMetricsExportAuth enforces that AUTH_TOKEN exports set basic_token, BASIC exports set username and
+// password, and that bearer token and basic-auth credentials are not mixed.
+func validateMetricsExportAuth(data *models.MetricsExport) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if isUserSet(data.BasicToken) {
+		for _, f := range []namedField{
+			{name: "username", set: isUserSet(data.Username)},
+			{name: "password", set: isUserSet(data.Password)},
+		} {
+			if f.set {
+				diags.AddAttributeError(path.Root(f.name), "Conflicting field",
+					fmt.Sprintf("%s cannot be set with basic_token, use either bearer token or basic authentication", f.name))
+			}
+		}
+	}
+
+	switch data.AuthType.ValueString() {
+	case string(platform_v1.CreateEnvironmentObjectMetricsExportRequestAuthTypeAUTHTOKEN):
+		if data.BasicToken.IsNull() {
+			diags.AddAttributeError(path.Root("basic_token"), "Missing required field",
+				"basic_token is required when auth_type=AUTH_TOKEN")
+		}
+	case string(platform_v1.CreateEnvironmentObjectMetricsExportRequestAuthTypeBASIC):
+		if data.Username.IsNull() {
+			diags.AddAttributeError(path.Root("username"), "Missing required field",
+				"username is required when auth_type=BASIC")
+		}
+		if data.Password.IsNull() {
+			diags.AddAttributeError(path.Root("password"), "Missing required field",
+				"password is required when auth_type=BASIC")
+		}
+	}
+	return diags
+}
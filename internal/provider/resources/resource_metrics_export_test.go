@@ -0,0 +1,91 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	astronomerprovider "github.com/astronomer/terraform-provider-astro/internal/provider"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceMetricsExport(t *testing.T) {
+	namePrefix := utils.GenerateTestResourceName(10)
+	meKey := fmt.Sprintf("test_me_%v", namePrefix)
+	workspaceId := os.Getenv("HOSTED_WORKSPACE_ID")
+	resourceVar := "astro_metrics_export.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { astronomerprovider.TestAccPreCheck(t) },
+		CheckDestroy:             testAccCheckEnvironmentObjectDestroyed(t, meKey),
+		Steps: []resource.TestStep{
+			// Credentials must match auth_type
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + metricsExport("test", meKey, workspaceId, "https://prometheus.example.com/api/v1/write", `
+  auth_type = "BASIC"
+  username  = "metrics"`),
+				ExpectError: regexp.MustCompile("password is required when auth_type=BASIC"),
+			},
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + metricsExport("test", meKey, workspaceId, "https://prometheus.example.com/api/v1/write", `
+  auth_type   = "AUTH_TOKEN"
+  basic_token = "token"
+  username    = "metrics"`),
+				ExpectError: regexp.MustCompile("username cannot be set with basic_token"),
+			},
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + metricsExport("test", meKey, workspaceId, "https://prometheus.example.com/api/v1/write", `
+  auth_type   = "AUTH_TOKEN"
+  basic_token = "token"
+  labels      = { environment = "prod" }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentObjectExists(t, meKey),
+					resource.TestCheckResourceAttr(resourceVar, "key", meKey),
+					resource.TestCheckResourceAttr(resourceVar, "endpoint", "https://prometheus.example.com/api/v1/write"),
+					resource.TestCheckResourceAttr(resourceVar, "exporter_type", "PROMETHEUS"),
+					resource.TestCheckResourceAttr(resourceVar, "auth_type", "AUTH_TOKEN"),
+					resource.TestCheckResourceAttr(resourceVar, "basic_token", "token"),
+					resource.TestCheckResourceAttr(resourceVar, "labels.environment", "prod"),
+					resource.TestCheckResourceAttrSet(resourceVar, "id"),
+				),
+			},
+			// Switch to basic auth
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + metricsExport("test", meKey, workspaceId, "https://prometheus.example.com/api/v2/write", `
+  auth_type = "BASIC"
+  username  = "metrics"
+  password  = "secret"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentObjectExists(t, meKey),
+					resource.TestCheckResourceAttr(resourceVar, "endpoint", "https://prometheus.example.com/api/v2/write"),
+					resource.TestCheckResourceAttr(resourceVar, "auth_type", "BASIC"),
+					resource.TestCheckResourceAttr(resourceVar, "username", "metrics"),
+					resource.TestCheckResourceAttr(resourceVar, "password", "secret"),
+				),
+			},
+			// Import — auth_type and the credentials are write-only on the API
+			{
+				ResourceName:            resourceVar,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"basic_token", "password", "auth_type"},
+			},
+		},
+	})
+}
+
+func metricsExport(tfName, meKey, workspaceId, endpoint, auth string) string {
+	return fmt.Sprintf(`
+resource "astro_metrics_export" "%s" {
+  key             = "%s"
+  scope           = "WORKSPACE"
+  scope_entity_id = "%s"
+
+  endpoint = "%s"
+  %s
+}
+`, tfName, meKey, workspaceId, endpoint, auth)
+}
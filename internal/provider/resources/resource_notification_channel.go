@@ -10,6 +10,7 @@ import (
 	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
 	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
 	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,6 +22,13 @@ import (
 var _ resource.Resource = &notificationChannelResource{}
 var _ resource.ResourceWithImportState = &notificationChannelResource{}
 var _ resource.ResourceWithConfigure = &notificationChannelResource{}
+var _ resource.ResourceWithUpgradeState = &notificationChannelResource{}
+var _ resource.ResourceWithIdentity = &notificationChannelResource{}
+
+// notificationChannelIdentity identifies an astro_notification_channel by its ID
+var notificationChannelIdentity = resourceIdentity{
+	{name: "id", description: "Notification channel identifier"},
+}
 
 func NewNotificationChannelResource() resource.Resource {
 	return &notificationChannelResource{}
@@ -46,12 +54,22 @@ func (r *notificationChannelResource) Schema(
 	resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
+		// Version 1 upgrades the state of the earlier releases, see rawStateUpgrader
+		Version: 1,
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Notification Channel resource",
 		Attributes:          schemas.NotificationChannelResourceSchemaAttributes(),
 	}
 }
 
+func (r *notificationChannelResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = notificationChannelIdentity.Schema()
+}
+
 func (r *notificationChannelResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -246,6 +264,7 @@ func (r *notificationChannelResource) Create(
 
 	// Save to state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(notificationChannelIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *notificationChannelResource) Read(
@@ -258,6 +277,10 @@ func (r *notificationChannelResource) Read(
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(notificationChannelIdentity.Set(ctx, r.organizationId, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -473,6 +496,7 @@ func (r *notificationChannelResource) Update(
 
 	// Save to state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(notificationChannelIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *notificationChannelResource) Delete(
@@ -518,5 +542,13 @@ func (r *notificationChannelResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, notificationChannelIdentity, r.organizationId, path.Root("id"), req, resp, func(ctx context.Context, id string) (string, diag.Diagnostics) {
+		return resolveNotificationChannelImportId(ctx, r.platformClient, r.organizationId, id)
+	})
+}
+
+func (r *notificationChannelResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: rawStateUpgrader(),
+	}
 }
@@ -27,6 +27,13 @@ import (
 var _ resource.Resource = &TeamResource{}
 var _ resource.ResourceWithImportState = &TeamResource{}
 var _ resource.ResourceWithConfigure = &TeamResource{}
+var _ resource.ResourceWithMoveState = &TeamResource{}
+var _ resource.ResourceWithIdentity = &TeamResource{}
+
+// teamIdentity identifies an astro_team by its ID
+var teamIdentity = resourceIdentity{
+	{name: "id", description: "Team identifier"},
+}
 
 func NewTeamResource() resource.Resource {
 	return &TeamResource{}
@@ -59,6 +66,14 @@ func (r *TeamResource) Schema(
 	}
 }
 
+func (r *TeamResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = teamIdentity.Schema()
+}
+
 func (r *TeamResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -268,6 +283,7 @@ func (r *TeamResource) Create(
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(teamIdentity.Set(ctx, r.OrganizationId, resp.State, resp.Identity)...)
 }
 
 func (r *TeamResource) Read(
@@ -280,6 +296,10 @@ func (r *TeamResource) Read(
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(teamIdentity.Set(ctx, r.OrganizationId, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -446,6 +466,7 @@ func (r *TeamResource) Update(
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(teamIdentity.Set(ctx, r.OrganizationId, resp.State, resp.Identity)...)
 }
 
 func (r *TeamResource) Delete(
@@ -491,7 +512,9 @@ func (r *TeamResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, teamIdentity, r.OrganizationId, path.Root("id"), req, resp, func(ctx context.Context, id string) (string, diag.Diagnostics) {
+		return resolveTeamImportId(ctx, r.IamClient, r.OrganizationId, id)
+	})
 }
 
 func (r *TeamResource) CheckOrganizationIsScim(ctx context.Context) diag.Diagnostics {
@@ -609,3 +632,10 @@ func (r *TeamResource) UpdateTeamMembers(ctx context.Context, data models.TeamRe
 	}
 	return newMemberIds, nil
 }
+
+func (r *TeamResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		teamRolesToTeamStateMover(),
+		teamMembershipToTeamStateMover(),
+	}
+}
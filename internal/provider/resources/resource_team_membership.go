@@ -21,6 +21,14 @@ import (
 var _ resource.Resource = &teamMembershipResource{}
 var _ resource.ResourceWithImportState = &teamMembershipResource{}
 var _ resource.ResourceWithConfigure = &teamMembershipResource{}
+var _ resource.ResourceWithMoveState = &teamMembershipResource{}
+var _ resource.ResourceWithIdentity = &teamMembershipResource{}
+
+// teamMembershipIdentity identifies an astro_team_membership by its team and user
+var teamMembershipIdentity = resourceIdentity{
+	{name: "team_id", description: "Team identifier"},
+	{name: "user_id", description: "User identifier"},
+}
 
 func NewTeamMembershipResource() resource.Resource {
 	return &teamMembershipResource{}
@@ -54,6 +62,14 @@ func (r *teamMembershipResource) Schema(
 	}
 }
 
+func (r *teamMembershipResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = teamMembershipIdentity.Schema()
+}
+
 func (r *teamMembershipResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -110,6 +126,7 @@ func (r *teamMembershipResource) Create(
 
 	tflog.Trace(ctx, fmt.Sprintf("added user %v to team %v", userId, teamId))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(teamMembershipIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *teamMembershipResource) Read(
@@ -123,6 +140,10 @@ func (r *teamMembershipResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(teamMembershipIdentity.Set(ctx, r.organizationId, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	teamId := data.TeamId.ValueString()
 	userId := data.UserId.ValueString()
@@ -229,8 +250,13 @@ func (r *teamMembershipResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
+	id, diags := teamMembershipIdentity.ImportID(ctx, r.organizationId, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	// Import ID format: <team_id>/<user_id>
-	parts := strings.SplitN(req.ID, "/", 2)
+	parts := strings.SplitN(id, "/", 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
@@ -240,10 +266,16 @@ func (r *teamMembershipResource) ImportState(
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 // membershipID returns the composite state ID for a team membership.
 func membershipID(teamId, userId string) types.String {
 	return types.StringValue(teamId + "/" + userId)
 }
+
+func (r *teamMembershipResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		teamToTeamMembershipStateMover(),
+	}
+}
@@ -28,6 +28,13 @@ import (
 var _ resource.Resource = &teamRolesResource{}
 var _ resource.ResourceWithImportState = &teamRolesResource{}
 var _ resource.ResourceWithConfigure = &teamRolesResource{}
+var _ resource.ResourceWithMoveState = &teamRolesResource{}
+var _ resource.ResourceWithIdentity = &teamRolesResource{}
+
+// teamRolesIdentity identifies the role bindings of an astro_team_roles by their subject, the team, in the scope of the organization
+var teamRolesIdentity = resourceIdentity{
+	{name: "team_id", description: "Team identifier"},
+}
 
 func NewTeamRolesResource() resource.Resource {
 	return &teamRolesResource{}
@@ -60,6 +67,14 @@ func (r *teamRolesResource) Schema(
 	}
 }
 
+func (r *teamRolesResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = teamRolesIdentity.Schema()
+}
+
 func (r *teamRolesResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -196,6 +211,7 @@ func (r *teamRolesResource) Create(
 	tflog.Trace(ctx, fmt.Sprintf("created a team_roles resource for team '%v'", data.TeamId.ValueString()))
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(teamRolesIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *teamRolesResource) Read(
@@ -210,6 +226,10 @@ func (r *teamRolesResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(teamRolesIdentity.Set(ctx, r.organizationId, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save prior state role sets so that explicit empty sets ([]) are preserved
 	// if the API returns null (see preserveEmptySet for rationale).
@@ -289,6 +309,7 @@ func (r *teamRolesResource) Update(
 	tflog.Trace(ctx, fmt.Sprintf("updated a team_roles resource for team '%v'", data.TeamId.ValueString()))
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(teamRolesIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *teamRolesResource) Delete(
@@ -348,5 +369,11 @@ func (r *teamRolesResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("team_id"), req, resp)
+	teamRolesIdentity.ImportStatePassthrough(ctx, r.organizationId, path.Root("team_id"), req, resp)
+}
+
+func (r *teamRolesResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		teamToTeamRolesStateMover(),
+	}
 }
@@ -0,0 +1,481 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/common"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithConfigure = &UserResource{}
+var _ resource.ResourceWithModifyPlan = &UserResource{}
+var _ resource.ResourceWithIdentity = &UserResource{}
+
+// userIdentity identifies an astro_user by its email
+var userIdentity = resourceIdentity{
+	{name: "email", description: "User email"},
+}
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+// UserResource defines the resource implementation.
+type UserResource struct {
+	iamClient      *iam.ClientWithResponses
+	platformClient *platform.ClientWithResponses
+	organizationId string
+}
+
+func (r *UserResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "User resource. Invites the user to the organization by email and binds its roles once the user accepts the invite: while the user is `PENDING`, the roles are kept as configured, and the first `terraform apply` after the user joined the organization assigns them. A user who is already a member of the organization is not invited, its roles are assigned right away. Users cannot be removed from an organization through the API, so destroying the resource withdraws a pending invite, or removes the workspace, deployment and DAG roles of an active user and makes it an `ORGANIZATION_MEMBER`. Do not manage the roles of a user with both this resource and `astro_user_roles`.",
+		Attributes:          schemas.UserResourceSchemaAttributes(),
+	}
+}
+
+func (r *UserResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = userIdentity.Schema()
+}
+
+func (r *UserResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClients, ok := req.ProviderData.(models.ApiClientsModel)
+	if !ok {
+		utils.ResourceApiClientConfigureError(ctx, req, resp)
+		return
+	}
+
+	r.iamClient = apiClients.IamClient
+	r.platformClient = apiClients.PlatformClient
+	r.organizationId = apiClients.OrganizationId
+}
+
+// FindUser returns the user of the organization with the email, or nil if there is none
+func (r *UserResource) FindUser(ctx context.Context, email string) (*iam.User, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	params := &iam.ListUsersParams{
+		Limit: lo.ToPtr(1000),
+	}
+	offset := 0
+	for {
+		params.Offset = &offset
+		users, err := r.iamClient.ListUsersWithResponse(ctx, r.organizationId, params)
+		if err != nil {
+			tflog.Error(ctx, "failed to list users", map[string]interface{}{"error": err})
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to list users, got error: %s", err),
+			)
+			return nil, diags
+		}
+		_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, users.HTTPResponse, users.Body, users.JSON200, "list users")
+		if diagnostic != nil {
+			diags.Append(diagnostic)
+			return nil, diags
+		}
+		if user, ok := lo.Find(users.JSON200.Users, func(user iam.User) bool {
+			return strings.EqualFold(user.Username, email)
+		}); ok {
+			return &user, nil
+		}
+		offset += 1000
+		if users.JSON200.TotalCount <= offset {
+			return nil, nil
+		}
+	}
+}
+
+// Invite invites the user to the organization. The invite carries the organization role of the user when it is one a
+// user can be invited with, otherwise the user is invited as an ORGANIZATION_MEMBER until its roles are bound.
+func (r *UserResource) Invite(ctx context.Context, data *models.UserResource) diag.Diagnostics {
+	var diags diag.Diagnostics
+	role := iam.CreateUserInviteRequestRole(data.OrganizationRole.ValueString())
+	if !lo.Contains([]iam.CreateUserInviteRequestRole{
+		iam.CreateUserInviteRequestRoleORGANIZATIONOWNER,
+		iam.CreateUserInviteRequestRoleORGANIZATIONMEMBER,
+		iam.CreateUserInviteRequestRoleORGANIZATIONBILLINGADMIN,
+	}, role) {
+		role = iam.CreateUserInviteRequestRoleORGANIZATIONMEMBER
+	}
+	invite, err := r.iamClient.CreateUserInviteWithResponse(ctx, r.organizationId, iam.CreateUserInviteRequest{
+		InviteeEmail: data.Email.ValueString(),
+		Role:         role,
+	})
+	if err != nil {
+		tflog.Error(ctx, "failed to create user invite", map[string]interface{}{"error": err})
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create user invite, got error: %s", err),
+		)
+		return diags
+	}
+	_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, invite.HTTPResponse, invite.Body, invite.JSON200, "create user invite")
+	if diagnostic != nil {
+		diags.Append(diagnostic)
+		return diags
+	}
+	data.ReadFromInvite(invite.JSON200)
+	if data.Id.IsNull() {
+		// the invite does not always reference the invited user
+		user, diags := r.FindUser(ctx, data.Email.ValueString())
+		if diags.HasError() {
+			return diags
+		}
+		if user == nil {
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to find the user invited with invite '%s'", data.InviteId.ValueString()),
+			)
+			return diags
+		}
+		data.Id = types.StringValue(user.Id)
+	}
+	return nil
+}
+
+// DeleteInvite withdraws the invite sent to the user
+func (r *UserResource) DeleteInvite(ctx context.Context, inviteId string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	deletedInvite, err := r.iamClient.DeleteUserInviteWithResponse(ctx, r.organizationId, inviteId)
+	if err != nil {
+		tflog.Error(ctx, "failed to delete user invite", map[string]interface{}{"error": err})
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete user invite, got error: %s", err),
+		)
+		return diags
+	}
+	statusCode, diagnostic := clients.NormalizeAPIError(ctx, deletedInvite.HTTPResponse, deletedInvite.Body)
+	// It is recommended to ignore 404 Resource Not Found errors when deleting a resource
+	if statusCode != http.StatusNotFound && diagnostic != nil {
+		diags.Append(diagnostic)
+		return diags
+	}
+	return nil
+}
+
+// BindRoles assigns the roles of the user, which must be active
+func (r *UserResource) BindRoles(ctx context.Context, data *models.UserResource) diag.Diagnostics {
+	userRoles := models.UserRoles{
+		UserId:           data.Id,
+		OrganizationRole: data.OrganizationRole,
+		WorkspaceRoles:   data.WorkspaceRoles,
+		DeploymentRoles:  data.DeploymentRoles,
+		DagRoles:         data.DagRoles,
+	}
+	userRolesResource := UserRolesResource{
+		iamClient:      r.iamClient,
+		platformClient: r.platformClient,
+		organizationId: r.organizationId,
+	}
+	diags := userRolesResource.MutateRoles(ctx, &userRoles)
+	if diags.HasError() {
+		return diags
+	}
+	data.Status = types.StringValue(string(iam.ACTIVE))
+	data.OrganizationRole = userRoles.OrganizationRole
+	data.WorkspaceRoles = userRoles.WorkspaceRoles
+	data.DeploymentRoles = userRoles.DeploymentRoles
+	data.DagRoles = userRoles.DagRoles
+	return nil
+}
+
+// ValidateRoles validates the roles of a pending user, so a mistake shows up when the user is invited rather than when
+// the roles are bound
+func (r *UserResource) ValidateRoles(ctx context.Context, data *models.UserResource) diag.Diagnostics {
+	workspaceRoles, diags := common.RequestWorkspaceRoles(ctx, data.WorkspaceRoles)
+	if diags.HasError() {
+		return diags
+	}
+	deploymentRoles, diags := common.RequestDeploymentRoles(ctx, data.DeploymentRoles)
+	if diags.HasError() {
+		return diags
+	}
+	dagRoles, diags := common.RequestDagRoles(ctx, data.DagRoles)
+	if diags.HasError() {
+		return diags
+	}
+	diags = common.ValidateRolesWithDagRoles(workspaceRoles, deploymentRoles, dagRoles)
+	if diags.HasError() {
+		return diags
+	}
+	return common.ValidateWorkspaceDeploymentRoles(ctx, common.ValidateWorkspaceDeploymentRolesInput{
+		PlatformClient:  r.platformClient,
+		OrganizationId:  r.organizationId,
+		WorkspaceRoles:  workspaceRoles,
+		DeploymentRoles: deploymentRoles,
+	})
+}
+
+func (r *UserResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data models.UserResource
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, diags := r.FindUser(ctx, data.Email.ValueString())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if user != nil && user.Status == iam.ACTIVE {
+		// the user is already a member of the organization
+		data.Id = types.StringValue(user.Id)
+		data.InviteId = types.StringNull()
+		data.InviteExpiresAt = types.StringNull()
+		diags = r.BindRoles(ctx, &data)
+	} else {
+		diags = r.ValidateRoles(ctx, &data)
+		if !diags.HasError() {
+			diags = r.Invite(ctx, &data)
+		}
+	}
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created a user resource: %v", data.Id.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(userIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
+}
+
+func (r *UserResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data models.UserResource
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(userIdentity.Set(ctx, r.organizationId, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user *iam.User
+	if data.Id.IsNull() {
+		// the resource is imported by email
+		var diags diag.Diagnostics
+		user, diags = r.FindUser(ctx, data.Email.ValueString())
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if user == nil {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	} else {
+		userResp, err := r.iamClient.GetUserWithResponse(ctx, r.organizationId, data.Id.ValueString())
+		if err != nil {
+			tflog.Error(ctx, "failed to get user", map[string]interface{}{"error": err})
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to get user, got error: %s", err),
+			)
+			return
+		}
+		statusCode, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, userResp.HTTPResponse, userResp.Body, userResp.JSON200, "read user")
+		// If the resource no longer exists, it is recommended to ignore the errors
+		// and call RemoveResource to remove the resource from the state. The next Terraform plan will recreate the resource.
+		if statusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if diagnostic != nil {
+			resp.Diagnostics.Append(diagnostic)
+			return
+		}
+		user = userResp.JSON200
+	}
+
+	diags := data.ReadFromResponse(ctx, user)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("read a user resource: %v", data.Id.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data models.UserResource
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state models.UserResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	switch {
+	case state.Status.ValueString() != string(iam.PENDING):
+		diags = r.BindRoles(ctx, &data)
+	case data.InviteId.IsUnknown():
+		// the invite expired, see ModifyPlan
+		diags = r.ValidateRoles(ctx, &data)
+		if !diags.HasError() {
+			diags = r.DeleteInvite(ctx, state.InviteId.ValueString())
+		}
+		if !diags.HasError() {
+			diags = r.Invite(ctx, &data)
+		}
+	default:
+		// the roles are bound once the user accepts the invite
+		diags = r.ValidateRoles(ctx, &data)
+	}
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated a user resource: %v", data.Id.ValueString()))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(userIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
+}
+
+func (r *UserResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data models.UserResource
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	switch {
+	case data.Status.ValueString() != string(iam.PENDING):
+		// users cannot be removed from the organization, so only their roles are
+		data.OrganizationRole = types.StringValue(string(iam.UserOrganizationRoleORGANIZATIONMEMBER))
+		data.WorkspaceRoles = types.SetNull(data.WorkspaceRoles.ElementType(ctx))
+		data.DeploymentRoles = types.SetNull(data.DeploymentRoles.ElementType(ctx))
+		data.DagRoles = types.SetNull(data.DagRoles.ElementType(ctx))
+		diags = r.BindRoles(ctx, &data)
+	case !data.InviteId.IsNull():
+		diags = r.DeleteInvite(ctx, data.InviteId.ValueString())
+	}
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted a user resource: %v", data.Id.ValueString()))
+}
+
+func (r *UserResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state models.UserResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	var reinviteOnExpiry types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("reinvite_on_expiry"), &reinviteOnExpiry)...)
+	if resp.Diagnostics.HasError() || !state.InviteExpired(time.Now()) {
+		return
+	}
+
+	if !reinviteOnExpiry.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"User invite expired",
+			fmt.Sprintf("The invite sent to %s expired on %s before the user accepted it, so its roles cannot be assigned. Set reinvite_on_expiry to invite the user again.", state.Email.ValueString(), state.InviteExpiresAt.ValueString()),
+		)
+		return
+	}
+	// A new invite is sent on update, for a user that may get a new ID
+	for _, attribute := range []string{"id", "invite_id", "invite_expires_at"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attribute), types.StringUnknown())...)
+	}
+}
+
+func (r *UserResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	userIdentity.ImportStatePassthrough(ctx, r.organizationId, path.Root("email"), req, resp)
+}
@@ -26,6 +26,12 @@ import (
 var _ resource.Resource = &UserRolesResource{}
 var _ resource.ResourceWithImportState = &UserRolesResource{}
 var _ resource.ResourceWithConfigure = &UserRolesResource{}
+var _ resource.ResourceWithIdentity = &UserRolesResource{}
+
+// userRolesIdentity identifies the role bindings of an astro_user_roles by their subject, the user, in the scope of the organization
+var userRolesIdentity = resourceIdentity{
+	{name: "user_id", description: "User identifier"},
+}
 
 func NewUserRolesResource() resource.Resource {
 	return &UserRolesResource{}
@@ -58,6 +64,14 @@ func (r *UserRolesResource) Schema(
 	}
 }
 
+func (r *UserRolesResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = userRolesIdentity.Schema()
+}
+
 func (r *UserRolesResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -171,6 +185,7 @@ func (r *UserRolesResource) Create(
 	tflog.Trace(ctx, fmt.Sprintf("created a user_roles resource for user '%v'", data.UserId.ValueString()))
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(userRolesIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *UserRolesResource) Read(
@@ -185,6 +200,10 @@ func (r *UserRolesResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(userRolesIdentity.Set(ctx, r.organizationId, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	userId := data.UserId.ValueString()
 
@@ -264,6 +283,7 @@ func (r *UserRolesResource) Update(
 	tflog.Trace(ctx, fmt.Sprintf("updated a user_roles resource for user '%v'", data.UserId.ValueString()))
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(userRolesIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *UserRolesResource) Delete(
@@ -323,5 +343,5 @@ func (r *UserRolesResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("user_id"), req, resp)
+	userRolesIdentity.ImportStatePassthrough(ctx, r.organizationId, path.Root("user_id"), req, resp)
 }
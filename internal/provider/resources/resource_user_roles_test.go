@@ -413,6 +413,16 @@ type dagRoleInput struct {
 
 func userRoles(input userRolesInput) string {
 	userId := os.Getenv("HOSTED_DUMMY_USER_ID")
+	return fmt.Sprintf(`
+resource "astro_user_roles" "%v" {
+  	user_id = "%v"
+  	%v
+}
+`, userId, userId, userRolesAttributes(input))
+}
+
+// userRolesAttributes returns the organization_role, workspace_roles, deployment_roles and dag_roles attributes of input
+func userRolesAttributes(input userRolesInput) string {
 	deploymentRoles := lo.Map(input.DeploymentRoles, func(role utils.Role, _ int) string {
 		return fmt.Sprintf(`
 		{
@@ -459,15 +469,10 @@ func userRoles(input userRolesInput) string {
 		dagRolesStr = fmt.Sprintf("dag_roles = [%v]", strings.Join(dagRoles, ","))
 	}
 
-	return fmt.Sprintf(`
-resource "astro_user_roles" "%v" {
-  	user_id = "%v"
-  	organization_role = "%v"
+	return fmt.Sprintf(`organization_role = "%v"
   	%s
 	%s
-	%s
-}
-`, userId, userId, input.OrganizationRole, workspaceRolesStr, deploymentRolesStr, dagRolesStr)
+	%s`, input.OrganizationRole, workspaceRolesStr, deploymentRolesStr, dagRolesStr)
 }
 
 func testAccCheckUserRolesCorrect(t *testing.T, organizationRole string, workspaceRoles, deploymentRoles []utils.Role) func(state *terraform.State) error {
@@ -0,0 +1,201 @@
+package resources_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/samber/lo"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
+	"github.com/astronomer/terraform-provider-astro/internal/fakeapi"
+	astronomerprovider "github.com/astronomer/terraform-provider-astro/internal/provider"
+	"github.com/astronomer/terraform-provider-astro/internal/utils"
+)
+
+func TestAcc_ResourceUser(t *testing.T) {
+	namePrefix := utils.GenerateTestResourceName(10)
+	email := fmt.Sprintf("%v@astronomer.test", strings.ToLower(namePrefix))
+	workspaceId := os.Getenv("HOSTED_WORKSPACE_ID")
+	deploymentId := os.Getenv("HOSTED_DEPLOYMENT_ID")
+
+	resourceVar := fmt.Sprintf("astro_user.%v", namePrefix)
+	pendingRoles := userRolesInput{
+		OrganizationRole: string(iam.UserOrganizationRoleORGANIZATIONMEMBER),
+		WorkspaceRoles:   []utils.Role{{Role: string(iam.WORKSPACEMEMBER), EntityId: workspaceId}},
+		DeploymentRoles:  []utils.Role{{Role: "DEPLOYMENT_ACCESSOR", EntityId: deploymentId}},
+	}
+	roles := pendingRoles
+	roles.DagRoles = []dagRoleInput{{DeploymentId: deploymentId, DagId: "test_dag_id", Role: "DAG_AUTHOR"}}
+
+	steps := []resource.TestStep{
+		{
+			Config:      astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + user(namePrefix, "invalid-email", pendingRoles),
+			ExpectError: regexp.MustCompile("must be a valid email address"),
+		},
+		// The user is invited and its roles are kept until it accepts the invite
+		{
+			Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + user(namePrefix, email, pendingRoles),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttrSet(resourceVar, "id"),
+				resource.TestCheckResourceAttr(resourceVar, "email", email),
+				resource.TestCheckResourceAttr(resourceVar, "status", string(iam.PENDING)),
+				resource.TestCheckResourceAttrSet(resourceVar, "invite_id"),
+				resource.TestCheckResourceAttrSet(resourceVar, "invite_expires_at"),
+				resource.TestCheckResourceAttr(resourceVar, "reinvite_on_expiry", "false"),
+				resource.TestCheckResourceAttr(resourceVar, "workspace_roles.#", "1"),
+				resource.TestCheckResourceAttr(resourceVar, "deployment_roles.#", "1"),
+				testAccCheckUser(t, email, iam.PENDING, 0),
+			),
+		},
+		// The roles of a pending user can change
+		{
+			Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + user(namePrefix, email, roles),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(resourceVar, "status", string(iam.PENDING)),
+				resource.TestCheckResourceAttr(resourceVar, "dag_roles.#", "1"),
+				testAccCheckUser(t, email, iam.PENDING, 0),
+			),
+		},
+	}
+	// Only the fake API lets a test accept an invite
+	if fakeapi.Enabled() {
+		steps = append(steps, []resource.TestStep{
+			// The roles are bound on the first apply after the user accepted the invite
+			{
+				PreConfig: func() { acceptUserInvite(t, email) },
+				Config:    astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + user(namePrefix, email, roles),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceVar, "status", string(iam.ACTIVE)),
+					resource.TestCheckResourceAttr(resourceVar, "workspace_roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceVar, "deployment_roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceVar, "dag_roles.#", "1"),
+					testAccCheckUser(t, email, iam.ACTIVE, 1),
+				),
+			},
+			// Import by email
+			{
+				ResourceName:            resourceVar,
+				ImportState:             true,
+				ImportStateId:           email,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"invite_id", "invite_expires_at"},
+			},
+			// The roles of an active user are updated right away
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + user(namePrefix, email, userRolesInput{
+					OrganizationRole: string(iam.UserOrganizationRoleORGANIZATIONBILLINGADMIN),
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceVar, "organization_role", string(iam.UserOrganizationRoleORGANIZATIONBILLINGADMIN)),
+					resource.TestCheckNoResourceAttr(resourceVar, "workspace_roles"),
+					testAccCheckUser(t, email, iam.ACTIVE, 0),
+				),
+			},
+		}...)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { astronomerprovider.TestAccPreCheck(t) },
+		CheckDestroy: func(state *terraform.State) error {
+			// a pending user is no longer invited, an active user has no roles
+			found, err := findUser(email)
+			if err != nil || found == nil {
+				return err
+			}
+			if found.Status == iam.PENDING || len(lo.FromPtr(found.WorkspaceRoles)) > 0 {
+				return fmt.Errorf("user %s is still %s with workspace roles %v", email, found.Status, lo.FromPtr(found.WorkspaceRoles))
+			}
+			return nil
+		},
+		Steps: steps,
+	})
+}
+
+func user(name, email string, roles userRolesInput) string {
+	return fmt.Sprintf(`
+resource "astro_user" "%v" {
+	email = "%v"
+	%v
+}
+`, name, email, userRolesAttributes(roles))
+}
+
+// findUser returns the user of the organization with the email, or nil if there is none
+func findUser(email string) (*iam.User, error) {
+	client, err := utils.GetTestHostedIamClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get iam client: %w", err)
+	}
+	resp, err := client.ListUsersWithResponse(context.Background(), os.Getenv("HOSTED_ORGANIZATION_ID"), &iam.ListUsersParams{
+		Limit: lo.ToPtr(1000),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("failed to list users: %s", string(resp.Body))
+	}
+	found, ok := lo.Find(resp.JSON200.Users, func(user iam.User) bool {
+		return strings.EqualFold(user.Username, email)
+	})
+	if !ok {
+		return nil, nil
+	}
+	return &found, nil
+}
+
+// testAccCheckUser checks through the API the status and number of workspace roles of the user with the email
+func testAccCheckUser(t *testing.T, email string, status iam.UserStatus, numWorkspaceRoles int) resource.TestCheckFunc {
+	t.Helper()
+	return func(state *terraform.State) error {
+		found, err := findUser(email)
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return fmt.Errorf("user %s not found", email)
+		}
+		if found.Status != status {
+			return fmt.Errorf("expected user %s to be %s, got %s", email, status, found.Status)
+		}
+		if len(lo.FromPtr(found.WorkspaceRoles)) != numWorkspaceRoles {
+			return fmt.Errorf("expected user %s to have %d workspace roles, got %v", email, numWorkspaceRoles, lo.FromPtr(found.WorkspaceRoles))
+		}
+		return nil
+	}
+}
+
+// acceptUserInvite accepts the invite of the user with the email, like the user does in the Astro UI. It is only
+// possible with the fake API.
+func acceptUserInvite(t *testing.T, email string) {
+	t.Helper()
+	body, err := json.Marshal(fakeapi.AcceptUserInviteRequest{Email: email})
+	if err != nil {
+		t.Fatalf("failed to encode the accept invite request: %v", err)
+	}
+	url := fmt.Sprintf("%v/fake/organizations/%v/invites/accept", os.Getenv("ASTRO_API_HOST"), os.Getenv("HOSTED_ORGANIZATION_ID"))
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("failed to create the accept invite request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+os.Getenv("HOSTED_ORGANIZATION_API_TOKEN"))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("failed to accept the invite of %s: %v", email, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("failed to accept the invite of %s: status %d", email, resp.StatusCode)
+	}
+}
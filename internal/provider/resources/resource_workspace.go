@@ -10,6 +10,7 @@ import (
 	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
 	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
 	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,6 +21,13 @@ import (
 var _ resource.Resource = &workspaceResource{}
 var _ resource.ResourceWithImportState = &workspaceResource{}
 var _ resource.ResourceWithConfigure = &workspaceResource{}
+var _ resource.ResourceWithModifyPlan = &workspaceResource{}
+var _ resource.ResourceWithIdentity = &workspaceResource{}
+
+// workspaceIdentity identifies an astro_workspace by its ID
+var workspaceIdentity = resourceIdentity{
+	{name: "id", description: "Workspace identifier"},
+}
 
 func NewWorkspaceResource() resource.Resource {
 	return &workspaceResource{}
@@ -51,6 +59,14 @@ func (r *workspaceResource) Schema(
 	}
 }
 
+func (r *workspaceResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = workspaceIdentity.Schema()
+}
+
 func (r *workspaceResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -76,7 +92,7 @@ func (r *workspaceResource) Create(
 	req resource.CreateRequest,
 	resp *resource.CreateResponse,
 ) {
-	var data models.Workspace
+	var data models.WorkspaceResource
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -120,6 +136,7 @@ func (r *workspaceResource) Create(
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workspaceIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *workspaceResource) Read(
@@ -127,11 +144,15 @@ func (r *workspaceResource) Read(
 	req resource.ReadRequest,
 	resp *resource.ReadResponse,
 ) {
-	var data models.Workspace
+	var data models.WorkspaceResource
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(workspaceIdentity.Set(ctx, r.organizationId, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -179,7 +200,7 @@ func (r *workspaceResource) Update(
 	req resource.UpdateRequest,
 	resp *resource.UpdateResponse,
 ) {
-	var data models.Workspace
+	var data models.WorkspaceResource
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -224,6 +245,7 @@ func (r *workspaceResource) Update(
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(workspaceIdentity.Set(ctx, r.organizationId, resp.State, resp.Identity)...)
 }
 
 func (r *workspaceResource) Delete(
@@ -231,7 +253,7 @@ func (r *workspaceResource) Delete(
 	req resource.DeleteRequest,
 	resp *resource.DeleteResponse,
 ) {
-	var data models.Workspace
+	var data models.WorkspaceResource
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -240,6 +262,11 @@ func (r *workspaceResource) Delete(
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(DeletionProtectionDiagnostic("Workspace", data.Id.ValueString(), "destroy"))
+		return
+	}
+
 	// delete request
 	workspace, err := r.platformClient.DeleteWorkspaceWithResponse(
 		ctx,
@@ -264,10 +291,21 @@ func (r *workspaceResource) Delete(
 	tflog.Trace(ctx, fmt.Sprintf("deleted a workspace resource: %v", data.Id.ValueString()))
 }
 
+// ModifyPlan blocks destroy and replace plans while deletion_protection is enabled in the prior state.
+func (r *workspaceResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	ModifyPlanDeletionProtection(ctx, req, resp, "Workspace", nil)
+}
+
 func (r *workspaceResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, workspaceIdentity, r.organizationId, path.Root("id"), req, resp, func(ctx context.Context, id string) (string, diag.Diagnostics) {
+		return resolveWorkspaceImportId(ctx, r.platformClient, r.organizationId, id)
+	})
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/astronomer/terraform-provider-astro/internal/clients"
@@ -106,6 +107,48 @@ func TestAcc_WorkspaceRemovedOutsideOfTerraform(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceWorkspaceDeletionProtection(t *testing.T) {
+	workspaceName := utils.GenerateTestResourceName(10)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: astronomerprovider.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { astronomerprovider.TestAccPreCheck(t) },
+		CheckDestroy:             testAccCheckWorkspaceExistence(t, workspaceName, false),
+		Steps: []resource.TestStep{
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + workspaceWithDeletionProtection(workspaceName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("astro_workspace.test", "deletion_protection", "true"),
+					testAccCheckWorkspaceExistence(t, workspaceName, true),
+				),
+			},
+			// Destroying the workspace fails while deletion_protection is enabled
+			{
+				Config:      astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + workspaceWithDeletionProtection(workspaceName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Cannot destroy Workspace with deletion_protection enabled"),
+			},
+			// Turn off deletion_protection so the workspace can be destroyed
+			{
+				Config: astronomerprovider.ProviderConfig(t, astronomerprovider.HOSTED) + workspaceWithDeletionProtection(workspaceName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("astro_workspace.test", "deletion_protection", "false"),
+					testAccCheckWorkspaceExistence(t, workspaceName, true),
+				),
+			},
+		},
+	})
+}
+
+func workspaceWithDeletionProtection(name string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "astro_workspace" "test" {
+	name = "%s"
+	description = "%s"
+	cicd_enforced_default = true
+	deletion_protection = %t
+}`, name, utils.TestResourceDescription, deletionProtection)
+}
+
 func workspaceWithVariableName() string {
 	return fmt.Sprintf(`
 variable "name" {
@@ -0,0 +1,149 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients"
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+// runtimeReleaseChannelDeprecated is the channel of the runtime releases that are still offered but deprecated
+const runtimeReleaseChannelDeprecated = "deprecated"
+
+// runtimeSupportStatus returns the support status of the runtime version among all the releases offered by the
+// platform. A version that is no longer offered has reached its end of support, and the status is UNKNOWN when no
+// release is offered at all.
+func runtimeSupportStatus(version string, releases []platform.RuntimeRelease) string {
+	if len(releases) == 0 {
+		return schemas.RuntimeSupportStatusUnknown
+	}
+	release, ok := lo.Find(releases, func(release platform.RuntimeRelease) bool {
+		return release.Version == version
+	})
+	switch {
+	case !ok:
+		return schemas.RuntimeSupportStatusUnsupported
+	case strings.EqualFold(release.Channel, runtimeReleaseChannelDeprecated):
+		return schemas.RuntimeSupportStatusDeprecated
+	default:
+		return schemas.RuntimeSupportStatusSupported
+	}
+}
+
+// runtimeReleasesKey identifies the runtime releases offered to an organization through a platform client
+type runtimeReleasesKey struct {
+	platformClient *platform.ClientWithResponses
+	organizationId string
+}
+
+// runtimeReleases caches the runtime releases by runtimeReleasesKey, so that they are listed once per Terraform command
+// instead of for every deployment planned, created or read
+var runtimeReleases sync.Map
+
+// listRuntimeReleases returns all the runtime releases offered by the platform. The deployment options are not filtered
+// by deployment type, executor or cloud provider, since a release missing from a filtered list is still supported by
+// the deployments already running it.
+func (r *DeploymentResource) listRuntimeReleases(ctx context.Context) ([]platform.RuntimeRelease, diag.Diagnostic) {
+	key := runtimeReleasesKey{platformClient: r.platformClient, organizationId: r.organizationId}
+	if releases, ok := runtimeReleases.Load(key); ok {
+		return releases.([]platform.RuntimeRelease), nil
+	}
+	deploymentOptions, err := r.platformClient.GetDeploymentOptionsWithResponse(ctx, r.organizationId, &platform.GetDeploymentOptionsParams{})
+	if err != nil {
+		tflog.Error(ctx, "failed to get deployment options", map[string]interface{}{"error": err})
+		return nil, diag.NewErrorDiagnostic(
+			"Client Error",
+			fmt.Sprintf("Unable to get deployment options, got error: %s", err),
+		)
+	}
+	_, diagnostic := clients.NormalizeAPIResponseWithBody(ctx, deploymentOptions.HTTPResponse, deploymentOptions.Body, deploymentOptions.JSON200, "read deployment options")
+	if diagnostic != nil {
+		return nil, diagnostic
+	}
+	runtimeReleases.Store(key, deploymentOptions.JSON200.RuntimeReleases)
+	return deploymentOptions.JSON200.RuntimeReleases, nil
+}
+
+// readRuntimeSupportStatus returns the support status of the runtime of the deployment. The status is UNKNOWN when the
+// runtime releases cannot be read, so that a failure does not prevent reading the deployment.
+func (r *DeploymentResource) readRuntimeSupportStatus(ctx context.Context, data *models.DeploymentResource) types.String {
+	releases, diagnostic := r.listRuntimeReleases(ctx)
+	if diagnostic != nil {
+		tflog.Warn(ctx, "failed to read the runtime support status", map[string]interface{}{"error": diagnostic.Detail()})
+		return types.StringValue(schemas.RuntimeSupportStatusUnknown)
+	}
+	return types.StringValue(runtimeSupportStatus(data.AstroRuntimeVersion.ValueString(), releases))
+}
+
+// ModifyPlanRuntimeSupport reports a deployment on a deprecated or unsupported runtime version with the
+// runtime_deprecation_severity of the plan. Existing deployments use the runtime_support_status refreshed by Read, new
+// deployments the status of original_astro_runtime_version among the releases offered.
+func (r *DeploymentResource) ModifyPlanRuntimeSupport(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	var plan models.DeploymentResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	severity := plan.RuntimeDeprecationSeverity.ValueString()
+	if severity == schemas.RuntimeDeprecationSeverityNone || plan.RuntimeDeprecationSeverity.IsUnknown() {
+		return
+	}
+
+	var version, status string
+	attributePath := path.Root("astro_runtime_version")
+	if req.State.Raw.IsNull() {
+		// The latest version is used when original_astro_runtime_version is not set
+		if plan.OriginalAstroRuntimeVersion.ValueString() == "" {
+			return
+		}
+		version = plan.OriginalAstroRuntimeVersion.ValueString()
+		attributePath = path.Root("original_astro_runtime_version")
+		releases, diagnostic := r.listRuntimeReleases(ctx)
+		if diagnostic != nil {
+			resp.Diagnostics.Append(diagnostic)
+			return
+		}
+		status = runtimeSupportStatus(version, releases)
+	} else {
+		var state models.DeploymentResource
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		version = state.AstroRuntimeVersion.ValueString()
+		status = state.RuntimeSupportStatus.ValueString()
+	}
+
+	var summary, detail string
+	switch status {
+	case schemas.RuntimeSupportStatusDeprecated:
+		summary = "Deprecated Astro Runtime version"
+		detail = fmt.Sprintf("Astro Runtime %s is deprecated and will soon no longer be supported.", version)
+	case schemas.RuntimeSupportStatusUnsupported:
+		summary = "Unsupported Astro Runtime version"
+		detail = fmt.Sprintf("Astro Runtime %s is no longer offered by Astro and has reached its end of support.", version)
+	default:
+		return
+	}
+	detail += " Upgrade the Astro Runtime version of your Astro project and deploy the new image, or set runtime_deprecation_severity to change how this is reported."
+	if severity == schemas.RuntimeDeprecationSeverityError {
+		resp.Diagnostics.AddAttributeError(attributePath, summary, detail)
+	} else {
+		resp.Diagnostics.AddAttributeWarning(attributePath, summary, detail)
+	}
+}
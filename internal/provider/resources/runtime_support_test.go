@@ -0,0 +1,52 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
+	"github.com/astronomer/terraform-provider-astro/internal/fakeapi"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+)
+
+func TestUnit_RuntimeSupportStatus(t *testing.T) {
+	releases := []platform.RuntimeRelease{
+		{Version: "3.0-4", Channel: "stable"},
+		{Version: "12.9.0", Channel: "stable"},
+		{Version: "9.18.0", Channel: "DEPRECATED"},
+	}
+
+	assert.Equal(t, schemas.RuntimeSupportStatusSupported, runtimeSupportStatus("3.0-4", releases))
+	assert.Equal(t, schemas.RuntimeSupportStatusSupported, runtimeSupportStatus("12.9.0", releases))
+	assert.Equal(t, schemas.RuntimeSupportStatusDeprecated, runtimeSupportStatus("9.18.0", releases))
+	assert.Equal(t, schemas.RuntimeSupportStatusUnsupported, runtimeSupportStatus("7.4.0", releases))
+	assert.Equal(t, schemas.RuntimeSupportStatusUnknown, runtimeSupportStatus("12.9.0", nil))
+}
+
+func TestUnit_ListRuntimeReleases(t *testing.T) {
+	ctx := context.Background()
+	var queries []string
+	server := fakeapi.NewTestServer(t, func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if strings.HasSuffix(req.URL.Path, "/deployment-options") {
+				queries = append(queries, req.URL.RawQuery)
+			}
+			next.ServeHTTP(w, req)
+		})
+	})
+	r := &DeploymentResource{platformClient: server.PlatformClient, organizationId: server.OrganizationId}
+
+	releases, diagnostic := r.listRuntimeReleases(ctx)
+	require.Nil(t, diagnostic)
+	assert.Equal(t, schemas.RuntimeSupportStatusDeprecated, runtimeSupportStatus("9.18.0", releases))
+
+	// The releases are listed once for all the deployments, without filters
+	_, diagnostic = r.listRuntimeReleases(ctx)
+	require.Nil(t, diagnostic)
+	assert.Equal(t, []string{""}, queries)
+}
@@ -0,0 +1,200 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Several resources manage the same Astro objects, e.g. astro_alert and astro_alerts or astro_team and
+// astro_team_roles. Their MoveState handlers let a `moved` block switch an object from one to the other without
+// deleting and recreating it. A move only sets the attributes both resources have: Terraform refreshes the moved
+// resource before planning, which reads the others from the API.
+
+// providerAddress is the address of this provider, the only provider whose resources can be moved to its resources
+const providerAddress = "registry.terraform.io/astronomer/astro"
+
+// stateMover returns a StateMover moving the state of a sourceTypeName resource of this provider, whose schema has the
+// sourceAttributes, with move. Other source resources are left to the other StateMovers of the target resource, the
+// framework returns an error if none of them moves the state.
+func stateMover(
+	sourceTypeName string,
+	sourceAttributes map[string]schema.Attribute,
+	move func(ctx context.Context, source *tfsdk.State, target *tfsdk.State) diag.Diagnostics,
+) resource.StateMover {
+	return resource.StateMover{
+		SourceSchema: &schema.Schema{
+			Attributes: sourceAttributes,
+		},
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if req.SourceTypeName != sourceTypeName || req.SourceProviderAddress != providerAddress || req.SourceState == nil {
+				return
+			}
+			resp.Diagnostics.Append(move(ctx, req.SourceState, &resp.TargetState)...)
+		},
+	}
+}
+
+// setAttributes sets the root attributes of state to values, the other attributes are null
+func setAttributes(ctx context.Context, state *tfsdk.State, values map[string]attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for name, value := range values {
+		diags.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	return diags
+}
+
+// alertToAlertsStateMover moves an astro_alert to an astro_alerts resource, where the alert is keyed by its name
+func alertToAlertsStateMover() resource.StateMover {
+	return stateMover("astro_alert", schemas.AlertResourceSchemaAttributes(), func(ctx context.Context, source *tfsdk.State, target *tfsdk.State) diag.Diagnostics {
+		var alert models.AlertResource
+		diags := source.Get(ctx, &alert)
+		if diags.HasError() {
+			return diags
+		}
+		element := models.AlertsResourceElementModel{
+			Id:                     alert.Id,
+			Name:                   alert.Name,
+			Type:                   alert.Type,
+			Rules:                  alert.Rules,
+			Severity:               alert.Severity,
+			EntityId:               alert.EntityId,
+			EntityType:             alert.EntityType,
+			NotificationChannelIds: alert.NotificationChannelIds,
+		}
+		alerts, diags := types.MapValueFrom(ctx, models.AlertsElementObjectType(), map[string]models.AlertsResourceElementModel{
+			alert.Name.ValueString(): element,
+		})
+		if diags.HasError() {
+			return diags
+		}
+		return target.Set(ctx, &models.AlertsResource{Alerts: alerts})
+	})
+}
+
+// alertsToAlertStateMover moves an astro_alerts resource managing a single alert to an astro_alert
+func alertsToAlertStateMover() resource.StateMover {
+	return stateMover("astro_alerts", schemas.AlertsResourceSchemaAttributes(), func(ctx context.Context, source *tfsdk.State, target *tfsdk.State) diag.Diagnostics {
+		var data models.AlertsResource
+		diags := source.Get(ctx, &data)
+		if diags.HasError() {
+			return diags
+		}
+		elements := make(map[string]models.AlertsResourceElementModel)
+		diags = data.Alerts.ElementsAs(ctx, &elements, false)
+		if diags.HasError() {
+			return diags
+		}
+		if len(elements) != 1 {
+			diags.AddError("Unable to move astro_alerts",
+				fmt.Sprintf("astro_alerts manages %d alerts and only an astro_alerts managing a single alert can be moved to astro_alert. Remove the other alerts from astro_alerts first, or import them as astro_alert resources.", len(elements)))
+			return diags
+		}
+		for _, element := range elements {
+			diags = setAttributes(ctx, target, map[string]attr.Value{
+				"id":                       element.Id,
+				"name":                     element.Name,
+				"type":                     element.Type,
+				"rules":                    element.Rules,
+				"severity":                 element.Severity,
+				"entity_id":                element.EntityId,
+				"entity_type":              element.EntityType,
+				"notification_channel_ids": element.NotificationChannelIds,
+			})
+		}
+		return diags
+	})
+}
+
+// teamToTeamRolesStateMover moves the roles of an astro_team to an astro_team_roles
+func teamToTeamRolesStateMover() resource.StateMover {
+	return stateMover("astro_team", schemas.TeamResourceSchemaAttributes(), func(ctx context.Context, source *tfsdk.State, target *tfsdk.State) diag.Diagnostics {
+		var team models.TeamResource
+		diags := source.Get(ctx, &team)
+		if diags.HasError() {
+			return diags
+		}
+		return target.Set(ctx, &models.TeamRoles{
+			TeamId:           team.Id,
+			OrganizationRole: team.OrganizationRole,
+			WorkspaceRoles:   team.WorkspaceRoles,
+			DeploymentRoles:  team.DeploymentRoles,
+			DagRoles:         team.DagRoles,
+		})
+	})
+}
+
+// teamRolesToTeamStateMover moves an astro_team_roles to an astro_team with the same roles
+func teamRolesToTeamStateMover() resource.StateMover {
+	return stateMover("astro_team_roles", schemas.ResourceTeamRolesSchemaAttributes(), func(ctx context.Context, source *tfsdk.State, target *tfsdk.State) diag.Diagnostics {
+		var teamRoles models.TeamRoles
+		diags := source.Get(ctx, &teamRoles)
+		if diags.HasError() {
+			return diags
+		}
+		return setAttributes(ctx, target, map[string]attr.Value{
+			"id":                teamRoles.TeamId,
+			"organization_role": teamRoles.OrganizationRole,
+			"workspace_roles":   teamRoles.WorkspaceRoles,
+			"deployment_roles":  teamRoles.DeploymentRoles,
+			"dag_roles":         teamRoles.DagRoles,
+		})
+	})
+}
+
+// teamToTeamMembershipStateMover moves an astro_team with a single member in member_ids to an astro_team_membership
+func teamToTeamMembershipStateMover() resource.StateMover {
+	return stateMover("astro_team", schemas.TeamResourceSchemaAttributes(), func(ctx context.Context, source *tfsdk.State, target *tfsdk.State) diag.Diagnostics {
+		var team models.TeamResource
+		diags := source.Get(ctx, &team)
+		if diags.HasError() {
+			return diags
+		}
+		var memberIds []string
+		diags = team.MemberIds.ElementsAs(ctx, &memberIds, false)
+		if diags.HasError() {
+			return diags
+		}
+		if len(memberIds) != 1 {
+			diags.AddError("Unable to move astro_team",
+				fmt.Sprintf("Team %s has %d members in member_ids and only an astro_team with a single member can be moved to astro_team_membership. Import the other memberships as astro_team_membership resources instead.", team.Id.ValueString(), len(memberIds)))
+			return diags
+		}
+		return target.Set(ctx, &models.TeamMembership{
+			ID:     membershipID(team.Id.ValueString(), memberIds[0]),
+			TeamId: team.Id,
+			UserId: types.StringValue(memberIds[0]),
+		})
+	})
+}
+
+// teamMembershipToTeamStateMover moves an astro_team_membership to an astro_team with the user in member_ids
+func teamMembershipToTeamStateMover() resource.StateMover {
+	return stateMover("astro_team_membership", schemas.TeamMembershipResourceSchemaAttributes(), func(ctx context.Context, source *tfsdk.State, target *tfsdk.State) diag.Diagnostics {
+		var membership models.TeamMembership
+		diags := source.Get(ctx, &membership)
+		if diags.HasError() {
+			return diags
+		}
+		memberIds, diags := types.SetValue(types.StringType, []attr.Value{membership.UserId})
+		if diags.HasError() {
+			return diags
+		}
+		return setAttributes(ctx, target, map[string]attr.Value{
+			"id":         membership.TeamId,
+			"member_ids": memberIds,
+		})
+	})
+}
@@ -0,0 +1,240 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sourceState builds a state with the attributes set to values, the other attributes are null
+func sourceState(t *testing.T, ctx context.Context, attributes map[string]rschema.Attribute, values map[string]attr.Value) *tfsdk.State {
+	t.Helper()
+	s := rschema.Schema{Attributes: attributes}
+	state := &tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	diags := setAttributes(ctx, state, values)
+	require.False(t, diags.HasError(), diags)
+	return state
+}
+
+// moveState moves the state of a sourceTypeName resource to target with the first StateMover handling it
+func moveState(t *testing.T, ctx context.Context, target resource.ResourceWithMoveState, sourceTypeName string, source *tfsdk.State) *resource.MoveStateResponse {
+	t.Helper()
+	var schemaResp resource.SchemaResponse
+	target.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	emptyState := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+	resp := &resource.MoveStateResponse{}
+	for _, mover := range target.MoveState(ctx) {
+		resp = &resource.MoveStateResponse{TargetState: tfsdk.State{Schema: schemaResp.Schema, Raw: emptyState}}
+		mover.StateMover(ctx, resource.MoveStateRequest{
+			SourceTypeName:        sourceTypeName,
+			SourceProviderAddress: providerAddress,
+			SourceState:           source,
+		}, resp)
+		if resp.Diagnostics.HasError() || !resp.TargetState.Raw.Equal(emptyState) {
+			return resp
+		}
+	}
+	return resp
+}
+
+func testAlertRules(t *testing.T) types.Object {
+	t.Helper()
+	propertyTypes := schemas.AlertRulesResourceAttributeTypes()["properties"].(types.ObjectType).AttrTypes
+	properties := map[string]attr.Value{
+		"deployment_id":            types.StringValue("clx44jyu001m201m5dzsbexqr"),
+		"dag_duration_seconds":     types.Int64Value(300),
+		"dag_deadline":             types.StringNull(),
+		"days_of_week":             types.SetNull(types.StringType),
+		"look_back_period_seconds": types.Int64Null(),
+		"task_duration_seconds":    types.Int64Null(),
+	}
+	rules, diags := types.ObjectValue(schemas.AlertRulesResourceAttributeTypes(), map[string]attr.Value{
+		"properties":      types.ObjectValueMust(propertyTypes, properties),
+		"pattern_matches": types.SetNull(types.ObjectType{AttrTypes: schemas.AlertRulesPatternMatchAttributeTypes()}),
+	})
+	require.False(t, diags.HasError(), diags)
+	return rules
+}
+
+func TestUnit_AlertStateMovers(t *testing.T) {
+	ctx := context.Background()
+	notificationChannelIds := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("clx46acvv000001mh9k6k9t9h")})
+	alert := map[string]attr.Value{
+		"id":                       types.StringValue("clx45xm9p000101mh2zqz9d8y"),
+		"name":                     types.StringValue("dag_duration"),
+		"type":                     types.StringValue("DAG_DURATION"),
+		"rules":                    testAlertRules(t),
+		"severity":                 types.StringValue("WARNING"),
+		"entity_id":                types.StringValue("clx44jyu001m201m5dzsbexqr"),
+		"entity_type":              types.StringValue("DEPLOYMENT"),
+		"notification_channel_ids": notificationChannelIds,
+	}
+
+	t.Run("moves an astro_alert to astro_alerts keyed by its name", func(t *testing.T) {
+		resp := moveState(t, ctx, &alertsResource{}, "astro_alert", sourceState(t, ctx, schemas.AlertResourceSchemaAttributes(), alert))
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var data models.AlertsResource
+		diags := resp.TargetState.Get(ctx, &data)
+		require.False(t, diags.HasError(), diags)
+		elements := map[string]models.AlertsResourceElementModel{}
+		diags = data.Alerts.ElementsAs(ctx, &elements, false)
+		require.False(t, diags.HasError(), diags)
+		require.Contains(t, elements, "dag_duration")
+		assert.Equal(t, "clx45xm9p000101mh2zqz9d8y", elements["dag_duration"].Id.ValueString())
+		assert.Equal(t, "WARNING", elements["dag_duration"].Severity.ValueString())
+		assert.True(t, elements["dag_duration"].NotificationChannelIds.Equal(notificationChannelIds))
+	})
+
+	element := types.ObjectValueMust(schemas.AlertsElementResourceAttributeTypes(), alert)
+
+	t.Run("moves an astro_alerts with a single alert to astro_alert", func(t *testing.T) {
+		alerts := types.MapValueMust(models.AlertsElementObjectType(), map[string]attr.Value{"dag_duration": element})
+		resp := moveState(t, ctx, &alertResource{}, "astro_alerts", sourceState(t, ctx, schemas.AlertsResourceSchemaAttributes(), map[string]attr.Value{"alerts": alerts}))
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var data models.AlertResource
+		diags := resp.TargetState.Get(ctx, &data)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, "clx45xm9p000101mh2zqz9d8y", data.Id.ValueString())
+		assert.Equal(t, "DAG_DURATION", data.Type.ValueString())
+		assert.True(t, data.Rules.Equal(alert["rules"]))
+		assert.True(t, data.CreatedAt.IsNull())
+	})
+
+	t.Run("rejects an astro_alerts with several alerts", func(t *testing.T) {
+		alerts := types.MapValueMust(models.AlertsElementObjectType(), map[string]attr.Value{"first": element, "second": element})
+		resp := moveState(t, ctx, &alertResource{}, "astro_alerts", sourceState(t, ctx, schemas.AlertsResourceSchemaAttributes(), map[string]attr.Value{"alerts": alerts}))
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "astro_alerts manages 2 alerts")
+	})
+}
+
+func TestUnit_TeamStateMovers(t *testing.T) {
+	ctx := context.Background()
+	workspaceRoles := types.SetValueMust(types.ObjectType{AttrTypes: schemas.WorkspaceRoleAttributeTypes()}, []attr.Value{
+		types.ObjectValueMust(schemas.WorkspaceRoleAttributeTypes(), map[string]attr.Value{
+			"workspace_id": types.StringValue("clx42sxw501gl01o0gjenthnh"),
+			"role":         types.StringValue("WORKSPACE_MEMBER"),
+		}),
+	})
+	team := func(memberIds ...string) *tfsdk.State {
+		members := make([]attr.Value, 0, len(memberIds))
+		for _, memberId := range memberIds {
+			members = append(members, types.StringValue(memberId))
+		}
+		return sourceState(t, ctx, schemas.TeamResourceSchemaAttributes(), map[string]attr.Value{
+			"id":                types.StringValue("clx4825jb068z01j9931ib5gb"),
+			"name":              types.StringValue("data-engineering"),
+			"organization_role": types.StringValue("ORGANIZATION_MEMBER"),
+			"workspace_roles":   workspaceRoles,
+			"member_ids":        types.SetValueMust(types.StringType, members),
+		})
+	}
+
+	t.Run("moves the roles of an astro_team to astro_team_roles", func(t *testing.T) {
+		resp := moveState(t, ctx, &teamRolesResource{}, "astro_team", team())
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var data models.TeamRoles
+		diags := resp.TargetState.Get(ctx, &data)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, "clx4825jb068z01j9931ib5gb", data.TeamId.ValueString())
+		assert.Equal(t, "ORGANIZATION_MEMBER", data.OrganizationRole.ValueString())
+		assert.True(t, data.WorkspaceRoles.Equal(workspaceRoles))
+	})
+
+	t.Run("moves an astro_team_roles to astro_team", func(t *testing.T) {
+		resp := moveState(t, ctx, &TeamResource{}, "astro_team_roles", sourceState(t, ctx, schemas.ResourceTeamRolesSchemaAttributes(), map[string]attr.Value{
+			"team_id":           types.StringValue("clx4825jb068z01j9931ib5gb"),
+			"organization_role": types.StringValue("ORGANIZATION_MEMBER"),
+			"workspace_roles":   workspaceRoles,
+		}))
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var data models.TeamResource
+		diags := resp.TargetState.Get(ctx, &data)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, "clx4825jb068z01j9931ib5gb", data.Id.ValueString())
+		assert.True(t, data.WorkspaceRoles.Equal(workspaceRoles))
+		assert.True(t, data.Name.IsNull())
+		assert.True(t, data.MemberIds.IsNull())
+	})
+
+	t.Run("moves an astro_team with a single member to astro_team_membership", func(t *testing.T) {
+		resp := moveState(t, ctx, &teamMembershipResource{}, "astro_team", team("clhpichn8002m01mqa4ocuyol"))
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var data models.TeamMembership
+		diags := resp.TargetState.Get(ctx, &data)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, "clx4825jb068z01j9931ib5gb/clhpichn8002m01mqa4ocuyol", data.ID.ValueString())
+		assert.Equal(t, "clhpichn8002m01mqa4ocuyol", data.UserId.ValueString())
+	})
+
+	t.Run("rejects an astro_team with several members", func(t *testing.T) {
+		resp := moveState(t, ctx, &teamMembershipResource{}, "astro_team", team("clhpichn8002m01mqa4ocuyol", "clhpichn8002m01mqa4ocuyom"))
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "has 2 members in member_ids")
+	})
+
+	t.Run("moves an astro_team_membership to astro_team", func(t *testing.T) {
+		resp := moveState(t, ctx, &TeamResource{}, "astro_team_membership", sourceState(t, ctx, schemas.TeamMembershipResourceSchemaAttributes(), map[string]attr.Value{
+			"id":      types.StringValue("clx4825jb068z01j9931ib5gb/clhpichn8002m01mqa4ocuyol"),
+			"team_id": types.StringValue("clx4825jb068z01j9931ib5gb"),
+			"user_id": types.StringValue("clhpichn8002m01mqa4ocuyol"),
+		}))
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var data models.TeamResource
+		diags := resp.TargetState.Get(ctx, &data)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, "clx4825jb068z01j9931ib5gb", data.Id.ValueString())
+		assert.Len(t, data.MemberIds.Elements(), 1)
+		assert.True(t, data.OrganizationRole.IsNull())
+	})
+
+	t.Run("ignores resources of other providers", func(t *testing.T) {
+		resp := &resource.MoveStateResponse{}
+		teamRolesToTeamStateMover().StateMover(ctx, resource.MoveStateRequest{
+			SourceTypeName:        "astro_team_roles",
+			SourceProviderAddress: "registry.terraform.io/other/astro",
+			SourceState:           team(),
+		}, resp)
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.True(t, resp.TargetState.Raw.IsNull())
+	})
+}
+
+func TestUnit_TypedEnvironmentObjectToEnvironmentObjectStateMover(t *testing.T) {
+	ctx := context.Background()
+	source := sourceState(t, ctx, schemas.AirflowVariableResourceSchemaAttributes(), map[string]attr.Value{
+		"id":              types.StringValue("clx46acvv000001mh9k6k9t9h"),
+		"key":             types.StringValue("etl_default_region"),
+		"scope":           types.StringValue("DEPLOYMENT"),
+		"scope_entity_id": types.StringValue("clx44jyu001m201m5dzsbexqr"),
+		"value":           types.StringValue("us-east-1"),
+	})
+
+	resp := moveState(t, ctx, &environmentObjectResource{}, "astro_airflow_variable", source)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var data models.EnvironmentObject
+	diags := resp.TargetState.Get(ctx, &data)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "clx46acvv000001mh9k6k9t9h", data.Id.ValueString())
+	assert.Equal(t, "etl_default_region", data.ObjectKey.ValueString())
+	assert.Equal(t, "AIRFLOW_VARIABLE", data.ObjectType.ValueString())
+	assert.Equal(t, "us-east-1", data.Value.ValueString())
+	assert.True(t, data.Host.IsNull())
+}
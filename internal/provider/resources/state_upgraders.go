@@ -0,0 +1,38 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Version 0 is the implicit version of every schema released before the schemas were versioned. The state of a
+// version 0 schema is in the current shape: the attributes added since are null until Read, which sets the defaults
+// of the Terraform-only attributes such as deletion_protection, and refreshes the others from the API.
+
+// rawStateUpgrader returns a StateUpgrader reading the raw JSON state of an earlier schema version into the current
+// schema, dropping the attributes the current schema no longer has.
+func rawStateUpgrader() resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil || req.RawState.JSON == nil {
+				resp.Diagnostics.AddError("Unable to upgrade resource state", "The prior state is not stored as JSON and cannot be upgraded")
+				return
+			}
+
+			var err error
+			resp.State.Raw, err = req.RawState.UnmarshalWithOpts(resp.State.Schema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+				ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+					IgnoreUndefinedAttributes: true,
+				},
+			})
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to upgrade resource state", fmt.Sprintf("The prior state does not match the current schema, got error: %s", err))
+				return
+			}
+		},
+	}
+}
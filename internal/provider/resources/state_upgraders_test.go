@@ -0,0 +1,218 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/astronomer/terraform-provider-astro/internal/fakeapi"
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The version 0 states in testdata/state/v0 were recorded with the resources of the last release before the schemas
+// were versioned, reading the objects of the fake API like after an import, and are stored as Terraform stores them.
+
+// upgradeRawState upgrades the version 0 rawState to the current schema of r
+func upgradeRawState(t *testing.T, ctx context.Context, r resource.ResourceWithUpgradeState, rawState []byte) tfsdk.State {
+	t.Helper()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.Equal(t, int64(1), schemaResp.Schema.Version)
+
+	upgrader, ok := r.UpgradeState(ctx)[0]
+	require.True(t, ok, "no upgrader from version 0")
+	resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: rawState}}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	return resp.State
+}
+
+// upgradeFixture upgrades the version 0 state recorded in testdata/state/v0/<fixture>.json like upgradeRawState, and
+// checks that every attribute of the fixture kept its value
+func upgradeFixture(t *testing.T, ctx context.Context, r resource.ResourceWithUpgradeState, fixture string) tfsdk.State {
+	t.Helper()
+	rawState, err := os.ReadFile(filepath.Join("testdata", "state", "v0", fixture+".json"))
+	require.NoError(t, err)
+	state := upgradeRawState(t, ctx, r, rawState)
+
+	var priorState map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(rawState))
+	decoder.UseNumber()
+	require.NoError(t, decoder.Decode(&priorState))
+	upgradedState, ok := stateValueJSON(state.Raw).(map[string]any)
+	require.True(t, ok)
+	for name, value := range priorState {
+		assert.Equal(t, value, upgradedState[name], name)
+	}
+	return state
+}
+
+// stateValueJSON returns value as it is decoded from the JSON of a Terraform state
+func stateValueJSON(value tftypes.Value) any {
+	if value.IsNull() {
+		return nil
+	}
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+		_ = value.As(&s)
+		return s
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+		_ = value.As(&b)
+		return b
+	case value.Type().Is(tftypes.Number):
+		var n big.Float
+		_ = value.As(&n)
+		return json.Number(n.Text('f', -1))
+	case value.Type().Is(tftypes.Object{}), value.Type().Is(tftypes.Map{}):
+		var attributes map[string]tftypes.Value
+		_ = value.As(&attributes)
+		object := map[string]any{}
+		for name, attribute := range attributes {
+			object[name] = stateValueJSON(attribute)
+		}
+		return object
+	default:
+		var elements []tftypes.Value
+		_ = value.As(&elements)
+		list := []any{}
+		for _, element := range elements {
+			list = append(list, stateValueJSON(element))
+		}
+		return list
+	}
+}
+
+func TestUnit_DeploymentStateUpgrader(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("standard deployment", func(t *testing.T) {
+		state := upgradeFixture(t, ctx, &DeploymentResource{}, "deployment_standard")
+
+		var data models.DeploymentResource
+		diags := state.Get(ctx, &data)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, "acceptance-tests-standard", data.Name.ValueString())
+		assert.Equal(t, "STANDARD", data.Type.ValueString())
+		assert.Len(t, data.WorkerQueues.Elements(), 1)
+		assert.Len(t, data.EnvironmentVariables.Elements(), 1)
+		assert.True(t, data.ScalingSpec.IsNull())
+		// Read sets the defaults of the attributes added since version 0
+		assert.True(t, data.DeletionProtection.IsNull())
+		assert.True(t, data.RuntimeDeprecationSeverity.IsNull())
+		assert.True(t, data.DrWorkloadIdentity.IsNull())
+	})
+
+	t.Run("development deployment with hibernation schedules", func(t *testing.T) {
+		state := upgradeFixture(t, ctx, &DeploymentResource{}, "deployment_development")
+
+		var data models.DeploymentResource
+		diags := state.Get(ctx, &data)
+		require.False(t, diags.HasError(), diags)
+		assert.True(t, data.IsDevelopmentMode.ValueBool())
+
+		var scalingSpec models.DeploymentScalingSpec
+		diags = data.ScalingSpec.As(ctx, &scalingSpec, basetypes.ObjectAsOptions{})
+		require.False(t, diags.HasError(), diags)
+		var hibernationSpec models.HibernationSpec
+		diags = scalingSpec.HibernationSpec.As(ctx, &hibernationSpec, basetypes.ObjectAsOptions{})
+		require.False(t, diags.HasError(), diags)
+		assert.True(t, hibernationSpec.Override.IsNull())
+		var schedules []models.HibernationSchedule
+		diags = hibernationSpec.Schedules.ElementsAs(ctx, &schedules, false)
+		require.False(t, diags.HasError(), diags)
+		require.Len(t, schedules, 1)
+		assert.Equal(t, "0 0 * * 6", schedules[0].HibernateAtCron.ValueString())
+		assert.Equal(t, "0 6 * * 1", schedules[0].WakeAtCron.ValueString())
+	})
+
+	t.Run("drops the attributes the schema no longer has", func(t *testing.T) {
+		state := upgradeRawState(t, ctx, &DeploymentResource{}, []byte(`{"id": "clyn6kxud003x01mtxmccegnh", "removed_attribute": "value"}`))
+
+		var data models.DeploymentResource
+		diags := state.Get(ctx, &data)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, "clyn6kxud003x01mtxmccegnh", data.Id.ValueString())
+	})
+
+	t.Run("reads the upgraded state from the API", func(t *testing.T) {
+		server := fakeapi.NewTestServer(t)
+		r := &DeploymentResource{platformClient: server.PlatformClient, organizationId: server.OrganizationId}
+
+		// The recorded deployment is the seeded standard deployment of another fake API
+		rawState, err := os.ReadFile(filepath.Join("testdata", "state", "v0", "deployment_standard.json"))
+		require.NoError(t, err)
+		var priorState map[string]any
+		require.NoError(t, json.Unmarshal(rawState, &priorState))
+		priorState["id"] = server.Env["HOSTED_STANDARD_DEPLOYMENT_ID"]
+		rawState, err = json.Marshal(priorState)
+		require.NoError(t, err)
+		state := upgradeRawState(t, ctx, r, rawState)
+
+		resp := &resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var data models.DeploymentResource
+		diags := resp.State.Get(ctx, &data)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, "acceptance-tests-standard", data.Name.ValueString())
+		assert.False(t, data.DeletionProtection.IsNull())
+		assert.False(t, data.DeletionProtection.ValueBool())
+		assert.Equal(t, "WARNING", data.RuntimeDeprecationSeverity.ValueString())
+	})
+}
+
+func TestUnit_ClusterStateUpgrader(t *testing.T) {
+	ctx := context.Background()
+	state := upgradeFixture(t, ctx, &ClusterResource{}, "cluster_dedicated")
+
+	var data models.ClusterResource
+	diags := state.Get(ctx, &data)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "acceptance-tests-dedicated", data.Name.ValueString())
+	assert.Equal(t, `"10.0.0.10"`, data.Metadata.Attributes()["kube_dns_ip"].String())
+	assert.Len(t, data.WorkspaceIds.Elements(), 1)
+	assert.True(t, data.DeletionProtection.IsNull())
+}
+
+func TestUnit_EnvironmentObjectStateUpgrader(t *testing.T) {
+	ctx := context.Background()
+	state := upgradeFixture(t, ctx, &environmentObjectResource{}, "environment_object_connection")
+
+	var data models.EnvironmentObject
+	diags := state.Get(ctx, &data)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "warehouse_postgres", data.ObjectKey.ValueString())
+	assert.Equal(t, int64(5432), data.Port.ValueInt64())
+	assert.True(t, data.Uri.IsNull())
+
+	var links []models.EnvironmentObjectLinkInput
+	diags = data.Links.ElementsAs(ctx, &links, false)
+	require.False(t, diags.HasError(), diags)
+	require.Len(t, links, 1)
+	assert.Equal(t, "DEPLOYMENT", links[0].Scope.ValueString())
+}
+
+func TestUnit_NotificationChannelStateUpgrader(t *testing.T) {
+	ctx := context.Background()
+	state := upgradeFixture(t, ctx, &notificationChannelResource{}, "notification_channel_email")
+
+	var data models.NotificationChannelResource
+	diags := state.Get(ctx, &data)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "EMAIL", data.Type.ValueString())
+	assert.Len(t, data.Definition.Attributes()["recipients"].(basetypes.SetValue).Elements(), 1)
+}
@@ -0,0 +1,46 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/samber/lo"
+)
+
+// AirflowVariableOverridesAttributeTypes describes the per-link `overrides` of an astro_airflow_variable
+func AirflowVariableOverridesAttributeTypes() map[string]attr.Type {
+	return lo.PickByKeys(EnvironmentObjectOverridesAttributeTypes(), []string{"value"})
+}
+
+func airflowVariableOverridesResourceSchemaAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"value": resourceSchema.StringAttribute{
+			MarkdownDescription: "Override value",
+			Optional:            true,
+			Sensitive:           true,
+		},
+	}
+}
+
+// AirflowVariableResourceSchemaAttributes is the schema of astro_airflow_variable, an
+// environment object with object_type=AIRFLOW_VARIABLE
+func AirflowVariableResourceSchemaAttributes() map[string]resourceSchema.Attribute {
+	return lo.Assign(environmentObjectTypedResourceSchemaAttributes("Airflow variable", airflowVariableOverridesResourceSchemaAttributes()), map[string]resourceSchema.Attribute{
+		"value": resourceSchema.StringAttribute{
+			MarkdownDescription: "The value of the Airflow variable",
+			Required:            true,
+			Sensitive:           true,
+		},
+		"is_secret": resourceSchema.BoolAttribute{
+			MarkdownDescription: "Whether the value is a secret. Immutable on the API; toggling forces resource replacement.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -93,3 +94,33 @@ func AlertsDataSourceSchemaAttributes() map[string]schema.Attribute {
 		},
 	}
 }
+
+// AlertsListResourceSchemaAttributes is the configuration of the alert list resource, with the filters of the data source
+func AlertsListResourceSchemaAttributes() map[string]listschema.Attribute {
+	return map[string]listschema.Attribute{
+		"alert_ids": listschema.ListAttribute{
+			MarkdownDescription: "Only list the alerts with these identifiers",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"deployment_ids": listschema.ListAttribute{
+			MarkdownDescription: "Only list the alerts of these deployments",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"workspace_ids": listschema.ListAttribute{
+			MarkdownDescription: "Only list the alerts of these workspaces",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"alert_types": listschema.ListAttribute{
+			MarkdownDescription: "Only list the alerts of these types",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"entity_type": listschema.StringAttribute{
+			MarkdownDescription: "Only list the alerts of this entity type. Allowed values: `DEPLOYMENT`.",
+			Optional:            true,
+		},
+	}
+}
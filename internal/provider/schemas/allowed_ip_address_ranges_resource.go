@@ -2,6 +2,7 @@ package schemas
 
 import (
 	"github.com/astronomer/terraform-provider-astro/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -32,5 +33,27 @@ func AllowedIpAddressRangesResourceSchemaAttributes() map[string]resourceSchema.
 				setvalidator.ValueStringsAre(validators.IsCidr()),
 			},
 		},
+		"ip_address_range_descriptions": resourceSchema.MapAttribute{
+			MarkdownDescription: "Descriptions of the allowed IP address ranges, keyed by the CIDR of the range in " +
+				"`ip_address_ranges`, e.g. to record why each range exists. The Astro API does not store descriptions, " +
+				"so they are only kept in the Terraform state.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.Map{
+				mapvalidator.KeysAre(validators.IsCidr()),
+			},
+		},
+		"ip_address_range_ids": resourceSchema.MapAttribute{
+			MarkdownDescription: "The IDs of the allowed IP address ranges, keyed by the CIDR of the range in `ip_address_ranges`.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"allow_self_lockout": resourceSchema.BoolAttribute{
+			MarkdownDescription: "Whether to allow plans whose `ip_address_ranges` do not cover the public IP address of " +
+				"the machine running Terraform. By default, the plan detects that IP address and fails when applying it " +
+				"would lock the machine out of the Astro API. Set to `true` when Terraform reaches the Astro API through " +
+				"another IP address than the detected one, e.g. through a proxy.",
+			Optional: true,
+		},
 	}
 }
@@ -76,6 +76,17 @@ func ApiTokenDataSourceSchemaAttributes() map[string]datasourceSchema.Attribute
 			Computed:            true,
 			MarkdownDescription: "The roles assigned to the API Token",
 		},
+		"kind": datasourceSchema.StringAttribute{
+			MarkdownDescription: "API Token kind, `STANDARD` or `DIRECT_ACCESS`",
+			Computed:            true,
+		},
+		"dag_roles": datasourceSchema.SetNestedAttribute{
+			NestedObject: datasourceSchema.NestedAttributeObject{
+				Attributes: DataSourceDagRoleSchemaAttributes(),
+			},
+			Computed:            true,
+			MarkdownDescription: "The DAG roles assigned to the API Token, the `roles` with a `DAG` or `DAG_TAG` entity type",
+		},
 	}
 }
 
@@ -162,5 +173,9 @@ func ApiTokenResourceSchemaAttributes() map[string]resourceSchema.Attribute {
 			Computed:            true,
 			Sensitive:           true,
 		},
+		"rotation_trigger": resourceSchema.StringAttribute{
+			MarkdownDescription: "Rotates the API Token when set or changed, for example to a timestamp, and stores its new value in `token`. The previous value stops working",
+			Optional:            true,
+		},
 	}
 }
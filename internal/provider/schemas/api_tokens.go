@@ -1,9 +1,14 @@
 package schemas
 
 import (
+	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
 	"github.com/astronomer/terraform-provider-astro/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -32,6 +37,12 @@ func ApiTokensElementAttributeTypes() map[string]attr.Type {
 				AttrTypes: ApiTokenRoleAttributeTypes(),
 			},
 		},
+		"kind": types.StringType,
+		"dag_roles": types.SetType{
+			ElemType: types.ObjectType{
+				AttrTypes: DagRoleAttributeTypes(),
+			},
+		},
 	}
 }
 
@@ -54,5 +65,60 @@ func ApiTokensDataSourceSchemaAttributes() map[string]schema.Attribute {
 		"include_only_organization_tokens": schema.BoolAttribute{
 			Optional: true,
 		},
+		"dag_id": schema.StringAttribute{
+			MarkdownDescription: "Only return the API tokens with a DAG role for this DAG of the deployment `deployment_id`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.AlsoRequires(path.MatchRoot("deployment_id")),
+			},
+		},
+		"dag_tags": schema.SetAttribute{
+			MarkdownDescription: "Only return the API tokens with a DAG role for these DAG tags of the deployment `deployment_id`",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				setvalidator.AlsoRequires(path.MatchRoot("deployment_id")),
+			},
+		},
+		"kind": schema.StringAttribute{
+			MarkdownDescription: "Only return the API tokens of this kind",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(string(iam.STANDARD), string(iam.DIRECTACCESS)),
+			},
+		},
+	}
+}
+
+// ApiTokensListResourceSchemaAttributes is the configuration of the API token list resource, with the filters of the data source
+func ApiTokensListResourceSchemaAttributes() map[string]listschema.Attribute {
+	return map[string]listschema.Attribute{
+		"workspace_id": listschema.StringAttribute{
+			MarkdownDescription: "Only list the API tokens of this workspace",
+			Optional:            true,
+		},
+		"deployment_id": listschema.StringAttribute{
+			MarkdownDescription: "Only list the API tokens of this deployment",
+			Optional:            true,
+		},
+		"include_only_organization_tokens": listschema.BoolAttribute{
+			MarkdownDescription: "Only list the organization API tokens",
+			Optional:            true,
+		},
+		"dag_id": listschema.StringAttribute{
+			MarkdownDescription: "Only list the API tokens with a DAG role for this DAG of the deployment `deployment_id`",
+			Optional:            true,
+		},
+		"dag_tags": listschema.ListAttribute{
+			MarkdownDescription: "Only list the API tokens with a DAG role for these DAG tags of the deployment `deployment_id`",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"kind": listschema.StringAttribute{
+			MarkdownDescription: "Only list the API tokens of this kind. Allowed values: `STANDARD`, `DIRECT_ACCESS`.",
+			Optional:            true,
+		},
 	}
 }
@@ -12,9 +12,9 @@ import (
 	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -149,11 +149,11 @@ func ClusterResourceSchemaAttributes(ctx context.Context) map[string]resourceSch
 			NestedObject: resourceSchema.NestedAttributeObject{
 				Attributes: NodePoolResourceSchemaAttributes(),
 			},
-			MarkdownDescription: "Cluster node pools",
+			MarkdownDescription: "Cluster node pools, including the node pools managed by `astro_cluster_node_pool` resources. The cluster resource never changes node pools.",
 			Computed:            true,
-			PlanModifiers: []planmodifier.Set{
-				setplanmodifier.UseStateForUnknown(),
-			},
+			// No UseStateForUnknown: astro_cluster_node_pool resources can change the node pools in the same apply as the
+			// cluster, so node pools planned from the state may not match the node pools read after the cluster update,
+			// which Terraform rejects as an inconsistent result
 		},
 		"workspace_ids": resourceSchema.SetAttribute{
 			ElementType:         types.StringType,
@@ -193,7 +193,7 @@ func ClusterResourceSchemaAttributes(ctx context.Context) map[string]resourceSch
 			Computed:            true,
 		},
 		"is_failed_over": resourceSchema.BoolAttribute{
-			MarkdownDescription: "Whether the cluster is currently failed over to the DR region. Set to `true` to trigger failover; set to `false` to fail back.",
+			MarkdownDescription: "Whether the cluster is currently failed over to the DR region. Set to `true` to trigger failover; set to `false` to fail back. Leave unset when the failover is managed by an `astro_cluster_failover` resource.",
 			Optional:            true,
 			Computed:            true,
 			PlanModifiers: []planmodifier.Bool{
@@ -220,6 +220,12 @@ func ClusterResourceSchemaAttributes(ctx context.Context) map[string]resourceSch
 			Optional:            true,
 			Computed:            true,
 		},
+		"deletion_protection": resourceSchema.BoolAttribute{
+			MarkdownDescription: "Whether the cluster is protected from deletion. While `true`, any plan that would destroy or replace the cluster fails; set it to `false` and apply before removing or replacing the resource. Defaults to `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
 	}
 }
 
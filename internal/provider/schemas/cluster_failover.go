@@ -0,0 +1,53 @@
+package schemas
+
+import (
+	"github.com/astronomer/terraform-provider-astro/internal/provider/validators"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ClusterFailoverResourceSchemaAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"id": resourceSchema.StringAttribute{
+			MarkdownDescription: "Cluster failover identifier, the ID of the cluster",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"cluster_id": resourceSchema.StringAttribute{
+			MarkdownDescription: "The ID of the dedicated cluster with disaster recovery enabled - if changed, the cluster failover will be recreated.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				validators.IsCuid(),
+			},
+		},
+		"is_failed_over": resourceSchema.BoolAttribute{
+			MarkdownDescription: "Whether the cluster is failed over to its disaster recovery region. Changing it from `false` to `true` fails the cluster over, changing it from `true` to `false` fails it back to its primary region.",
+			Required:            true,
+		},
+		"region": resourceSchema.StringAttribute{
+			MarkdownDescription: "The primary region of the cluster",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"dr_region": resourceSchema.StringAttribute{
+			MarkdownDescription: "The disaster recovery region of the cluster",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"active_region": resourceSchema.StringAttribute{
+			MarkdownDescription: "The region the cluster is running in: `dr_region` when the cluster is failed over, `region` otherwise",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,93 @@
+package schemas
+
+import (
+	"github.com/astronomer/terraform-provider-astro/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func ClusterNodePoolResourceSchemaAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"id": resourceSchema.StringAttribute{
+			MarkdownDescription: "Node pool identifier",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"cluster_id": resourceSchema.StringAttribute{
+			MarkdownDescription: "The ID of the dedicated cluster of the node pool - if changed, the node pool will be recreated.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				validators.IsCuid(),
+			},
+		},
+		"name": resourceSchema.StringAttribute{
+			MarkdownDescription: "Node pool name - if changed, the node pool will be recreated.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"node_instance_type": resourceSchema.StringAttribute{
+			MarkdownDescription: "Node pool node instance type - if changed, the node pool will be recreated. The instance types available for a cluster are listed by the `astro_cluster_options` data source.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"max_node_count": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Node pool maximum node count",
+			Required:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"is_default": resourceSchema.BoolAttribute{
+			MarkdownDescription: "Whether the node pool is the default node pool of the cluster. Setting it to `true` makes the other node pools of the cluster non-default. If not set, the platform decides.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"cloud_provider": resourceSchema.StringAttribute{
+			MarkdownDescription: "Node pool cloud provider",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"supported_astro_machines": resourceSchema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Node pool supported Astro machines",
+			Computed:            true,
+		},
+		"created_at": resourceSchema.StringAttribute{
+			MarkdownDescription: "Node pool creation timestamp",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": resourceSchema.StringAttribute{
+			MarkdownDescription: "Node pool last updated timestamp",
+			Computed:            true,
+		},
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -88,3 +89,18 @@ func ClustersDataSourceSchemaAttributes() map[string]schema.Attribute {
 		},
 	}
 }
+
+// ClustersListResourceSchemaAttributes is the configuration of the cluster list resource, with the filters of the data source
+func ClustersListResourceSchemaAttributes() map[string]listschema.Attribute {
+	return map[string]listschema.Attribute{
+		"cloud_provider": listschema.StringAttribute{
+			MarkdownDescription: "Only list the clusters of this cloud provider. Allowed values: `AWS`, `GCP`, `AZURE`.",
+			Optional:            true,
+		},
+		"names": listschema.ListAttribute{
+			MarkdownDescription: "Only list the clusters with these names",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+	}
+}
@@ -0,0 +1,112 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/samber/lo"
+)
+
+// ConnectionOverridesAttributeTypes describes the per-link `overrides` of an astro_connection
+func ConnectionOverridesAttributeTypes() map[string]attr.Type {
+	return lo.PickByKeys(EnvironmentObjectOverridesAttributeTypes(), []string{
+		"type", "host", "port", "schema", "login", "extra", "password",
+	})
+}
+
+func connectionOverridesResourceSchemaAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"type": resourceSchema.StringAttribute{
+			MarkdownDescription: "Override connection type",
+			Optional:            true,
+		},
+		"host": resourceSchema.StringAttribute{
+			MarkdownDescription: "Override host address",
+			Optional:            true,
+		},
+		"port": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Override port",
+			Optional:            true,
+		},
+		"schema": resourceSchema.StringAttribute{
+			MarkdownDescription: "Override schema",
+			Optional:            true,
+		},
+		"login": resourceSchema.StringAttribute{
+			MarkdownDescription: "Override login",
+			Optional:            true,
+		},
+		"extra": resourceSchema.StringAttribute{
+			MarkdownDescription: "Override extra JSON",
+			Optional:            true,
+		},
+		"password": resourceSchema.StringAttribute{
+			MarkdownDescription: "Override password",
+			Optional:            true,
+			Sensitive:           true,
+		},
+	}
+}
+
+// ConnectionResourceSchemaAttributes is the schema of astro_connection, an
+// environment object with object_type=CONNECTION
+func ConnectionResourceSchemaAttributes() map[string]resourceSchema.Attribute {
+	return lo.Assign(environmentObjectTypedResourceSchemaAttributes("connection", connectionOverridesResourceSchemaAttributes()), map[string]resourceSchema.Attribute{
+		"uri": resourceSchema.StringAttribute{
+			MarkdownDescription: "The connection as an Airflow connection URI, e.g. the value of an `AIRFLOW_CONN_*` environment variable. " +
+				"Parsed with Airflow's URI rules into type, host, port, schema, login, password and extra, which can't be set with it. Not returned by the API",
+			Optional:  true,
+			Sensitive: true,
+		},
+		"type": resourceSchema.StringAttribute{
+			MarkdownDescription: "The connection type, e.g. `postgres`. Required unless uri is set. Immutable on the API; changing it forces resource replacement.",
+			Optional:            true,
+			Computed:            true,
+			// Computed from uri when it is set, see astro_environment_object
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"host": resourceSchema.StringAttribute{
+			MarkdownDescription: "The host address for the connection",
+			Optional:            true,
+			Computed:            true,
+		},
+		"port": resourceSchema.Int64Attribute{
+			MarkdownDescription: "The port for the connection",
+			Optional:            true,
+			Computed:            true,
+		},
+		"schema": resourceSchema.StringAttribute{
+			MarkdownDescription: "The schema for the connection",
+			Optional:            true,
+			Computed:            true,
+		},
+		"login": resourceSchema.StringAttribute{
+			MarkdownDescription: "The username used for the connection",
+			Optional:            true,
+			Computed:            true,
+		},
+		"password": resourceSchema.StringAttribute{
+			MarkdownDescription: "The password used for the connection. Not returned by the API",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"extra": resourceSchema.StringAttribute{
+			MarkdownDescription: "Extra connection details as JSON string. Use jsonencode({...})",
+			Optional:            true,
+			Computed:            true,
+		},
+		"auth_type_id": resourceSchema.StringAttribute{
+			MarkdownDescription: "The ID for the connection auth type. Provided on create/update; not returned by the API",
+			Optional:            true,
+		},
+		"connection_auth_type": resourceSchema.SingleNestedAttribute{
+			MarkdownDescription: "The resolved auth type of the connection, populated from auth_type_id",
+			Computed:            true,
+			Attributes:          environmentObjectConnectionAuthTypeResourceSchemaAttributes(),
+		},
+	})
+}
@@ -12,16 +12,35 @@ import (
 	"github.com/astronomer/terraform-provider-astro/internal/provider/validators"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	actionSchema "github.com/hashicorp/terraform-plugin-framework/action/schema"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// Values of runtime_support_status
+const (
+	RuntimeSupportStatusSupported   = "SUPPORTED"
+	RuntimeSupportStatusDeprecated  = "DEPRECATED"
+	RuntimeSupportStatusUnsupported = "UNSUPPORTED"
+	RuntimeSupportStatusUnknown     = "UNKNOWN"
+)
+
+// Values of runtime_deprecation_severity
+const (
+	RuntimeDeprecationSeverityWarning = "WARNING"
+	RuntimeDeprecationSeverityError   = "ERROR"
+	RuntimeDeprecationSeverityNone    = "NONE"
+)
+
 func DeploymentResourceSchemaAttributes() map[string]resourceSchema.Attribute {
 	return map[string]resourceSchema.Attribute{
 		"id": resourceSchema.StringAttribute{
@@ -64,12 +83,9 @@ func DeploymentResourceSchemaAttributes() map[string]resourceSchema.Attribute {
 			Attributes:          ResourceSubjectProfileSchemaAttributes(),
 		},
 		"workspace_id": resourceSchema.StringAttribute{
-			MarkdownDescription: "Deployment workspace identifier - if changing this value, the deployment will be recreated in the new workspace",
+			MarkdownDescription: "Deployment workspace identifier - changing this value moves the deployment to the new workspace in place. For a dedicated or hybrid deployment, the new workspace must be authorized to use the cluster. Environment objects and role bindings of the previous workspace no longer apply to the deployment, the plan lists them in a warning",
 			Required:            true,
 			Validators:          []validator.String{validators.IsCuid()},
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.RequiresReplaceIfConfigured(),
-			},
 		},
 		"original_astro_runtime_version": resourceSchema.StringAttribute{
 			MarkdownDescription: "Deployment's original Astro Runtime version. The Terraform provider uses this value to create the Deployment. If not provided, defaults to the current Astro runtime version. This value is immutable after the Deployment is created — to upgrade the Astro Runtime version, update your Astro project's Dockerfile and deploy the new image (for example with `astro deploy`). Changing this attribute in Terraform will produce an error at plan time rather than recreate the Deployment, which would destroy connections, DAG history, and other state that is not managed by Terraform.",
@@ -101,15 +117,17 @@ func DeploymentResourceSchemaAttributes() map[string]resourceSchema.Attribute {
 		},
 		"contact_emails": resourceSchema.SetAttribute{
 			ElementType:         types.StringType,
-			MarkdownDescription: "Deployment contact emails",
-			Required:            true,
+			MarkdownDescription: "Deployment contact emails. If not set, the provider `deployment_defaults.contact_emails` value is used.",
+			Optional:            true,
+			Computed:            true,
 			Validators: []validator.Set{
 				setvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(validators.EmailString), "must be a valid email address")),
 			},
 		},
 		"executor": resourceSchema.StringAttribute{
-			MarkdownDescription: "Deployment executor. Allowed values: `CELERY`, `KUBERNETES`, `ASTRO`.",
-			Required:            true,
+			MarkdownDescription: "Deployment executor. Allowed values: `CELERY`, `KUBERNETES`, `ASTRO`. If not set, the provider `deployment_defaults.executor` value is used.",
+			Optional:            true,
+			Computed:            true,
 			Validators: []validator.String{
 				stringvalidator.OneOf(string(platform.DeploymentExecutorCELERY), string(platform.DeploymentExecutorKUBERNETES), string(platform.DeploymentExecutorASTRO)),
 			},
@@ -170,12 +188,14 @@ func DeploymentResourceSchemaAttributes() map[string]resourceSchema.Attribute {
 			Computed:            true,
 		},
 		"is_cicd_enforced": resourceSchema.BoolAttribute{
-			MarkdownDescription: "Deployment CI/CD enforced",
-			Required:            true,
+			MarkdownDescription: "Deployment CI/CD enforced. If not set, the provider `deployment_defaults.is_cicd_enforced` value is used.",
+			Optional:            true,
+			Computed:            true,
 		},
 		"is_dag_deploy_enabled": resourceSchema.BoolAttribute{
-			MarkdownDescription: "Whether DAG deploy is enabled - Changing this value may disrupt your deployment. Read more at https://docs.astronomer.io/astro/deploy-dags#enable-or-disable-dag-only-deploys-on-a-deployment",
-			Required:            true,
+			MarkdownDescription: "Whether DAG deploy is enabled - Changing this value may disrupt your deployment. Read more at https://docs.astronomer.io/astro/deploy-dags#enable-or-disable-dag-only-deploys-on-a-deployment. If not set, the provider `deployment_defaults.is_dag_deploy_enabled` value is used.",
+			Optional:            true,
+			Computed:            true,
 		},
 		"external_ips": resourceSchema.SetAttribute{
 			ElementType:         types.StringType,
@@ -200,6 +220,29 @@ func DeploymentResourceSchemaAttributes() map[string]resourceSchema.Attribute {
 			MarkdownDescription: "Deployment workload identity. This value can be changed via the Astro API if applicable.",
 			Computed:            true,
 		},
+		"dr_workload_identity": resourceSchema.StringAttribute{
+			MarkdownDescription: "Deployment workload identity in the disaster recovery region of its cluster. If not set, it is assigned automatically.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"dr_external_ips": resourceSchema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Deployment external IPs in the disaster recovery region of its cluster",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"dr_oidc_issuer_url": resourceSchema.StringAttribute{
+			MarkdownDescription: "Deployment OIDC issuer URL in the disaster recovery region of its cluster",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
 		"type": resourceSchema.StringAttribute{
 			MarkdownDescription: "Deployment type - if changing this value, the deployment will be recreated with the new type",
 			Required:            true,
@@ -242,8 +285,9 @@ func DeploymentResourceSchemaAttributes() map[string]resourceSchema.Attribute {
 			Optional:            true,
 		},
 		"scheduler_size": resourceSchema.StringAttribute{
-			MarkdownDescription: "Deployment scheduler size - required for 'STANDARD' and 'DEDICATED' deployments. Allowed values: `SMALL`, `MEDIUM`, `LARGE`, `EXTRALARGE`.",
+			MarkdownDescription: "Deployment scheduler size - required for 'STANDARD' and 'DEDICATED' deployments. Allowed values: `SMALL`, `MEDIUM`, `LARGE`, `EXTRALARGE`. If not set, the provider `deployment_defaults.scheduler_size` value is used for 'STANDARD' and 'DEDICATED' deployments.",
 			Optional:            true,
+			Computed:            true,
 			Validators: []validator.String{
 				stringvalidator.OneOf(
 					string(platform.SchedulerMachineNameSMALL),
@@ -350,19 +394,52 @@ func DeploymentResourceSchemaAttributes() map[string]resourceSchema.Attribute {
 				stringplanmodifier.RequiresReplaceIfConfigured(),
 			},
 		},
+		"deletion_protection": resourceSchema.BoolAttribute{
+			MarkdownDescription: "Whether the Deployment is protected from deletion. While `true`, any plan that would destroy or replace the Deployment fails; set it to `false` and apply before removing or replacing the resource. Defaults to `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"runtime_deprecation_severity": resourceSchema.StringAttribute{
+			MarkdownDescription: "How a plan reports a Deployment on a deprecated or unsupported Astro Runtime version, see `runtime_support_status`. `WARNING` adds a warning to the plan, `ERROR` fails the plan and `NONE` disables the check. Defaults to `WARNING`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(RuntimeDeprecationSeverityWarning),
+			Validators: []validator.String{
+				stringvalidator.OneOf(RuntimeDeprecationSeverityWarning, RuntimeDeprecationSeverityError, RuntimeDeprecationSeverityNone),
+			},
+		},
+		"runtime_support_status": resourceSchema.StringAttribute{
+			MarkdownDescription: "Support status of the Deployment's current Astro Runtime version among the releases offered by Astro: `SUPPORTED`, `DEPRECATED` for a release of the deprecated channel, `UNSUPPORTED` for a release that is no longer offered, or `UNKNOWN` if the releases could not be read. The releases are listed once per Terraform command",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"provider_default_attributes": resourceSchema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Names of the attributes whose values were taken from the provider `deployment_defaults` because they are not set on the resource",
+			Computed:            true,
+		},
 	}
 }
 
 func DeploymentDataSourceSchemaAttributes() map[string]datasourceSchema.Attribute {
 	return map[string]datasourceSchema.Attribute{
 		"id": datasourceSchema.StringAttribute{
-			MarkdownDescription: "Deployment identifier",
-			Required:            true,
-			Validators:          []validator.String{validators.IsCuid()},
+			MarkdownDescription: "Deployment identifier - exactly one of `id` or `name` must be set",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				validators.IsCuid(),
+				stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+			},
 		},
 		"name": datasourceSchema.StringAttribute{
-			MarkdownDescription: "Deployment name",
+			MarkdownDescription: "Deployment name - looks up the Deployment by name when `id` is not set, fails if no or more than one Deployment has the name. Set `workspace_id` to narrow down the lookup",
+			Optional:            true,
 			Computed:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 		},
 		"description": datasourceSchema.StringAttribute{
 			MarkdownDescription: "Deployment description",
@@ -387,8 +464,13 @@ func DeploymentDataSourceSchemaAttributes() map[string]datasourceSchema.Attribut
 			Attributes:          DataSourceSubjectProfileSchemaAttributes(),
 		},
 		"workspace_id": datasourceSchema.StringAttribute{
-			MarkdownDescription: "Deployment workspace identifier",
+			MarkdownDescription: "Deployment workspace identifier - can only be set with `name` to look up the Deployment in this Workspace",
+			Optional:            true,
 			Computed:            true,
+			Validators: []validator.String{
+				validators.IsCuid(),
+				stringvalidator.AlsoRequires(path.MatchRoot("name")),
+			},
 		},
 		"cluster_id": datasourceSchema.StringAttribute{
 			MarkdownDescription: "Deployment cluster identifier",
@@ -534,6 +616,19 @@ func DeploymentDataSourceSchemaAttributes() map[string]datasourceSchema.Attribut
 			MarkdownDescription: "Deployment OIDC issuer URL",
 			Computed:            true,
 		},
+		"dr_workload_identity": datasourceSchema.StringAttribute{
+			MarkdownDescription: "Deployment workload identity in the disaster recovery region of its cluster",
+			Computed:            true,
+		},
+		"dr_external_ips": datasourceSchema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Deployment external IPs in the disaster recovery region of its cluster",
+			Computed:            true,
+		},
+		"dr_oidc_issuer_url": datasourceSchema.StringAttribute{
+			MarkdownDescription: "Deployment OIDC issuer URL in the disaster recovery region of its cluster",
+			Computed:            true,
+		},
 		"resource_quota_cpu": datasourceSchema.StringAttribute{
 			MarkdownDescription: "Deployment resource quota CPU",
 			Computed:            true,
@@ -799,3 +894,22 @@ func (m immutableAfterCreateStringPlanModifier) PlanModifyString(_ context.Conte
 	}
 	resp.Diagnostics.AddAttributeError(req.Path, m.errorSummary, m.errorDetail)
 }
+
+func DeploymentHibernationOverrideActionSchemaAttributes() map[string]actionSchema.Attribute {
+	return map[string]actionSchema.Attribute{
+		"deployment_id": actionSchema.StringAttribute{
+			MarkdownDescription: "Deployment identifier, the deployment must be in development mode",
+			Required:            true,
+			Validators:          []validator.String{validators.IsCuid()},
+		},
+		"is_hibernating": actionSchema.BoolAttribute{
+			MarkdownDescription: "Whether to hibernate (`true`) or wake up (`false`) the deployment regardless of its hibernation schedules",
+			Required:            true,
+		},
+		"override_until": actionSchema.StringAttribute{
+			MarkdownDescription: "End of the override as an RFC 3339 timestamp, e.g. `2025-01-01T00:00:00Z`. The override persists until it is ended in Astro or by another override if not set.",
+			Optional:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+	}
+}
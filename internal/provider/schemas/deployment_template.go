@@ -0,0 +1,143 @@
+package schemas
+
+import (
+	"github.com/astronomer/terraform-provider-astro/internal/provider/validators"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DeploymentTemplateDataSourceSchemaAttributes returns the attributes of the deployment template data source. The
+// computed attributes have the names and types of the astro_deployment arguments, so they can be assigned to them as is.
+func DeploymentTemplateDataSourceSchemaAttributes() map[string]datasourceSchema.Attribute {
+	return map[string]datasourceSchema.Attribute{
+		"source_deployment_id": datasourceSchema.StringAttribute{
+			MarkdownDescription: "Identifier of the Deployment to copy the configuration from",
+			Required:            true,
+			Validators: []validator.String{
+				validators.IsCuid(),
+			},
+		},
+		"include_secret_environment_variables": datasourceSchema.BoolAttribute{
+			MarkdownDescription: "Whether to copy the secret environment variables of the source Deployment. The Astro API never returns secret values, so copied secret variables have a null `value` that must be set before the template is used. Defaults to `false`, which skips them",
+			Optional:            true,
+		},
+		"workspace_id": datasourceSchema.StringAttribute{
+			MarkdownDescription: "Workspace identifier of the source Deployment",
+			Computed:            true,
+		},
+		"description": datasourceSchema.StringAttribute{
+			MarkdownDescription: "Description of the source Deployment",
+			Computed:            true,
+		},
+		"type": datasourceSchema.StringAttribute{
+			MarkdownDescription: "Deployment type",
+			Computed:            true,
+		},
+		"cloud_provider": datasourceSchema.StringAttribute{
+			MarkdownDescription: "Deployment cloud provider, only set for 'STANDARD' deployments",
+			Computed:            true,
+		},
+		"region": datasourceSchema.StringAttribute{
+			MarkdownDescription: "Deployment region, only set for 'STANDARD' deployments",
+			Computed:            true,
+		},
+		"cluster_id": datasourceSchema.StringAttribute{
+			MarkdownDescription: "Deployment cluster identifier, only set for 'DEDICATED' and 'HYBRID' deployments",
+			Computed:            true,
+		},
+		"original_astro_runtime_version": datasourceSchema.StringAttribute{
+			MarkdownDescription: "Current Astro Runtime version of the source Deployment",
+			Computed:            true,
+		},
+		"executor": datasourceSchema.StringAttribute{
+			MarkdownDescription: "Deployment executor",
+			Computed:            true,
+		},
+		"contact_emails": datasourceSchema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Deployment contact emails",
+			Computed:            true,
+		},
+		"is_cicd_enforced": datasourceSchema.BoolAttribute{
+			MarkdownDescription: "Whether the Deployment enforces CI/CD deploys",
+			Computed:            true,
+		},
+		"is_dag_deploy_enabled": datasourceSchema.BoolAttribute{
+			MarkdownDescription: "Whether DAG deploy is enabled",
+			Computed:            true,
+		},
+		"is_development_mode": datasourceSchema.BoolAttribute{
+			MarkdownDescription: "Whether the Deployment is in development mode, only set for 'STANDARD' and 'DEDICATED' deployments",
+			Computed:            true,
+		},
+		"is_high_availability": datasourceSchema.BoolAttribute{
+			MarkdownDescription: "Whether the Deployment has high availability, only set for 'STANDARD' and 'DEDICATED' deployments",
+			Computed:            true,
+		},
+		"scheduler_size": datasourceSchema.StringAttribute{
+			MarkdownDescription: "Deployment scheduler size, only set for 'STANDARD' and 'DEDICATED' deployments",
+			Computed:            true,
+		},
+		"scheduler_au": datasourceSchema.Int64Attribute{
+			MarkdownDescription: "Deployment scheduler AU, only set for 'HYBRID' deployments",
+			Computed:            true,
+		},
+		"scheduler_replicas": datasourceSchema.Int64Attribute{
+			MarkdownDescription: "Deployment scheduler replicas",
+			Computed:            true,
+		},
+		"resource_quota_cpu": datasourceSchema.StringAttribute{
+			MarkdownDescription: "Deployment resource quota CPU, only set for 'STANDARD' and 'DEDICATED' deployments",
+			Computed:            true,
+		},
+		"resource_quota_memory": datasourceSchema.StringAttribute{
+			MarkdownDescription: "Deployment resource quota memory, only set for 'STANDARD' and 'DEDICATED' deployments",
+			Computed:            true,
+		},
+		"default_task_pod_cpu": datasourceSchema.StringAttribute{
+			MarkdownDescription: "Deployment default task pod CPU, only set for 'STANDARD' and 'DEDICATED' deployments",
+			Computed:            true,
+		},
+		"default_task_pod_memory": datasourceSchema.StringAttribute{
+			MarkdownDescription: "Deployment default task pod memory, only set for 'STANDARD' and 'DEDICATED' deployments",
+			Computed:            true,
+		},
+		"task_pod_node_pool_id": datasourceSchema.StringAttribute{
+			MarkdownDescription: "Deployment task pod node pool identifier, only set for 'HYBRID' deployments",
+			Computed:            true,
+		},
+		"environment_variables": datasourceSchema.SetNestedAttribute{
+			NestedObject: datasourceSchema.NestedAttributeObject{
+				Attributes: DeploymentEnvironmentVariableDataSourceAttributes(),
+			},
+			MarkdownDescription: "Deployment environment variables, `updated_at` is always null",
+			Computed:            true,
+		},
+		"worker_queues": datasourceSchema.SetNestedAttribute{
+			NestedObject: datasourceSchema.NestedAttributeObject{
+				Attributes: deploymentTemplateWorkerQueueSchemaAttributes(),
+			},
+			MarkdownDescription: "Deployment worker queues, `pod_cpu` and `pod_memory` are always null",
+			Computed:            true,
+		},
+		"scaling_spec": datasourceSchema.SingleNestedAttribute{
+			MarkdownDescription: "Deployment scaling spec with the hibernation schedules of the source Deployment, the hibernation override of the source Deployment is not copied",
+			Computed:            true,
+			Attributes:          ScalingSpecDataSourceSchemaAttributes(),
+		},
+		"remote_execution": datasourceSchema.SingleNestedAttribute{
+			MarkdownDescription: "Deployment remote execution configuration, `remote_api_url` is always null",
+			Computed:            true,
+			Attributes:          RemoteExecutionDataSourceSchemaAttributes(),
+		},
+	}
+}
+
+// deploymentTemplateWorkerQueueSchemaAttributes returns the worker queue data source attributes without the identifier,
+// which the astro_deployment worker queues do not have
+func deploymentTemplateWorkerQueueSchemaAttributes() map[string]datasourceSchema.Attribute {
+	attributes := WorkerQueueDataSourceSchemaAttributes()
+	delete(attributes, "id")
+	return attributes
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -69,7 +70,12 @@ func DeploymentsElementAttributeTypes() map[string]attr.Type {
 		"external_ips": types.SetType{
 			ElemType: types.StringType,
 		},
-		"oidc_issuer_url":         types.StringType,
+		"oidc_issuer_url":      types.StringType,
+		"dr_workload_identity": types.StringType,
+		"dr_external_ips": types.SetType{
+			ElemType: types.StringType,
+		},
+		"dr_oidc_issuer_url":      types.StringType,
 		"resource_quota_cpu":      types.StringType,
 		"resource_quota_memory":   types.StringType,
 		"default_task_pod_cpu":    types.StringType,
@@ -90,7 +96,7 @@ func DeploymentsDataSourceSchemaAttributes() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"deployments": schema.SetNestedAttribute{
 			NestedObject: schema.NestedAttributeObject{
-				Attributes: DeploymentDataSourceSchemaAttributes(),
+				Attributes: deploymentsElementSchemaAttributes(),
 			},
 			Computed: true,
 		},
@@ -117,3 +123,43 @@ func DeploymentsDataSourceSchemaAttributes() map[string]schema.Attribute {
 		},
 	}
 }
+
+// deploymentsElementSchemaAttributes is the deployment data source schema without its lookup keys, which are only
+// computed in the list
+func deploymentsElementSchemaAttributes() map[string]schema.Attribute {
+	attributes := DeploymentDataSourceSchemaAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Deployment identifier",
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Deployment name",
+		Computed:            true,
+	}
+	attributes["workspace_id"] = schema.StringAttribute{
+		MarkdownDescription: "Deployment workspace identifier",
+		Computed:            true,
+	}
+	return attributes
+}
+
+// DeploymentsListResourceSchemaAttributes is the configuration of the deployment list resource, with the filters of the data source
+func DeploymentsListResourceSchemaAttributes() map[string]listschema.Attribute {
+	return map[string]listschema.Attribute{
+		"deployment_ids": listschema.ListAttribute{
+			MarkdownDescription: "Only list the deployments with these identifiers",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"workspace_ids": listschema.ListAttribute{
+			MarkdownDescription: "Only list the deployments of these workspaces",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"names": listschema.ListAttribute{
+			MarkdownDescription: "Only list the deployments with these names",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+	}
+}
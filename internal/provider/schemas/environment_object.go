@@ -1,6 +1,8 @@
 package schemas
 
 import (
+	"fmt"
+
 	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
 	"github.com/astronomer/terraform-provider-astro/internal/provider/validators"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
 )
 
 // EnvironmentObjectConnectionAuthTypeAttributeTypes describes the read-only
@@ -760,8 +763,14 @@ func EnvironmentObjectResourceSchemaAttributes() map[string]resourceSchema.Attri
 			},
 		},
 		// CONNECTION fields (only valid when object_type=CONNECTION)
+		"uri": resourceSchema.StringAttribute{
+			MarkdownDescription: "The connection as an Airflow connection URI, e.g. the value of an `AIRFLOW_CONN_*` environment variable (only valid when object_type=CONNECTION). " +
+				"Parsed with Airflow's URI rules into type, host, port, schema, login, password and extra, which can't be set with it. Not returned by the API",
+			Optional:  true,
+			Sensitive: true,
+		},
 		"type": resourceSchema.StringAttribute{
-			MarkdownDescription: "The connection type (required when object_type=CONNECTION). Immutable on the API; changing it forces resource replacement.",
+			MarkdownDescription: "The connection type (required when object_type=CONNECTION, unless uri is set). Immutable on the API; changing it forces resource replacement.",
 			Optional:            true,
 			Computed:            true,
 			// RequiresReplaceIfConfigured (not RequiresReplace) — only fires
@@ -914,3 +923,92 @@ func EnvironmentObjectResourceSchemaAttributes() map[string]resourceSchema.Attri
 		},
 	}
 }
+
+// EnvironmentObjectTypedLinkAttributeTypes describes a links element of the typed
+// environment object resources (astro_connection, astro_airflow_variable and
+// astro_metrics_export), whose `overrides` only carry the fields of their type.
+func EnvironmentObjectTypedLinkAttributeTypes(overridesAttributeTypes map[string]attr.Type) map[string]attr.Type {
+	return map[string]attr.Type{
+		"scope":           types.StringType,
+		"scope_entity_id": types.StringType,
+		"overrides":       types.ObjectType{AttrTypes: overridesAttributeTypes},
+	}
+}
+
+// environmentObjectTypedResourceSchemaAttributes are the attributes shared by the
+// typed environment object resources. `kind` names the object in descriptions and
+// `overrides` are the per-link overrides of the type.
+func environmentObjectTypedResourceSchemaAttributes(kind string, overrides map[string]resourceSchema.Attribute) map[string]resourceSchema.Attribute {
+	attributes := lo.PickByKeys(EnvironmentObjectResourceSchemaAttributes(), []string{
+		"id",
+		"description",
+		"scope",
+		"scope_entity_id",
+		"source_scope",
+		"source_scope_entity_id",
+		"auto_link_deployments",
+		"exclude_links",
+		"created_at",
+		"updated_at",
+		"created_by",
+		"updated_by",
+	})
+	attributes["id"] = resourceSchema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("The %s identifier", kind),
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["key"] = resourceSchema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("The key of the %s", kind),
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["description"] = resourceSchema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("The description of the %s", kind),
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["scope"] = resourceSchema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("The scope of the %s (WORKSPACE, DEPLOYMENT)", kind),
+		Required:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				string(platform_v1.CreateEnvironmentObjectRequestScopeWORKSPACE),
+				string(platform_v1.CreateEnvironmentObjectRequestScopeDEPLOYMENT),
+			),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["scope_entity_id"] = resourceSchema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("The ID of the Workspace or Deployment where the %s is created", kind),
+		Required:            true,
+		Validators:          []validator.String{validators.IsCuid()},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	linkAttributes := environmentObjectLinkResourceSchemaAttributes()
+	linkAttributes["overrides"] = resourceSchema.SingleNestedAttribute{
+		MarkdownDescription: "Per-link overrides",
+		Optional:            true,
+		Attributes:          overrides,
+	}
+	attributes["links"] = resourceSchema.SetNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("The Deployments linked to the %s. Only applicable for WORKSPACE scope", kind),
+		Optional:            true,
+		Computed:            true,
+		NestedObject: resourceSchema.NestedAttributeObject{
+			Attributes: linkAttributes,
+		},
+	}
+	return attributes
+}
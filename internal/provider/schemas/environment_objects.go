@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -105,3 +106,25 @@ func EnvironmentObjectsDataSourceSchemaAttributes() map[string]datasourceSchema.
 		},
 	}
 }
+
+// EnvironmentObjectsListResourceSchemaAttributes is the configuration of the environment object list resource, with the filters of the data source
+func EnvironmentObjectsListResourceSchemaAttributes() map[string]listschema.Attribute {
+	return map[string]listschema.Attribute{
+		"workspace_id": listschema.StringAttribute{
+			MarkdownDescription: "Only list the environment objects of this workspace",
+			Optional:            true,
+		},
+		"deployment_id": listschema.StringAttribute{
+			MarkdownDescription: "Only list the environment objects of this deployment",
+			Optional:            true,
+		},
+		"object_type": listschema.StringAttribute{
+			MarkdownDescription: "Only list the environment objects of this type. Allowed values: `AIRFLOW_VARIABLE`, `ENVIRONMENT_VARIABLE`, `CONNECTION`, `METRICS_EXPORT`.",
+			Optional:            true,
+		},
+		"object_key": listschema.StringAttribute{
+			MarkdownDescription: "Only list the environment objects with this key",
+			Optional:            true,
+		},
+	}
+}
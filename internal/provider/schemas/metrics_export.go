@@ -0,0 +1,126 @@
+package schemas
+
+import (
+	platform_v1 "github.com/astronomer/terraform-provider-astro/internal/clients/platform_v1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+// MetricsExportOverridesAttributeTypes describes the per-link `overrides` of an astro_metrics_export
+func MetricsExportOverridesAttributeTypes() map[string]attr.Type {
+	return lo.PickByKeys(EnvironmentObjectOverridesAttributeTypes(), []string{
+		"auth_type", "endpoint", "basic_token", "exporter_type", "username", "password", "headers", "labels",
+	})
+}
+
+func metricsExportOverridesResourceSchemaAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"auth_type": resourceSchema.StringAttribute{
+			MarkdownDescription: "Override auth type",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(platform_v1.CreateEnvironmentObjectMetricsExportRequestAuthTypeAUTHTOKEN),
+					string(platform_v1.CreateEnvironmentObjectMetricsExportRequestAuthTypeBASIC),
+				),
+			},
+		},
+		"endpoint": resourceSchema.StringAttribute{
+			MarkdownDescription: "Override Prometheus endpoint",
+			Optional:            true,
+		},
+		"basic_token": resourceSchema.StringAttribute{
+			MarkdownDescription: "Override bearer token",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"exporter_type": resourceSchema.StringAttribute{
+			MarkdownDescription: "Override exporter type",
+			Optional:            true,
+		},
+		"username": resourceSchema.StringAttribute{
+			MarkdownDescription: "Override username",
+			Optional:            true,
+		},
+		"password": resourceSchema.StringAttribute{
+			MarkdownDescription: "Override HTTP Basic-auth password",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"headers": resourceSchema.MapAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Override HTTP request headers",
+			Optional:            true,
+		},
+		"labels": resourceSchema.MapAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Override metrics labels",
+			Optional:            true,
+		},
+	}
+}
+
+// MetricsExportResourceSchemaAttributes is the schema of astro_metrics_export, an
+// environment object with object_type=METRICS_EXPORT
+func MetricsExportResourceSchemaAttributes() map[string]resourceSchema.Attribute {
+	return lo.Assign(environmentObjectTypedResourceSchemaAttributes("metrics export", metricsExportOverridesResourceSchemaAttributes()), map[string]resourceSchema.Attribute{
+		"endpoint": resourceSchema.StringAttribute{
+			MarkdownDescription: "The Prometheus endpoint where the metrics are exported",
+			Required:            true,
+		},
+		"exporter_type": resourceSchema.StringAttribute{
+			MarkdownDescription: "The type of exporter. Defaults to PROMETHEUS",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(string(platform_v1.CreateEnvironmentObjectMetricsExportRequestExporterTypePROMETHEUS)),
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(platform_v1.CreateEnvironmentObjectMetricsExportRequestExporterTypePROMETHEUS),
+				),
+			},
+		},
+		"auth_type": resourceSchema.StringAttribute{
+			MarkdownDescription: "The type of authentication (AUTH_TOKEN, BASIC). AUTH_TOKEN requires basic_token, BASIC requires username and password",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(platform_v1.CreateEnvironmentObjectMetricsExportRequestAuthTypeAUTHTOKEN),
+					string(platform_v1.CreateEnvironmentObjectMetricsExportRequestAuthTypeBASIC),
+				),
+			},
+		},
+		"basic_token": resourceSchema.StringAttribute{
+			MarkdownDescription: "The bearer token to connect to the remote endpoint when auth_type=AUTH_TOKEN. Not returned by the API",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"username": resourceSchema.StringAttribute{
+			MarkdownDescription: "The username to connect to the remote endpoint when auth_type=BASIC",
+			Optional:            true,
+			Computed:            true,
+		},
+		"password": resourceSchema.StringAttribute{
+			MarkdownDescription: "The password to connect to the remote endpoint when auth_type=BASIC. Not returned by the API",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"headers": resourceSchema.MapAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "HTTP request headers for the remote endpoint",
+			Optional:            true,
+			Computed:            true,
+		},
+		"labels": resourceSchema.MapAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Key-value pair metrics labels for your export",
+			Optional:            true,
+			Computed:            true,
+		},
+	})
+}
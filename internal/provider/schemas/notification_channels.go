@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -92,3 +93,33 @@ func NotificationChannelsDataSourceSchemaAttributes() map[string]schema.Attribut
 		},
 	}
 }
+
+// NotificationChannelsListResourceSchemaAttributes is the configuration of the notification channel list resource, with the filters of the data source
+func NotificationChannelsListResourceSchemaAttributes() map[string]listschema.Attribute {
+	return map[string]listschema.Attribute{
+		"notification_channel_ids": listschema.ListAttribute{
+			MarkdownDescription: "Only list the notification channels with these identifiers",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"deployment_ids": listschema.ListAttribute{
+			MarkdownDescription: "Only list the notification channels of these deployments",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"workspace_ids": listschema.ListAttribute{
+			MarkdownDescription: "Only list the notification channels of these workspaces",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"channel_types": listschema.ListAttribute{
+			MarkdownDescription: "Only list the notification channels of these types",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"entity_type": listschema.StringAttribute{
+			MarkdownDescription: "Only list the notification channels of this entity type. Allowed values: `ORGANIZATION`, `WORKSPACE`, `DEPLOYMENT`.",
+			Optional:            true,
+		},
+	}
+}
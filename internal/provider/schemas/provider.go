@@ -3,10 +3,17 @@ package schemas
 import (
 	"regexp"
 
+	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
 	"github.com/astronomer/terraform-provider-astro/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func ProviderSchemaAttributes() map[string]schema.Attribute {
@@ -14,7 +21,40 @@ func ProviderSchemaAttributes() map[string]schema.Attribute {
 		"token": schema.StringAttribute{
 			Optional:            true,
 			Sensitive:           true,
-			MarkdownDescription: "Astro API Token. Can be set with an `ASTRO_API_TOKEN` env var.",
+			MarkdownDescription: "Astro API Token. Can be set with an `ASTRO_API_TOKEN` env var. Only one of `token`, `token_file`, `token_command` or `use_astro_cli_context` can be set.",
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("token_file"), path.MatchRoot("token_command"), path.MatchRoot("use_astro_cli_context")),
+			},
+		},
+		"token_file": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Path to a file containing the Astro API Token. The file is read every time the provider is configured, so it can hold a short-lived token that is refreshed outside of Terraform.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ConflictsWith(path.MatchRoot("token_command"), path.MatchRoot("use_astro_cli_context")),
+			},
+		},
+		"token_command": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Optional:            true,
+			MarkdownDescription: "Command, and its arguments, of a helper that prints the Astro API Token to stdout, for example `[\"get-astro-token\", \"--audience\", \"astro\"]`. The command is run every time the provider is configured and must exit within one minute.",
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				listvalidator.ConflictsWith(path.MatchRoot("use_astro_cli_context")),
+			},
+		},
+		"use_astro_cli_context": schema.BoolAttribute{
+			Optional:            true,
+			MarkdownDescription: "Use the token of the current context of a logged-in Astro CLI, read from its config file. If `host` is not set, it is derived from the context domain. Run `astro login` again when the token expires. Defaults to `false`.",
+		},
+		"astro_cli_config_file": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Path to the Astro CLI config file used by `use_astro_cli_context`. Defaults to `~/.astro/config.yaml`.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.AlsoRequires(path.MatchRoot("use_astro_cli_context")),
+			},
 		},
 		"organization_id": schema.StringAttribute{
 			Required:            true,
@@ -25,10 +65,90 @@ func ProviderSchemaAttributes() map[string]schema.Attribute {
 		},
 		"host": schema.StringAttribute{
 			Optional:            true,
-			MarkdownDescription: "API host to use for the provider. Default is `https://api.astronomer.io`",
+			MarkdownDescription: "API host to use for the provider. Default is `https://api.astronomer.io`. Must be an Astronomer API host unless `allow_custom_host` is `true`.",
+			Validators: []validator.String{
+				validators.IsApiHost(path.Root("allow_custom_host")),
+			},
+		},
+		"allow_custom_host": schema.BoolAttribute{
+			Optional:            true,
+			MarkdownDescription: "Allow `host` to be any HTTPS URL, or an HTTP URL on a loopback address such as `http://localhost:8080`, instead of an Astronomer API host. Use this to reach the Astro API through an internal API gateway or to point the provider at a local stand-in server. Defaults to `false`.",
+		},
+		"ca_cert_file": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Path to a PEM encoded CA certificate bundle trusted in addition to the system certificate pool when connecting to `host` or `proxy_url`.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"proxy_url": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "URL of the HTTP or HTTPS proxy used for every request to the Astro API. Defaults to the proxy set in the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` env vars.",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^(http|https|socks5)://.+`), "must be a proxy URL such as `http://proxy.example.com:3128`"),
+			},
+		},
+		"insecure_skip_verify": schema.BoolAttribute{
+			Optional:            true,
+			MarkdownDescription: "Skip verification of the TLS certificate presented by `host` or `proxy_url`. Only use this for testing. Defaults to `false`.",
+		},
+		"max_concurrent_requests": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "Maximum number of requests the provider sends to the Astro API at the same time, shared by all resources and data sources. Requests over the limit are queued. Unlimited by default.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"requests_per_second": schema.Float64Attribute{
+			Optional:            true,
+			MarkdownDescription: "Maximum rate of requests per second the provider sends to the Astro API, shared by all resources and data sources. Requests over the limit are queued. Unlimited by default.",
+			Validators: []validator.Float64{
+				float64validator.AtLeast(0.1),
+			},
+		},
+		"deployment_defaults": schema.SingleNestedAttribute{
+			Optional:            true,
+			MarkdownDescription: "Default values applied to every `astro_deployment` resource that does not set the attribute itself. Values set on the resource always take precedence. The attributes filled in from these defaults are listed in the resource's `provider_default_attributes`.",
+			Attributes:          DeploymentDefaultsSchemaAttributes(),
+		},
+	}
+}
+
+func DeploymentDefaultsSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"contact_emails": schema.SetAttribute{
+			ElementType:         types.StringType,
+			Optional:            true,
+			MarkdownDescription: "Default Deployment contact emails",
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(validators.EmailString), "must be a valid email address")),
+			},
+		},
+		"is_cicd_enforced": schema.BoolAttribute{
+			Optional:            true,
+			MarkdownDescription: "Default Deployment CI/CD enforced",
+		},
+		"is_dag_deploy_enabled": schema.BoolAttribute{
+			Optional:            true,
+			MarkdownDescription: "Default for whether DAG deploy is enabled",
+		},
+		"executor": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Default Deployment executor. Allowed values: `CELERY`, `KUBERNETES`, `ASTRO`.",
+			Validators: []validator.String{
+				stringvalidator.OneOf(string(platform.DeploymentExecutorCELERY), string(platform.DeploymentExecutorKUBERNETES), string(platform.DeploymentExecutorASTRO)),
+			},
+		},
+		"scheduler_size": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Default Deployment scheduler size, only applied to 'STANDARD' and 'DEDICATED' deployments. Allowed values: `SMALL`, `MEDIUM`, `LARGE`, `EXTRALARGE`.",
 			Validators: []validator.String{
-				stringvalidator.RegexMatches(regexp.MustCompile(`^https://(pr\d+)?api.astronomer(-(dev|stage))?.io$`),
-					"must be a valid Astronomer API host such as `https://api.astronomer.io`"),
+				stringvalidator.OneOf(
+					string(platform.SchedulerMachineNameSMALL),
+					string(platform.SchedulerMachineNameMEDIUM),
+					string(platform.SchedulerMachineNameLARGE),
+					string(platform.SchedulerMachineNameEXTRALARGE),
+				),
 			},
 		},
 	}
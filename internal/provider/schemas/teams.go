@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -65,3 +66,14 @@ func TeamsDataSourceSchemaAttributes() map[string]schema.Attribute {
 		},
 	}
 }
+
+// TeamsListResourceSchemaAttributes is the configuration of the team list resource, with the filters of the data source
+func TeamsListResourceSchemaAttributes() map[string]listschema.Attribute {
+	return map[string]listschema.Attribute{
+		"names": listschema.ListAttribute{
+			MarkdownDescription: "Only list the teams with these names",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+	}
+}
@@ -1,8 +1,15 @@
 package schemas
 
 import (
+	"regexp"
+
 	"github.com/astronomer/terraform-provider-astro/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
@@ -64,3 +71,55 @@ func UserDataSourceSchemaAttributes() map[string]datasourceSchema.Attribute {
 		},
 	}
 }
+
+func UserResourceSchemaAttributes() map[string]resourceSchema.Attribute {
+	// The roles are the ones of astro_user_roles, without the user_id
+	attributes := ResourceUserRolesSchemaAttributes()
+	delete(attributes, "user_id")
+
+	attributes["id"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "User identifier",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["email"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "The email address of the user - if changed, the user will be invited again",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(regexp.MustCompile(validators.EmailString), "must be a valid email address"),
+		},
+	}
+	attributes["status"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "The status of the user, `PENDING` until the user accepts the invite and `ACTIVE` after",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["invite_id"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "The ID of the invite sent to the user, null if the user was already a member of the organization",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["invite_expires_at"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "The expiration date of the invite sent to the user",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["reinvite_on_expiry"] = resourceSchema.BoolAttribute{
+		MarkdownDescription: "Whether to invite the user again when the invite expired before the user accepted it. Defaults to `false`, which only warns about the expired invite.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+	return attributes
+}
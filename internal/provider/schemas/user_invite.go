@@ -6,6 +6,7 @@ import (
 	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
 	"github.com/astronomer/terraform-provider-astro/internal/provider/validators"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	actionSchema "github.com/hashicorp/terraform-plugin-framework/action/schema"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
@@ -53,3 +54,25 @@ func UserInviteResourceSchemaAttributes() map[string]resourceSchema.Attribute {
 		},
 	}
 }
+
+func UserInviteResendActionSchemaAttributes() map[string]actionSchema.Attribute {
+	return map[string]actionSchema.Attribute{
+		"email": actionSchema.StringAttribute{
+			MarkdownDescription: "The email address of the user to invite again",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(validators.EmailString), "must be a valid email address"),
+			},
+		},
+		"role": actionSchema.StringAttribute{
+			MarkdownDescription: "The Organization role to assign to the user",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(string(iam.CreateUserInviteRequestRoleORGANIZATIONOWNER),
+					string(iam.CreateUserInviteRequestRoleORGANIZATIONMEMBER),
+					string(iam.CreateUserInviteRequestRoleORGANIZATIONBILLINGADMIN),
+				),
+			},
+		},
+	}
+}
@@ -2,8 +2,11 @@ package schemas
 
 import (
 	"github.com/astronomer/terraform-provider-astro/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -52,5 +55,22 @@ func UsersDataSourceSchemaAttributes() map[string]schema.Attribute {
 			Optional:   true,
 			Validators: []validator.String{validators.IsCuid()},
 		},
+		"dag_id": schema.StringAttribute{
+			MarkdownDescription: "Only return the users with a DAG role for this DAG of the deployment `deployment_id`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.AlsoRequires(path.MatchRoot("deployment_id")),
+			},
+		},
+		"dag_tags": schema.SetAttribute{
+			MarkdownDescription: "Only return the users with a DAG role for these DAG tags of the deployment `deployment_id`",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				setvalidator.AlsoRequires(path.MatchRoot("deployment_id")),
+			},
+		},
 	}
 }
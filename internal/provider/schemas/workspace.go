@@ -4,7 +4,9 @@ import (
 	"github.com/astronomer/terraform-provider-astro/internal/provider/validators"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -14,13 +16,19 @@ import (
 func WorkspaceDataSourceSchemaAttributes() map[string]datasourceSchema.Attribute {
 	return map[string]datasourceSchema.Attribute{
 		"id": datasourceSchema.StringAttribute{
-			MarkdownDescription: "Workspace identifier",
-			Required:            true,
-			Validators:          []validator.String{validators.IsCuid()},
+			MarkdownDescription: "Workspace identifier - exactly one of `id` or `name` must be set",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				validators.IsCuid(),
+				stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+			},
 		},
 		"name": datasourceSchema.StringAttribute{
-			MarkdownDescription: "Workspace name",
+			MarkdownDescription: "Workspace name - looks up the Workspace by name when `id` is not set, fails if no or more than one Workspace has the name",
+			Optional:            true,
 			Computed:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 		},
 		"description": datasourceSchema.StringAttribute{
 			MarkdownDescription: "Workspace description",
@@ -99,5 +107,11 @@ func WorkspaceResourceSchemaAttributes() map[string]resourceSchema.Attribute {
 			Computed:            true,
 			Attributes:          ResourceSubjectProfileSchemaAttributes(),
 		},
+		"deletion_protection": resourceSchema.BoolAttribute{
+			MarkdownDescription: "Whether the Workspace is protected from deletion. While `true`, any plan that would destroy the Workspace fails; set it to `false` and apply before removing the resource. Defaults to `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
 	}
 }
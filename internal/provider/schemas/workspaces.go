@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -31,7 +32,7 @@ func WorkspacesDataSourceSchemaAttributes() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"workspaces": schema.SetNestedAttribute{
 			NestedObject: schema.NestedAttributeObject{
-				Attributes: WorkspaceDataSourceSchemaAttributes(),
+				Attributes: workspacesElementSchemaAttributes(),
 			},
 			Computed: true,
 		},
@@ -51,3 +52,34 @@ func WorkspacesDataSourceSchemaAttributes() map[string]schema.Attribute {
 		},
 	}
 }
+
+// workspacesElementSchemaAttributes is the workspace data source schema without its lookup keys, which are only
+// computed in the list
+func workspacesElementSchemaAttributes() map[string]schema.Attribute {
+	attributes := WorkspaceDataSourceSchemaAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Workspace identifier",
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Workspace name",
+		Computed:            true,
+	}
+	return attributes
+}
+
+// WorkspacesListResourceSchemaAttributes is the configuration of the workspace list resource, with the filters of the data source
+func WorkspacesListResourceSchemaAttributes() map[string]listschema.Attribute {
+	return map[string]listschema.Attribute{
+		"workspace_ids": listschema.ListAttribute{
+			MarkdownDescription: "Only list the workspaces with these identifiers",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"names": listschema.ListAttribute{
+			MarkdownDescription: "Only list the workspaces with these names",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+	}
+}
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/astronomer/terraform-provider-astro/internal/provider/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+// tokenCommandTimeout is the maximum time token_command is allowed to run
+const tokenCommandTimeout = 1 * time.Minute
+
+// resolveToken sets data.Token from the configured token source: the token attribute, token_file, token_command or
+// the Astro CLI context, falling back to the ASTRO_API_TOKEN env var. The sources are read every time the provider is
+// configured so short-lived tokens are picked up on every run. When the Astro CLI context is used and host is not
+// configured, data.Host is set to the host of the context.
+func resolveToken(ctx context.Context, data *models.AstroProviderModel) diag.Diagnostics {
+	switch {
+	case !data.Token.IsNull():
+		return nil
+	case !data.TokenFile.IsNull():
+		return readTokenFile(data)
+	case !data.TokenCommand.IsNull():
+		return runTokenCommand(ctx, data)
+	case data.UseAstroCliContext.ValueBool():
+		return readAstroCliContext(ctx, data)
+	}
+
+	// Will fallback to the ASTRO_API_TOKEN env var
+	data.Token = types.StringValue(os.Getenv("ASTRO_API_TOKEN"))
+	return nil
+}
+
+func readTokenFile(data *models.AstroProviderModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	content, err := os.ReadFile(data.TokenFile.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("token_file"),
+			"Failed to read token_file",
+			fmt.Sprintf("Unable to read Astro API Token from '%s', got error: %s", data.TokenFile.ValueString(), err),
+		)
+		return diags
+	}
+	data.Token = types.StringValue(strings.TrimSpace(string(content)))
+	if len(data.Token.ValueString()) == 0 {
+		diags.AddAttributeError(
+			path.Root("token_file"),
+			"Empty token_file",
+			fmt.Sprintf("The token file '%s' is empty", data.TokenFile.ValueString()),
+		)
+	}
+	return diags
+}
+
+func runTokenCommand(ctx context.Context, data *models.AstroProviderModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var command []string
+	diags.Append(data.TokenCommand.ElementsAs(ctx, &command, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	commandCtx, cancel := context.WithTimeout(ctx, tokenCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(commandCtx, command[0], command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	tflog.Debug(ctx, "running token_command", map[string]interface{}{"command": command[0]})
+	if err := cmd.Run(); err != nil {
+		diags.AddAttributeError(
+			path.Root("token_command"),
+			"Failed to run token_command",
+			fmt.Sprintf("Unable to get Astro API Token from '%s', got error: %s\n%s", command[0], err, strings.TrimSpace(stderr.String())),
+		)
+		return diags
+	}
+
+	data.Token = types.StringValue(strings.TrimSpace(stdout.String()))
+	if len(data.Token.ValueString()) == 0 {
+		diags.AddAttributeError(
+			path.Root("token_command"),
+			"Empty token_command output",
+			fmt.Sprintf("The token command '%s' did not print a token", command[0]),
+		)
+	}
+	return diags
+}
+
+// astroCliConfig is the subset of the Astro CLI config file needed to reuse a logged-in context
+type astroCliConfig struct {
+	Context  string                     `yaml:"context"`
+	Contexts map[string]astroCliContext `yaml:"contexts"`
+}
+
+type astroCliContext struct {
+	Domain       string `yaml:"domain"`
+	Organization string `yaml:"organization"`
+	Token        string `yaml:"token"`
+}
+
+// astroCliDomainHosts maps the Astro CLI context domains to their API hosts
+var astroCliDomainHosts = map[string]string{
+	"astronomer.io":             "https://api.astronomer.io",
+	"cloud.astronomer.io":       "https://api.astronomer.io",
+	"astronomer-dev.io":         "https://api.astronomer-dev.io",
+	"cloud.astronomer-dev.io":   "https://api.astronomer-dev.io",
+	"astronomer-stage.io":       "https://api.astronomer-stage.io",
+	"cloud.astronomer-stage.io": "https://api.astronomer-stage.io",
+}
+
+func readAstroCliContext(ctx context.Context, data *models.AstroProviderModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configFile := data.AstroCliConfigFile.ValueString()
+	if configFile == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("astro_cli_config_file"),
+				"Failed to locate the Astro CLI config file",
+				fmt.Sprintf("Unable to find the home directory, set astro_cli_config_file instead, got error: %s", err),
+			)
+			return diags
+		}
+		configFile = filepath.Join(home, ".astro", "config.yaml")
+	}
+
+	content, err := os.ReadFile(configFile)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("use_astro_cli_context"),
+			"Failed to read the Astro CLI config file",
+			fmt.Sprintf("Unable to read '%s', run `astro login` first, got error: %s", configFile, err),
+		)
+		return diags
+	}
+	var config astroCliConfig
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		diags.AddAttributeError(
+			path.Root("use_astro_cli_context"),
+			"Failed to parse the Astro CLI config file",
+			fmt.Sprintf("Unable to parse '%s', got error: %s", configFile, err),
+		)
+		return diags
+	}
+
+	// The Astro CLI stores each context under its domain with dots replaced by underscores
+	cliContext, ok := config.Contexts[strings.ReplaceAll(config.Context, ".", "_")]
+	if !ok || config.Context == "" {
+		diags.AddAttributeError(
+			path.Root("use_astro_cli_context"),
+			"No current Astro CLI context",
+			fmt.Sprintf("'%s' does not have a current context, run `astro login` first", configFile),
+		)
+		return diags
+	}
+	token := strings.TrimSpace(strings.TrimPrefix(cliContext.Token, "Bearer "))
+	if token == "" {
+		diags.AddAttributeError(
+			path.Root("use_astro_cli_context"),
+			"Astro CLI context is not logged in",
+			fmt.Sprintf("The Astro CLI context '%s' does not have a token, run `astro login` first", config.Context),
+		)
+		return diags
+	}
+	if expiresAt, ok := tokenExpiry(token); ok && time.Now().After(expiresAt) {
+		diags.AddAttributeError(
+			path.Root("use_astro_cli_context"),
+			"Astro CLI token has expired",
+			fmt.Sprintf("The token of the Astro CLI context '%s' expired at %s, run `astro login` again", config.Context, expiresAt.Format(time.RFC3339)),
+		)
+		return diags
+	}
+	if cliContext.Organization != "" && cliContext.Organization != data.OrganizationId.ValueString() {
+		diags.AddAttributeWarning(
+			path.Root("use_astro_cli_context"),
+			"Astro CLI context uses a different organization",
+			fmt.Sprintf("The Astro CLI context '%s' is logged in to organization '%s' but the provider is configured for organization '%s'. Run `astro organization switch` if requests fail with permission errors.", config.Context, cliContext.Organization, data.OrganizationId.ValueString()),
+		)
+	}
+
+	data.Token = types.StringValue(token)
+	if host, ok := astroCliDomainHosts[cliContext.Domain]; ok && data.Host.IsNull() {
+		data.Host = types.StringValue(host)
+	}
+	tflog.Info(ctx, "using Astro CLI context", map[string]interface{}{"context": config.Context, "configFile": configFile})
+	return diags
+}
+
+// tokenExpiry returns the expiry time of a JWT token. The token signature is not verified as the token is only
+// inspected to give a clearer error than the API would.
+func tokenExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(claims.Exp, 0), true
+}
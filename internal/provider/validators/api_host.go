@@ -0,0 +1,102 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.String = isApiHostValidator{}
+
+// isApiHostValidator validates the provider host. Only Astronomer API hosts are accepted unless the attribute at
+// allowCustomHostPath is true, in which case any HTTPS URL and HTTP URLs on a loopback address are accepted too.
+type isApiHostValidator struct {
+	allowCustomHostPath path.Path
+}
+
+func (v isApiHostValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v isApiHostValidator) MarkdownDescription(_ context.Context) string {
+	return "must be a valid Astronomer API host such as `https://api.astronomer.io`"
+}
+
+func (v isApiHostValidator) ValidateString(
+	ctx context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+	value := request.ConfigValue.ValueString()
+
+	var allowCustomHost types.Bool
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, v.allowCustomHostPath, &allowCustomHost)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if allowCustomHost.ValueBool() {
+		if err := ValidateCustomApiHost(value); err != nil {
+			response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+				request.Path,
+				err.Error(),
+				value,
+			))
+		}
+		return
+	}
+
+	if !regexp.MustCompile(AstronomerApiHostString).MatchString(value) {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+			request.Path,
+			fmt.Sprintf("%s, or set %s to true to use a custom host", v.Description(ctx), v.allowCustomHostPath),
+			value,
+		))
+	}
+}
+
+// IsApiHost validates an Astro API host, accepting custom hosts when the boolean attribute at allowCustomHostPath is true
+func IsApiHost(allowCustomHostPath path.Path) validator.String {
+	return isApiHostValidator{allowCustomHostPath: allowCustomHostPath}
+}
+
+// ValidateCustomApiHost checks that host is an HTTPS URL, or an HTTP URL on a loopback address, without a query or fragment
+func ValidateCustomApiHost(host string) error {
+	u, err := url.Parse(host)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("must be a URL such as `https://astro-api.example.com`")
+	}
+	if u.RawQuery != "" || u.Fragment != "" || strings.HasSuffix(u.Path, "/") {
+		return fmt.Errorf("must not have a query, a fragment or a trailing slash")
+	}
+	switch u.Scheme {
+	case "https":
+		return nil
+	case "http":
+		if isLoopbackHost(u.Hostname()) {
+			return nil
+		}
+		return fmt.Errorf("must use https unless the host is a loopback address such as `http://localhost:8080`")
+	default:
+		return fmt.Errorf("must use the https scheme, or http for a loopback address")
+	}
+}
+
+func isLoopbackHost(hostname string) bool {
+	if strings.EqualFold(hostname, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(hostname)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,78 @@
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/astronomer/terraform-provider-astro/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnit_Validators_IsApiHost(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"host":              schema.StringAttribute{Optional: true},
+			"allow_custom_host": schema.BoolAttribute{Optional: true},
+		},
+	}
+	config := func(host string, allowCustomHost *bool) tfsdk.Config {
+		var allow tftypes.Value
+		if allowCustomHost == nil {
+			allow = tftypes.NewValue(tftypes.Bool, nil)
+		} else {
+			allow = tftypes.NewValue(tftypes.Bool, *allowCustomHost)
+		}
+		return tfsdk.Config{
+			Schema: s,
+			Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"host":              tftypes.NewValue(tftypes.String, host),
+				"allow_custom_host": allow,
+			}),
+		}
+	}
+	enabled, disabled := true, false
+
+	type testCase struct {
+		host            string
+		allowCustomHost *bool
+		expectedPass    bool
+	}
+	testCases := []testCase{
+		{host: "https://api.astronomer.io", expectedPass: true},
+		{host: "https://api.astronomer-dev.io", expectedPass: true},
+		{host: "https://pr1234api.astronomer-stage.io", expectedPass: true},
+		{host: "https://api.astronomer.com", expectedPass: false},
+		{host: "https://astro-api.example.com", allowCustomHost: &disabled, expectedPass: false},
+		{host: "https://astro-api.example.com", allowCustomHost: &enabled, expectedPass: true},
+		{host: "https://gateway.example.com/astro", allowCustomHost: &enabled, expectedPass: true},
+		{host: "https://api.astronomer.io", allowCustomHost: &enabled, expectedPass: true},
+		{host: "http://localhost:8080", allowCustomHost: &enabled, expectedPass: true},
+		{host: "http://127.0.0.1:8080", allowCustomHost: &enabled, expectedPass: true},
+		{host: "http://[::1]:8080", allowCustomHost: &enabled, expectedPass: true},
+		{host: "http://localhost:8080", expectedPass: false},
+		{host: "http://astro-api.example.com", allowCustomHost: &enabled, expectedPass: false},
+		{host: "https://astro-api.example.com/", allowCustomHost: &enabled, expectedPass: false},
+		{host: "https://astro-api.example.com?x=1", allowCustomHost: &enabled, expectedPass: false},
+		{host: "ftp://astro-api.example.com", allowCustomHost: &enabled, expectedPass: false},
+		{host: "astro-api.example.com", allowCustomHost: &enabled, expectedPass: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.host, func(t *testing.T) {
+			request := validator.StringRequest{
+				Path:        path.Root("host"),
+				ConfigValue: types.StringValue(tc.host),
+				Config:      config(tc.host, tc.allowCustomHost),
+			}
+			response := validator.StringResponse{}
+			validators.IsApiHost(path.Root("allow_custom_host")).ValidateString(ctx, request, &response)
+			assert.Equal(t, !tc.expectedPass, response.Diagnostics.HasError(), "diagnostics: %v", response.Diagnostics)
+		})
+	}
+}
@@ -2,3 +2,4 @@ package validators
 
 const KubernetesResourceString = `^(\+)?((\d+(\.\d*)?)|(\.\d+))(([KMGTPE]i)|[mkMGTPE]|([eE](\+)?((\d+(\.\d*)?)|(\.\d+))))?$`
 const EmailString = `^.+@.+\..+$` // May let some invalid emails through but should be enough for most cases
+const AstronomerApiHostString = `^https://(pr\d+)?api.astronomer(-(dev|stage))?.io$`
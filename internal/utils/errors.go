@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -48,3 +49,23 @@ func ResourceApiClientConfigureError(
 	)
 	return
 }
+
+func ActionApiClientConfigureError(
+	ctx context.Context,
+	req action.ConfigureRequest,
+	resp *action.ConfigureResponse,
+) {
+	tflog.Error(
+		ctx,
+		"unexpected action configure type",
+		map[string]interface{}{"type": fmt.Sprintf("%T", req.ProviderData)},
+	)
+	resp.Diagnostics.AddError(
+		"Unexpected Action Configure Type",
+		fmt.Sprintf(
+			"Expected apiClientsModel, got: %T. Please report this issue to the provider developers.",
+			req.ProviderData,
+		),
+	)
+	return
+}
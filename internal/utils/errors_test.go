@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/astronomer/terraform-provider-astro/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
@@ -32,6 +33,16 @@ func TestUnit_Errors(t *testing.T) {
 		resp := resource.ConfigureResponse{}
 		utils.ResourceApiClientConfigureError(ctx, req, &resp)
 
+		assert.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics[0].Detail(), "Expected apiClientsModel, got:")
+	})
+	t.Run("ActionApiClientConfigureError", func(t *testing.T) {
+		req := action.ConfigureRequest{
+			ProviderData: nil,
+		}
+		resp := action.ConfigureResponse{}
+		utils.ActionApiClientConfigureError(ctx, req, &resp)
+
 		assert.True(t, resp.Diagnostics.HasError())
 		assert.Contains(t, resp.Diagnostics[0].Detail(), "Expected apiClientsModel, got:")
 	})
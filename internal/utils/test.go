@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
@@ -8,6 +9,8 @@ import (
 
 	"github.com/astronomer/terraform-provider-astro/internal/clients/iam"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/astronomer/terraform-provider-astro/internal/clients/platform"
@@ -21,6 +24,20 @@ var hostedIamClient, hybridIamClient *iam.ClientWithResponses
 
 const TestResourceDescription = "Created by Terraform Acceptance Test - should self-cleanup but can delete manually if needed after 2 hours."
 
+// TestObjectValue builds the value of a schema, e.g. a configuration, plan or state, with the attributes in values and
+// every other attribute null
+func TestObjectValue(ctx context.Context, schemaType attr.Type, values map[string]tftypes.Value) tftypes.Value {
+	objectType := schemaType.TerraformType(ctx).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range values {
+		attributes[name] = value
+	}
+	return tftypes.NewValue(objectType, attributes)
+}
+
 func GenerateTestResourceName(numRandomChars int) string {
 	return fmt.Sprintf("TFAcceptanceTest_%v", strings.ToUpper(acctest.RandStringFromCharSet(numRandomChars, acctest.CharSetAlpha)))
 }